@@ -17,6 +17,10 @@ const (
 	DefaultWeightMsgClaimReward           int = 20
 	DefaultWeightMsgDeposit               int = 20
 	DefaultWeightMsgWithdraw              int = 20
+	DefaultWeightMsgBorrow                int = 20
+	DefaultWeightMsgBorrowToLimit         int = 10
+	DefaultWeightMsgRepay                 int = 20
+	DefaultWeightMsgLiquidate             int = 20
 	DefaultWeightMsgSwapExactForTokens    int = 20
 	DefaultWeightMsgSwapForExactTokens    int = 20
 	DefaultWeightMsgIssue                 int = 20
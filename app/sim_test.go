@@ -0,0 +1,174 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func init() {
+	simapp.GetSimulatorFlags()
+}
+
+// TestFullAppSimulation runs the simulator against the app. It is skipped unless run with the -Enabled flag.
+//
+//	go test ./app -run TestFullAppSimulation -Enabled=true -NumBlocks=100 -BlockSize=50 -Commit=true -Period=5 -v
+func TestFullAppSimulation(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+	// the evm module requires an ethermint formatted chain id
+	config.ChainID = testChainID
+
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	SetSDKConfig()
+	encCfg := MakeEncodingConfig()
+	options := DefaultOptions
+	options.InvariantCheckPeriod = simapp.FlagPeriodValue
+	app := NewApp(logger, db, DefaultNodeHome, nil, encCfg, options)
+
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		AppStateFn(app.AppCodec(), app.SimulationManager()),
+		simtypes.RandomAccounts,
+		simapp.SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, simErr)
+
+	require.NoError(t, simapp.CheckExportSimulation(app, config, simParams))
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+}
+
+// AppStateFn returns the initial application state using the simulation parameters.
+// It is based on the simapp implementation, but starts from the kava default genesis state.
+func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simtypes.AppStateFn {
+	return func(r *rand.Rand, accs []simtypes.Account, config simtypes.Config,
+	) (appState json.RawMessage, simAccs []simtypes.Account, chainID string, genesisTimestamp time.Time) {
+		genesisTimestamp = simtypes.RandTimestamp(r)
+		chainID = config.ChainID
+
+		appParams := make(simtypes.AppParams)
+		if config.ParamsFile != "" {
+			bz, err := os.ReadFile(config.ParamsFile)
+			if err != nil {
+				panic(err)
+			}
+			if err := json.Unmarshal(bz, &appParams); err != nil {
+				panic(err)
+			}
+		}
+		genesisState := AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams)
+
+		stakingState := new(stakingtypes.GenesisState)
+		cdc.MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], stakingState)
+
+		// compute not bonded balance
+		notBondedTokens := sdk.ZeroInt()
+		for _, val := range stakingState.Validators {
+			if val.Status != stakingtypes.Unbonded {
+				continue
+			}
+			notBondedTokens = notBondedTokens.Add(val.GetTokens())
+		}
+		notBondedCoins := sdk.NewCoin(stakingState.Params.BondDenom, notBondedTokens)
+
+		// edit bank state to make it have the not bonded pool tokens
+		bankState := new(banktypes.GenesisState)
+		cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], bankState)
+		bankState.Balances = append(bankState.Balances, banktypes.Balance{
+			Address: authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(),
+			Coins:   sdk.NewCoins(notBondedCoins),
+		})
+		genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+
+		appState, err := json.Marshal(genesisState)
+		if err != nil {
+			panic(err)
+		}
+		return appState, accs, chainID, genesisTimestamp
+	}
+}
+
+// AppStateRandomizedFn creates calls each module's GenesisState generator function
+// and creates the simulation params
+func AppStateRandomizedFn(
+	simManager *module.SimulationManager, r *rand.Rand, cdc codec.JSONCodec,
+	accs []simtypes.Account, genesisTimestamp time.Time, appParams simtypes.AppParams,
+) GenesisState {
+	numAccs := int64(len(accs))
+	genesisState := NewDefaultGenesisState()
+
+	// generate a random amount of initial stake coins and a random initial
+	// number of bonded accounts
+	var (
+		numInitiallyBonded int64
+		initialStake       sdkmath.Int
+	)
+	appParams.GetOrGenerate(
+		cdc, simappparams.StakePerAccount, &initialStake, r,
+		func(r *rand.Rand) { initialStake = sdkmath.NewInt(r.Int63n(1e12)) },
+	)
+	appParams.GetOrGenerate(
+		cdc, simappparams.InitiallyBondedValidators, &numInitiallyBonded, r,
+		func(r *rand.Rand) { numInitiallyBonded = int64(r.Intn(300)) },
+	)
+
+	if numInitiallyBonded > numAccs {
+		numInitiallyBonded = numAccs
+	}
+
+	fmt.Printf(
+		`Selected randomly generated parameters for simulated genesis:
+{
+  stake_per_account: "%d",
+  initially_bonded_validators: "%d"
+}
+`, initialStake, numInitiallyBonded,
+	)
+
+	simState := &module.SimulationState{
+		AppParams:    appParams,
+		Cdc:          cdc,
+		Rand:         r,
+		GenState:     genesisState,
+		Accounts:     accs,
+		InitialStake: initialStake,
+		NumBonded:    numInitiallyBonded,
+		GenTimestamp: genesisTimestamp,
+	}
+
+	simManager.GenerateGenesisStates(simState)
+
+	return genesisState
+}
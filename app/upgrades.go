@@ -1,3 +1,30 @@
 package app
 
-func (app App) RegisterUpgradeHandlers() {}
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	UpgradeName_Mainnet = "v0.26.0"
+	UpgradeName_Testnet = "v0.26.0-alpha.0"
+)
+
+// RegisterUpgradeHandlers registers the upgrade handlers for all upgrades.
+func (app App) RegisterUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeName_Mainnet, upgradeHandler(app, UpgradeName_Mainnet))
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeName_Testnet, upgradeHandler(app, UpgradeName_Testnet))
+}
+
+// upgradeHandler returns an upgrade handler that runs the in-place store migrations of all modules with a bumped
+// consensus version, such as the swap share token and the swap and incentive params migrations.
+func upgradeHandler(app App, name string) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		app.Logger().Info(fmt.Sprintf("running %s upgrade handler", name))
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	}
+}
@@ -0,0 +1,62 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestUpgradeHandlers_RunModuleMigrations(t *testing.T) {
+	for _, name := range []string{app.UpgradeName_Mainnet, app.UpgradeName_Testnet} {
+		t.Run(name, func(t *testing.T) {
+			tApp := app.NewTestApp()
+			tApp.InitializeFromGenesisStates()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Now()})
+
+			// a deposit made before shares were tokenized
+			depositor := sdk.AccAddress("depositor 1---------")
+			poolID := swaptypes.PoolID("ukava", "usdx")
+			swapKeeper := tApp.GetSwapKeeper()
+			swapKeeper.SetPool(ctx, swaptypes.NewPoolRecord(
+				sdk.NewCoins(
+					sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+					sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+				),
+				sdkmath.NewInt(3e6),
+			))
+			swapKeeper.SetDepositorShares(ctx, swaptypes.NewShareRecord(depositor, poolID, sdkmath.NewInt(3e6)))
+
+			upgradeKeeper := tApp.GetUpgradeKeeper()
+			fromVM := upgradeKeeper.GetModuleVersionMap(ctx)
+			fromVM[swaptypes.ModuleName] = 1
+			fromVM[incentivetypes.ModuleName] = 1
+			upgradeKeeper.SetModuleVersionMap(ctx, fromVM)
+
+			plan := upgradetypes.Plan{Name: name, Height: ctx.BlockHeight()}
+			require.True(t, upgradeKeeper.HasHandler(name))
+			require.NotPanics(t, func() { upgradeKeeper.ApplyUpgrade(ctx, plan) })
+
+			require.Equal(t, uint64(3), upgradeKeeper.GetModuleVersionMap(ctx)[swaptypes.ModuleName])
+			require.Equal(t, uint64(2), upgradeKeeper.GetModuleVersionMap(ctx)[incentivetypes.ModuleName])
+
+			shareDenom := swaptypes.ShareDenom(poolID)
+			require.Equal(t, sdkmath.NewInt(3e6), tApp.GetBankKeeper().GetBalance(ctx, depositor, shareDenom).Amount)
+
+			swapParams := swapKeeper.GetParams(ctx)
+			require.Equal(t, swaptypes.DefaultProtocolFee, swapParams.ProtocolFee)
+			require.Equal(t, swaptypes.DefaultPermissionlessPoolCreation, swapParams.PermissionlessPoolCreation)
+			require.Equal(t, swaptypes.DefaultMinInitialShares, swapParams.MinInitialShares)
+			require.Equal(t, incentivetypes.DefaultSwapRewardCuratedPoolsOnly, tApp.GetIncentiveKeeper().GetParams(ctx).SwapRewardCuratedPoolsOnly)
+		})
+	}
+}
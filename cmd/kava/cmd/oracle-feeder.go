@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	kavagrpc "github.com/kava-labs/kava/client/grpc"
+	"github.com/kava-labs/kava/cmd/kava/oraclefeeder"
+)
+
+const flagOnce = "once"
+
+// OracleFeederCmd returns the command to run a price feeder that posts prices to the pricefeed module.
+func OracleFeederCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-feeder [config-file]",
+		Short: "Post prices to the pricefeed from configured price sources",
+		Long: `Reads prices from the sources of a JSON config file every interval, computes the median price of each
+market from its sources, and posts the prices in batches of MsgPostPrices signed by the --from key, retrying
+failed txs. The key must be an oracle of the markets or the feeder delegated by the oracle.
+
+Sources are static JSON files of prices, JSON http endpoints, or the time-weighted average prices of swap pools.`,
+		Example: fmt.Sprintf(`%s oracle-feeder feeder.json --from oracle --chain-id kava_2222-10`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			config, err := oraclefeeder.LoadConfig(args[0])
+			if err != nil {
+				return err
+			}
+			grpcClient, err := kavagrpc.NewClient(config.GrpcURL)
+			if err != nil {
+				return err
+			}
+			sources, err := oraclefeeder.NewSources(config.Sources, grpcClient.Query.Swap)
+			if err != nil {
+				return err
+			}
+
+			feeder, err := oraclefeeder.NewFeeder(
+				config,
+				sources,
+				oraclefeeder.NewGrpcChain(grpcClient),
+				clientCtx.TxConfig,
+				tx.NewFactoryCLI(clientCtx, cmd.Flags()),
+				clientCtx.GetFromName(),
+				server.GetServerContextFromCmd(cmd).Logger.With("module", "oracle-feeder"),
+			)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			if once, _ := cmd.Flags().GetBool(flagOnce); once {
+				return feeder.FeedPrices(ctx)
+			}
+
+			ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			return feeder.Run(ctx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(flagOnce, false, "Post prices once and exit")
+
+	return cmd
+}
@@ -124,5 +124,6 @@ func addSubCmds(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, de
 		newTxCmd(),
 		keyCommands(app.DefaultNodeHome),
 		newShardCmd(opts),
+		OracleFeederCmd(),
 	)
 }
@@ -0,0 +1,249 @@
+// Package oraclefeeder implements a reference price feeder that reads prices from configurable sources and posts
+// them to the pricefeed module.
+package oraclefeeder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Source types supported by the feeder
+const (
+	SourceTypeStatic   = "static"
+	SourceTypeHTTP     = "http"
+	SourceTypeSwapTWAP = "swap_twap"
+)
+
+// Default config values used when they are not set in the config file
+const (
+	DefaultInterval   = time.Minute
+	DefaultBatchSize  = 20
+	DefaultMaxRetries = 3
+	DefaultRetryDelay = 5 * time.Second
+	DefaultTimeout    = 10 * time.Second
+)
+
+// Duration is a time.Duration that is read from and written to JSON as a duration string, such as "1m30s"
+type Duration struct {
+	time.Duration
+}
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = duration
+	return nil
+}
+
+// Config is the configuration of the feeder
+type Config struct {
+	// GrpcURL is the url of the grpc endpoint of the node used to query pools and broadcast txs
+	GrpcURL string `json:"grpc_url"`
+	// Interval is the time between rounds of posted prices
+	Interval Duration `json:"interval"`
+	// Expiry is how long posted prices are valid for
+	Expiry Duration `json:"expiry"`
+	// BatchSize is the max number of prices posted in a single tx
+	BatchSize int `json:"batch_size"`
+	// MaxRetries is the number of times a failed tx is retried before the batch is dropped
+	MaxRetries int `json:"max_retries"`
+	// RetryDelay is the time to wait before retrying a failed tx
+	RetryDelay Duration `json:"retry_delay"`
+
+	Sources []SourceConfig `json:"sources"`
+	Markets []MarketConfig `json:"markets"`
+}
+
+// SourceConfig configures a source of prices
+type SourceConfig struct {
+	// Name is the name markets use to refer to the source
+	Name string `json:"name"`
+	// Type is one of static, http or swap_twap
+	Type string `json:"type"`
+	// Path is the path of the prices file of a static source
+	Path string `json:"path,omitempty"`
+	// URL is the url of the JSON endpoint of an http source
+	URL string `json:"url,omitempty"`
+	// Timeout is the timeout of requests to an http source
+	Timeout Duration `json:"timeout,omitempty"`
+	// Window is the window of the time-weighted average price of a swap_twap source
+	Window Duration `json:"window,omitempty"`
+}
+
+// MarketConfig configures how the price of a market is computed
+type MarketConfig struct {
+	// MarketID is the pricefeed market the price is posted to
+	MarketID string `json:"market_id"`
+	// MinSources is the min number of sources with a price required to post a price for the market
+	MinSources int `json:"min_sources"`
+	// Sources are the prices the median price of the market is computed from
+	Sources []MarketSourceConfig `json:"sources"`
+}
+
+// MarketSourceConfig configures a price of a market read from a source
+type MarketSourceConfig struct {
+	// Source is the name of the source
+	Source string `json:"source"`
+	// Symbol identifies the price in the source: a key of a static source, a dot separated path into the
+	// response of an http source, or a pool id of a swap_twap source
+	Symbol string `json:"symbol"`
+	// Inverse uses the inverse of the price read from the source
+	Inverse bool `json:"inverse,omitempty"`
+}
+
+// LoadConfig reads a config from a JSON file, setting defaults for unset values, and validates it
+func LoadConfig(path string) (Config, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	var config Config
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return Config{}, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	config = config.WithDefaults()
+	if err := config.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// WithDefaults returns the config with default values set for unset values
+func (c Config) WithDefaults() Config {
+	if c.Interval.Duration == 0 {
+		c.Interval.Duration = DefaultInterval
+	}
+	if c.Expiry.Duration == 0 {
+		c.Expiry.Duration = 2 * c.Interval.Duration
+	}
+	if c.BatchSize == 0 {
+		c.BatchSize = DefaultBatchSize
+	}
+	if c.MaxRetries == 0 {
+		c.MaxRetries = DefaultMaxRetries
+	}
+	if c.RetryDelay.Duration == 0 {
+		c.RetryDelay.Duration = DefaultRetryDelay
+	}
+	for i, source := range c.Sources {
+		if source.Type == SourceTypeHTTP && source.Timeout.Duration == 0 {
+			c.Sources[i].Timeout.Duration = DefaultTimeout
+		}
+	}
+	for i, market := range c.Markets {
+		if market.MinSources == 0 {
+			c.Markets[i].MinSources = 1
+		}
+	}
+	return c
+}
+
+// Validate performs a basic validation of the config
+func (c Config) Validate() error {
+	if c.Interval.Duration <= 0 {
+		return fmt.Errorf("interval must be positive: %s", c.Interval)
+	}
+	if c.Expiry.Duration <= 0 {
+		return fmt.Errorf("expiry must be positive: %s", c.Expiry)
+	}
+	if c.BatchSize <= 0 {
+		return fmt.Errorf("batch size must be positive: %d", c.BatchSize)
+	}
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("max retries cannot be negative: %d", c.MaxRetries)
+	}
+	if c.RetryDelay.Duration < 0 {
+		return fmt.Errorf("retry delay cannot be negative: %s", c.RetryDelay)
+	}
+
+	sources := make(map[string]bool, len(c.Sources))
+	for _, source := range c.Sources {
+		if err := source.Validate(); err != nil {
+			return err
+		}
+		if sources[source.Name] {
+			return fmt.Errorf("duplicated source %s", source.Name)
+		}
+		sources[source.Name] = true
+	}
+
+	if len(c.Markets) == 0 {
+		return errors.New("no markets configured")
+	}
+	markets := make(map[string]bool, len(c.Markets))
+	for _, market := range c.Markets {
+		if err := market.Validate(sources); err != nil {
+			return err
+		}
+		if markets[market.MarketID] {
+			return fmt.Errorf("duplicated market %s", market.MarketID)
+		}
+		markets[market.MarketID] = true
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the source config
+func (c SourceConfig) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("source name cannot be blank")
+	}
+	switch c.Type {
+	case SourceTypeStatic:
+		if c.Path == "" {
+			return fmt.Errorf("static source %s must set a path", c.Name)
+		}
+	case SourceTypeHTTP:
+		if c.URL == "" {
+			return fmt.Errorf("http source %s must set a url", c.Name)
+		}
+		if c.Timeout.Duration <= 0 {
+			return fmt.Errorf("http source %s must set a positive timeout: %s", c.Name, c.Timeout)
+		}
+	case SourceTypeSwapTWAP:
+		if c.Window.Duration <= 0 {
+			return fmt.Errorf("swap_twap source %s must set a positive window: %s", c.Name, c.Window)
+		}
+	default:
+		return fmt.Errorf("source %s has unknown type: %s", c.Name, c.Type)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the market config against the names of the configured sources
+func (c MarketConfig) Validate(sources map[string]bool) error {
+	if strings.TrimSpace(c.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(c.Sources) == 0 {
+		return fmt.Errorf("market %s has no sources", c.MarketID)
+	}
+	if c.MinSources <= 0 || c.MinSources > len(c.Sources) {
+		return fmt.Errorf("market %s min sources must be between 1 and the number of sources: %d", c.MarketID, c.MinSources)
+	}
+	for _, source := range c.Sources {
+		if !sources[source.Source] {
+			return fmt.Errorf("market %s uses source %s that is not configured", c.MarketID, source.Source)
+		}
+		if strings.TrimSpace(source.Symbol) == "" {
+			return fmt.Errorf("market %s source %s symbol cannot be blank", c.MarketID, source.Source)
+		}
+	}
+	return nil
+}
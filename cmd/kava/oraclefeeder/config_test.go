@@ -0,0 +1,121 @@
+package oraclefeeder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/cmd/kava/oraclefeeder"
+)
+
+func TestLoadConfig(t *testing.T) {
+	path := writeFile(t, "feeder.json", `{
+		"grpc_url": "http://localhost:9090",
+		"interval": "30s",
+		"sources": [
+			{"name": "file", "type": "static", "path": "prices.json"},
+			{"name": "api", "type": "http", "url": "http://localhost:8080/prices"},
+			{"name": "pools", "type": "swap_twap", "window": "10m"}
+		],
+		"markets": [
+			{"market_id": "kava:usd", "sources": [{"source": "api", "symbol": "kava.usd"}, {"source": "pools", "symbol": "ukava:usdx"}]}
+		]
+	}`)
+
+	config, err := oraclefeeder.LoadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:9090", config.GrpcURL)
+	require.Equal(t, 30*time.Second, config.Interval.Duration)
+	require.Equal(t, time.Minute, config.Expiry.Duration)
+	require.Equal(t, oraclefeeder.DefaultBatchSize, config.BatchSize)
+	require.Equal(t, oraclefeeder.DefaultMaxRetries, config.MaxRetries)
+	require.Equal(t, oraclefeeder.DefaultRetryDelay, config.RetryDelay.Duration)
+	require.Equal(t, oraclefeeder.DefaultTimeout, config.Sources[1].Timeout.Duration)
+	require.Equal(t, 10*time.Minute, config.Sources[2].Window.Duration)
+	require.Equal(t, 1, config.Markets[0].MinSources)
+
+	_, err = oraclefeeder.LoadConfig(writeFile(t, "invalid.json", `{"interval": 30}`))
+	require.ErrorContains(t, err, "duration must be a string")
+}
+
+func TestConfig_Validate(t *testing.T) {
+	validConfig := func() oraclefeeder.Config {
+		return oraclefeeder.Config{
+			Sources: []oraclefeeder.SourceConfig{
+				{Name: "file", Type: oraclefeeder.SourceTypeStatic, Path: "prices.json"},
+			},
+			Markets: []oraclefeeder.MarketConfig{
+				{MarketID: "kava:usd", Sources: []oraclefeeder.MarketSourceConfig{{Source: "file", Symbol: "kava:usd"}}},
+			},
+		}.WithDefaults()
+	}
+
+	testCases := []struct {
+		msg    string
+		modify func(config *oraclefeeder.Config)
+		expErr string
+	}{
+		{
+			msg:    "valid",
+			modify: func(config *oraclefeeder.Config) {},
+		},
+		{
+			msg:    "negative retries",
+			modify: func(config *oraclefeeder.Config) { config.MaxRetries = -1 },
+			expErr: "max retries cannot be negative",
+		},
+		{
+			msg: "duplicated source",
+			modify: func(config *oraclefeeder.Config) {
+				config.Sources = append(config.Sources, config.Sources[0])
+			},
+			expErr: "duplicated source file",
+		},
+		{
+			msg:    "unknown source type",
+			modify: func(config *oraclefeeder.Config) { config.Sources[0].Type = "exchange" },
+			expErr: "unknown type",
+		},
+		{
+			msg:    "swap twap source without window",
+			modify: func(config *oraclefeeder.Config) { config.Sources[0].Type = oraclefeeder.SourceTypeSwapTWAP },
+			expErr: "must set a positive window",
+		},
+		{
+			msg:    "no markets",
+			modify: func(config *oraclefeeder.Config) { config.Markets = nil },
+			expErr: "no markets configured",
+		},
+		{
+			msg: "duplicated market",
+			modify: func(config *oraclefeeder.Config) {
+				config.Markets = append(config.Markets, config.Markets[0])
+			},
+			expErr: "duplicated market kava:usd",
+		},
+		{
+			msg:    "market source not configured",
+			modify: func(config *oraclefeeder.Config) { config.Markets[0].Sources[0].Source = "api" },
+			expErr: "uses source api that is not configured",
+		},
+		{
+			msg:    "min sources above sources",
+			modify: func(config *oraclefeeder.Config) { config.Markets[0].MinSources = 2 },
+			expErr: "min sources must be between 1 and the number of sources",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			config := validConfig()
+			tc.modify(&config)
+			err := config.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package oraclefeeder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	kavagrpc "github.com/kava-labs/kava/client/grpc"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// Chain is the chain the feeder posts prices to
+type Chain interface {
+	// Account returns the account of an address
+	Account(addr string) (authtypes.AccountI, error)
+	// BroadcastTx broadcasts a signed tx and returns the result of checking it
+	BroadcastTx(ctx context.Context, txBytes []byte) (*sdk.TxResponse, error)
+}
+
+// GrpcChain is a Chain that queries accounts and broadcasts txs using a kava grpc client
+type GrpcChain struct {
+	client *kavagrpc.KavaGrpcClient
+}
+
+var _ Chain = GrpcChain{}
+
+// NewGrpcChain returns a new GrpcChain
+func NewGrpcChain(client *kavagrpc.KavaGrpcClient) GrpcChain {
+	return GrpcChain{
+		client: client,
+	}
+}
+
+// Account implements Chain
+func (c GrpcChain) Account(addr string) (authtypes.AccountI, error) {
+	return c.client.Account(addr)
+}
+
+// BroadcastTx implements Chain
+func (c GrpcChain) BroadcastTx(ctx context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	res, err := c.client.Query.Tx.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.TxResponse, nil
+}
+
+// Feeder computes the prices of markets from its sources and posts them in batches of MsgPostPrices
+type Feeder struct {
+	config   Config
+	sources  map[string]Source
+	chain    Chain
+	txConfig client.TxConfig
+	txf      tx.Factory
+	keyName  string
+	from     sdk.AccAddress
+	logger   log.Logger
+}
+
+// NewFeeder returns a new Feeder that signs txs with the key of the tx factory keyring with the given name. The
+// key must belong to an oracle of the markets, or to the feeder delegated by the oracle.
+func NewFeeder(
+	config Config,
+	sources map[string]Source,
+	chain Chain,
+	txConfig client.TxConfig,
+	txf tx.Factory,
+	keyName string,
+	logger log.Logger,
+) (*Feeder, error) {
+	if txf.Keybase() == nil {
+		return nil, errors.New("tx factory must have a keyring")
+	}
+	key, err := txf.Keybase().Key(keyName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key %s: %w", keyName, err)
+	}
+	from, err := key.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, market := range config.Markets {
+		for _, source := range market.Sources {
+			if _, found := sources[source.Source]; !found {
+				return nil, fmt.Errorf("market %s uses source %s that does not exist", market.MarketID, source.Source)
+			}
+		}
+	}
+
+	return &Feeder{
+		config:   config,
+		sources:  sources,
+		chain:    chain,
+		txConfig: txConfig,
+		txf:      txf,
+		keyName:  keyName,
+		from:     from,
+		logger:   logger,
+	}, nil
+}
+
+// From returns the address the feeder posts prices from
+func (f *Feeder) From() sdk.AccAddress {
+	return f.from
+}
+
+// Run posts prices every interval until the context is done. Failed rounds are logged and retried at the next
+// interval.
+func (f *Feeder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(f.config.Interval.Duration)
+	defer ticker.Stop()
+
+	for {
+		if err := f.FeedPrices(ctx); err != nil {
+			f.logger.Error("failed to post prices", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// FeedPrices computes the current prices of the markets and posts them
+func (f *Feeder) FeedPrices(ctx context.Context) error {
+	prices := f.ComputePrices(ctx)
+	if len(prices) == 0 {
+		return errors.New("no market has enough source prices")
+	}
+	return f.PostPrices(ctx, prices)
+}
+
+// ComputePrices returns the median of the source prices of each market, expiring after the configured expiry.
+// Markets with fewer source prices than their min sources are left out.
+func (f *Feeder) ComputePrices(ctx context.Context) pricefeedtypes.PriceEntries {
+	sourcePrices := f.fetchSourcePrices(ctx)
+	expiry := time.Now().Add(f.config.Expiry.Duration).UTC()
+
+	var entries pricefeedtypes.PriceEntries
+	for _, market := range f.config.Markets {
+		var prices []sdk.Dec
+		for _, source := range market.Sources {
+			price, found := sourcePrices[source.Source][source.Symbol]
+			if !found {
+				continue
+			}
+			if source.Inverse {
+				price = sdk.OneDec().Quo(price)
+			}
+			prices = append(prices, price)
+		}
+
+		if len(prices) < market.MinSources {
+			f.logger.Info("skipping market without enough source prices", "market_id", market.MarketID, "prices", len(prices), "min_sources", market.MinSources)
+			continue
+		}
+		entries = append(entries, pricefeedtypes.NewPriceEntry(market.MarketID, median(prices), expiry))
+	}
+	return entries
+}
+
+// fetchSourcePrices returns the prices of the symbols used by the markets, keyed by source and symbol. Sources that
+// fail are logged and left out.
+func (f *Feeder) fetchSourcePrices(ctx context.Context) map[string]map[string]sdk.Dec {
+	var names []string
+	symbols := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, market := range f.config.Markets {
+		for _, source := range market.Sources {
+			if _, found := symbols[source.Source]; !found {
+				names = append(names, source.Source)
+			}
+			if !seen[source.Source+"/"+source.Symbol] {
+				symbols[source.Source] = append(symbols[source.Source], source.Symbol)
+				seen[source.Source+"/"+source.Symbol] = true
+			}
+		}
+	}
+
+	sourcePrices := make(map[string]map[string]sdk.Dec, len(names))
+	for _, name := range names {
+		prices, err := f.sources[name].Prices(ctx, symbols[name])
+		if err != nil {
+			f.logger.Error("failed to fetch source prices", "source", name, "err", err)
+			continue
+		}
+		sourcePrices[name] = prices
+	}
+	return sourcePrices
+}
+
+// PostPrices posts prices in txs of at most the batch size. Each failed tx is retried up to the max retries with the
+// account sequence queried again, and batches that still fail are skipped.
+func (f *Feeder) PostPrices(ctx context.Context, prices pricefeedtypes.PriceEntries) error {
+	var account authtypes.AccountI
+	var errs []error
+	for start := 0; start < len(prices); start += f.config.BatchSize {
+		end := start + f.config.BatchSize
+		if end > len(prices) {
+			end = len(prices)
+		}
+		msg := pricefeedtypes.NewMsgPostPrices(f.from.String(), prices[start:end])
+
+		var err error
+		for attempt := 0; attempt <= f.config.MaxRetries; attempt++ {
+			if attempt > 0 {
+				f.logger.Error("failed to post prices, retrying", "attempt", attempt, "err", err)
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(f.config.RetryDelay.Duration):
+				}
+			}
+
+			if account == nil {
+				account, err = f.chain.Account(f.from.String())
+				if err != nil {
+					continue
+				}
+			}
+			var res *sdk.TxResponse
+			res, err = f.broadcast(ctx, account, msg)
+			if err != nil {
+				// the account sequence may be out of date, so it is queried again before retrying
+				account = nil
+				continue
+			}
+			if err = account.SetSequence(account.GetSequence() + 1); err != nil {
+				return err
+			}
+			f.logger.Info("posted prices", "prices", len(msg.Prices), "tx_hash", res.TxHash)
+			break
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to post prices for markets %d to %d: %w", start, end-1, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// broadcast signs a tx containing the msg with the account number and sequence of the account and broadcasts it
+func (f *Feeder) broadcast(ctx context.Context, account authtypes.AccountI, msg sdk.Msg) (*sdk.TxResponse, error) {
+	txf := f.txf.
+		WithAccountNumber(account.GetAccountNumber()).
+		WithSequence(account.GetSequence())
+
+	txBuilder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, f.keyName, txBuilder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := f.txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := f.chain.BroadcastTx(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return res, nil
+}
+
+// median returns the median of prices, averaging the two middle prices for an even number of prices
+func median(prices []sdk.Dec) sdk.Dec {
+	sorted := make([]sdk.Dec, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+}
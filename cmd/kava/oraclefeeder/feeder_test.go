@@ -0,0 +1,233 @@
+package oraclefeeder_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/evmos/ethermint/crypto/hd"
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/cmd/kava/oraclefeeder"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swapkeeper "github.com/kava-labs/kava/x/swap/keeper"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+const testChainID = "kavatest_1-1"
+
+func TestMain(m *testing.M) {
+	app.SetSDKConfig()
+	os.Exit(m.Run())
+}
+
+// testChain is an in-process chain that delivers each broadcast tx in its own block
+type testChain struct {
+	tApp      app.TestApp
+	blockTime time.Time
+
+	// failures is the number of broadcasts that fail before txs are delivered
+	failures   int
+	broadcasts int
+}
+
+var _ oraclefeeder.Chain = &testChain{}
+
+func newTestChain(genTime time.Time, genesisStates ...app.GenesisState) *testChain {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStatesWithTimeAndChainID(genTime, testChainID, genesisStates...)
+	return &testChain{
+		tApp:      tApp,
+		blockTime: genTime,
+	}
+}
+
+// deliverCtx returns a context of the current block
+func (c *testChain) deliverCtx() sdk.Context {
+	return c.tApp.NewContext(false, tmproto.Header{Height: c.tApp.LastBlockHeight() + 1, Time: c.blockTime, ChainID: testChainID})
+}
+
+// queryCtx returns a context of the last committed block
+func (c *testChain) queryCtx() sdk.Context {
+	return c.tApp.NewContext(true, tmproto.Header{Height: c.tApp.LastBlockHeight(), Time: c.blockTime, ChainID: testChainID})
+}
+
+// nextBlock ends and commits the current block and begins the next one
+func (c *testChain) nextBlock() {
+	c.tApp.EndBlock(abci.RequestEndBlock{Height: c.tApp.LastBlockHeight() + 1})
+	c.tApp.Commit()
+	c.blockTime = c.blockTime.Add(5 * time.Second)
+	c.tApp.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{Height: c.tApp.LastBlockHeight() + 1, Time: c.blockTime, ChainID: testChainID},
+	})
+}
+
+// Account implements oraclefeeder.Chain
+func (c *testChain) Account(addr string) (authtypes.AccountI, error) {
+	address, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, err
+	}
+	account := c.tApp.GetAccountKeeper().GetAccount(c.queryCtx(), address)
+	if account == nil {
+		return nil, fmt.Errorf("account %s not found", addr)
+	}
+	return account, nil
+}
+
+// BroadcastTx implements oraclefeeder.Chain
+func (c *testChain) BroadcastTx(_ context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	c.broadcasts++
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("connection refused")
+	}
+
+	res := c.tApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	c.nextBlock()
+	return &sdk.TxResponse{
+		TxHash: fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		Code:   res.Code,
+		RawLog: res.Log,
+	}, nil
+}
+
+// newTestTxFactory returns a tx factory with a keyring containing a new key with the given name
+func newTestTxFactory(t *testing.T, keyName string) (tx.Factory, sdk.AccAddress) {
+	encodingConfig := app.MakeEncodingConfig()
+	kr := keyring.NewInMemory(encodingConfig.Marshaler, hd.EthSecp256k1Option())
+	record, _, err := kr.NewMnemonic(keyName, keyring.English, ethermint.BIP44HDPath, keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	txf := tx.Factory{}.
+		WithChainID(testChainID).
+		WithKeybase(kr).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithGas(200000)
+	return txf, addr
+}
+
+func TestFeeder_ComputePrices(t *testing.T) {
+	file := writeFile(t, "prices.json", `{"kava:usd": "1.0", "btc:usd": "20000", "eth:usd": "1800"}`)
+	api := newPricesServer(t, http.StatusOK, `{"kava": {"usd": "1.2"}, "btc": {"usd": "20000"}}`)
+	down := newPricesServer(t, http.StatusServiceUnavailable, "")
+
+	config := oraclefeeder.Config{
+		Markets: []oraclefeeder.MarketConfig{
+			{MarketID: "kava:usd", Sources: []oraclefeeder.MarketSourceConfig{{Source: "file", Symbol: "kava:usd"}, {Source: "api", Symbol: "kava.usd"}}},
+			{MarketID: "usd:btc", Sources: []oraclefeeder.MarketSourceConfig{{Source: "file", Symbol: "btc:usd", Inverse: true}}},
+			{MarketID: "eth:usd", MinSources: 2, Sources: []oraclefeeder.MarketSourceConfig{{Source: "file", Symbol: "eth:usd"}, {Source: "api", Symbol: "eth.usd"}}},
+			{MarketID: "atom:usd", Sources: []oraclefeeder.MarketSourceConfig{{Source: "down", Symbol: "atom.usd"}}},
+		},
+	}.WithDefaults()
+	sources := map[string]oraclefeeder.Source{
+		"file": oraclefeeder.NewStaticSource(file),
+		"api":  oraclefeeder.NewHTTPSource(api.URL, time.Second),
+		"down": oraclefeeder.NewHTTPSource(down.URL, time.Second),
+	}
+
+	txf, _ := newTestTxFactory(t, "feeder")
+	feeder, err := oraclefeeder.NewFeeder(config, sources, nil, app.MakeEncodingConfig().TxConfig, txf, "feeder", log.NewNopLogger())
+	require.NoError(t, err)
+
+	// markets without enough source prices, including from failed sources, are left out
+	prices := feeder.ComputePrices(context.Background())
+	require.Len(t, prices, 2)
+	require.Equal(t, "kava:usd", prices[0].MarketID)
+	require.Equal(t, sdk.MustNewDecFromStr("1.1"), prices[0].Price)
+	require.Equal(t, "usd:btc", prices[1].MarketID)
+	require.Equal(t, sdk.MustNewDecFromStr("0.00005"), prices[1].Price)
+	require.WithinDuration(t, time.Now().Add(config.Expiry.Duration), prices[0].Expiry, time.Minute)
+
+	_, err = oraclefeeder.NewFeeder(config, sources, nil, app.MakeEncodingConfig().TxConfig, txf, "oracle", log.NewNopLogger())
+	require.ErrorContains(t, err, "failed to get key oracle")
+}
+
+func TestFeeder_FeedPrices(t *testing.T) {
+	txf, from := newTestTxFactory(t, "feeder")
+	genTime := time.Now().UTC()
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	pricefeedGenState := pricefeedtypes.NewGenesisState(
+		pricefeedtypes.NewParams([]pricefeedtypes.Market{
+			pricefeedtypes.NewMarket("kava:usd", "kava", "usd", []sdk.AccAddress{from}, true),
+			pricefeedtypes.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{from}, true),
+		}),
+		nil, nil, nil, nil, nil, nil, nil,
+	)
+	chain := newTestChain(
+		genTime,
+		app.NewFundedGenStateWithSameCoins(cdc, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e9)), []sdk.AccAddress{from}),
+		app.GenesisState{pricefeedtypes.ModuleName: cdc.MustMarshalJSON(&pricefeedGenState)},
+	)
+
+	// add price history to the ukava:usdx pool for the swap twap source
+	chain.tApp.GetSwapKeeper().SetPriceObservation(chain.deliverCtx(), swaptypes.NewPriceObservation(
+		"ukava:usdx", genTime.Add(-time.Hour), sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewDec(2), sdk.MustNewDecFromStr("0.5"),
+	))
+	chain.nextBlock()
+	queryHelper := chain.tApp.NewQueryServerTestHelper(chain.queryCtx())
+	swaptypes.RegisterQueryServer(queryHelper, swapkeeper.NewQueryServerImpl(chain.tApp.GetSwapKeeper()))
+
+	api := newPricesServer(t, http.StatusOK, `{"btc": {"usd": "30000"}, "eth": {"usd": "1800"}}`)
+	config := oraclefeeder.Config{
+		BatchSize:  1,
+		MaxRetries: 1,
+		RetryDelay: oraclefeeder.Duration{Duration: time.Millisecond},
+		Sources: []oraclefeeder.SourceConfig{
+			{Name: "api", Type: oraclefeeder.SourceTypeHTTP, URL: api.URL},
+			{Name: "pools", Type: oraclefeeder.SourceTypeSwapTWAP, Window: oraclefeeder.Duration{Duration: 10 * time.Minute}},
+		},
+		Markets: []oraclefeeder.MarketConfig{
+			{MarketID: "kava:usd", Sources: []oraclefeeder.MarketSourceConfig{{Source: "pools", Symbol: "ukava:usdx"}}},
+			{MarketID: "btc:usd", Sources: []oraclefeeder.MarketSourceConfig{{Source: "api", Symbol: "btc.usd"}}},
+			{MarketID: "eth:usd", Sources: []oraclefeeder.MarketSourceConfig{{Source: "api", Symbol: "eth.usd"}}},
+		},
+	}.WithDefaults()
+	require.NoError(t, config.Validate())
+	sources, err := oraclefeeder.NewSources(config.Sources, swaptypes.NewQueryClient(queryHelper))
+	require.NoError(t, err)
+
+	feeder, err := oraclefeeder.NewFeeder(config, sources, chain, app.MakeEncodingConfig().TxConfig, txf, "feeder", log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, from, feeder.From())
+
+	// the first broadcast fails and is retried, and the batch of a market that does not exist fails every attempt
+	chain.failures = 1
+	err = feeder.FeedPrices(context.Background())
+	require.ErrorContains(t, err, "failed to post prices for markets 2 to 2")
+	require.ErrorContains(t, err, pricefeedtypes.ErrInvalidMarket.Error())
+	require.Equal(t, 5, chain.broadcasts)
+
+	k := chain.tApp.GetPriceFeedKeeper()
+	ctx := chain.queryCtx()
+	for marketID, expected := range map[string]sdk.Dec{
+		"kava:usd": sdk.NewDec(2),
+		"btc:usd":  sdk.NewDec(30000),
+	} {
+		rawPrices := k.GetRawPrices(ctx, marketID)
+		require.Len(t, rawPrices, 1, marketID)
+		require.Equal(t, from, rawPrices[0].OracleAddress)
+		require.Equal(t, expected, rawPrices[0].Price)
+
+		currentPrice, err := k.GetCurrentPrice(ctx, marketID)
+		require.NoError(t, err)
+		require.Equal(t, expected, currentPrice.Price)
+	}
+}
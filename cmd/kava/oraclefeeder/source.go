@@ -0,0 +1,227 @@
+package oraclefeeder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// Source is a source of prices
+type Source interface {
+	// Prices returns the prices of the given symbols. Symbols without a price in the source are left out.
+	Prices(ctx context.Context, symbols []string) (map[string]sdk.Dec, error)
+}
+
+// NewSources creates the sources of a config, keyed by name. The swap query client is used by swap_twap sources.
+func NewSources(configs []SourceConfig, swapClient swaptypes.QueryClient) (map[string]Source, error) {
+	sources := make(map[string]Source, len(configs))
+	for _, config := range configs {
+		switch config.Type {
+		case SourceTypeStatic:
+			sources[config.Name] = NewStaticSource(config.Path)
+		case SourceTypeHTTP:
+			sources[config.Name] = NewHTTPSource(config.URL, config.Timeout.Duration)
+		case SourceTypeSwapTWAP:
+			sources[config.Name] = NewSwapTWAPSource(swapClient, config.Window.Duration)
+		default:
+			return nil, fmt.Errorf("source %s has unknown type: %s", config.Name, config.Type)
+		}
+	}
+	return sources, nil
+}
+
+// StaticSource reads prices from a JSON file containing an object of symbols to prices. The file is read again on
+// each call, so that it can be updated while the feeder is running.
+type StaticSource struct {
+	path string
+}
+
+var _ Source = StaticSource{}
+
+// NewStaticSource returns a new StaticSource
+func NewStaticSource(path string) StaticSource {
+	return StaticSource{
+		path: path,
+	}
+}
+
+// Prices implements Source
+func (s StaticSource) Prices(_ context.Context, symbols []string) (map[string]sdk.Dec, error) {
+	bz, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prices file %s: %w", s.path, err)
+	}
+	var values map[string]interface{}
+	if err := decodeJSON(bz, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse prices file %s: %w", s.path, err)
+	}
+
+	prices := make(map[string]sdk.Dec, len(symbols))
+	for _, symbol := range symbols {
+		value, found := values[symbol]
+		if !found {
+			continue
+		}
+		price, err := parsePrice(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price for %s in prices file %s: %w", symbol, s.path, err)
+		}
+		prices[symbol] = price
+	}
+	return prices, nil
+}
+
+// HTTPSource reads prices from a JSON http endpoint. Each symbol is a dot separated path of object keys to a
+// price in the response, such as "kava.usd".
+type HTTPSource struct {
+	url    string
+	client *http.Client
+}
+
+var _ Source = HTTPSource{}
+
+// NewHTTPSource returns a new HTTPSource
+func NewHTTPSource(url string, timeout time.Duration) HTTPSource {
+	return HTTPSource{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Prices implements Source
+func (s HTTPSource) Prices(ctx context.Context, symbols []string) (map[string]sdk.Dec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch prices from %s: %w", s.url, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch prices from %s: status %s", s.url, res.Status)
+	}
+	bz, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prices from %s: %w", s.url, err)
+	}
+	var body interface{}
+	if err := decodeJSON(bz, &body); err != nil {
+		return nil, fmt.Errorf("failed to parse prices from %s: %w", s.url, err)
+	}
+
+	prices := make(map[string]sdk.Dec, len(symbols))
+	for _, symbol := range symbols {
+		value, found := lookupPath(body, symbol)
+		if !found {
+			continue
+		}
+		price, err := parsePrice(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price for %s from %s: %w", symbol, s.url, err)
+		}
+		prices[symbol] = price
+	}
+	return prices, nil
+}
+
+// SwapTWAPSource reads the time-weighted average prices of swap pools over a window ending at the latest block.
+// Each symbol is a pool id, such as "ukava:usdx", and the price is the price of the first denom of the pool in
+// units of the second denom.
+type SwapTWAPSource struct {
+	client swaptypes.QueryClient
+	window time.Duration
+}
+
+var _ Source = SwapTWAPSource{}
+
+// NewSwapTWAPSource returns a new SwapTWAPSource
+func NewSwapTWAPSource(client swaptypes.QueryClient, window time.Duration) SwapTWAPSource {
+	return SwapTWAPSource{
+		client: client,
+		window: window,
+	}
+}
+
+// Prices implements Source
+func (s SwapTWAPSource) Prices(ctx context.Context, symbols []string) (map[string]sdk.Dec, error) {
+	// the window starts before the latest block by the time since it was made, which is small compared to
+	// the window
+	start := time.Now().Add(-s.window)
+
+	prices := make(map[string]sdk.Dec, len(symbols))
+	for _, symbol := range symbols {
+		res, err := s.client.PoolTWAP(ctx, &swaptypes.QueryPoolTWAPRequest{
+			PoolId:    symbol,
+			StartTime: start,
+		})
+		if status.Code(err) == codes.NotFound {
+			// the pool does not exist or its price history does not cover the window
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query twap of pool %s: %w", symbol, err)
+		}
+		prices[symbol] = res.PriceA
+	}
+	return prices, nil
+}
+
+// decodeJSON decodes JSON keeping numbers as json.Number, so that prices are not rounded by float64
+func decodeJSON(bz []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// lookupPath returns the value at a dot separated path of object keys
+func lookupPath(value interface{}, path string) (interface{}, bool) {
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value, ok = object[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// parsePrice parses a positive price from a JSON number or string
+func parsePrice(value interface{}) (sdk.Dec, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return sdk.Dec{}, fmt.Errorf("price must be a number or string: %v", value)
+	}
+
+	price, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("price must be positive: %s", price)
+	}
+	return price, nil
+}
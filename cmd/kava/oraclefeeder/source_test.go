@@ -0,0 +1,71 @@
+package oraclefeeder_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/cmd/kava/oraclefeeder"
+)
+
+// writeFile writes content to a file in a temp dir and returns its path
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// newPricesServer returns a local http stand-in for a price api that responds with the body
+func newPricesServer(t *testing.T, status int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestStaticSource(t *testing.T) {
+	path := writeFile(t, "prices.json", `{"kava:usd": "1.5", "btc:usd": 30000.25, "bad:usd": "-1"}`)
+	source := oraclefeeder.NewStaticSource(path)
+
+	prices, err := source.Prices(context.Background(), []string{"kava:usd", "btc:usd", "eth:usd"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{
+		"kava:usd": sdk.MustNewDecFromStr("1.5"),
+		"btc:usd":  sdk.MustNewDecFromStr("30000.25"),
+	}, prices)
+
+	_, err = source.Prices(context.Background(), []string{"bad:usd"})
+	require.ErrorContains(t, err, "price must be positive")
+
+	_, err = oraclefeeder.NewStaticSource(filepath.Join(t.TempDir(), "missing.json")).Prices(context.Background(), []string{"kava:usd"})
+	require.ErrorContains(t, err, "failed to read prices file")
+}
+
+func TestHTTPSource(t *testing.T) {
+	server := newPricesServer(t, http.StatusOK, `{"kava": {"usd": "0.75"}, "btc": {"usd": 30000}, "eth": "1800"}`)
+	source := oraclefeeder.NewHTTPSource(server.URL, time.Second)
+
+	prices, err := source.Prices(context.Background(), []string{"kava.usd", "btc.usd", "eth.usd", "atom.usd"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{
+		"kava.usd": sdk.MustNewDecFromStr("0.75"),
+		"btc.usd":  sdk.NewDec(30000),
+	}, prices)
+
+	failing := newPricesServer(t, http.StatusInternalServerError, "")
+	_, err = oraclefeeder.NewHTTPSource(failing.URL, time.Second).Prices(context.Background(), []string{"kava.usd"})
+	require.ErrorContains(t, err, "status 500")
+
+	invalid := newPricesServer(t, http.StatusOK, `{"kava": {"usd": true}}`)
+	_, err = oraclefeeder.NewHTTPSource(invalid.URL, time.Second).Prices(context.Background(), []string{"kava.usd"})
+	require.ErrorContains(t, err, "price must be a number or string")
+}
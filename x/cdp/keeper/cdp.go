@@ -628,10 +628,11 @@ func (k Keeper) GetMarketStatus(ctx sdk.Context, marketID string) bool {
 	return bz != nil
 }
 
-// UpdatePricefeedStatus determines if the price of an asset is available and updates the global status of the market
+// UpdatePricefeedStatus determines if the price of an asset is available and updates the global status of the market.
+// Markets halted by the pricefeed circuit breaker are treated as down.
 func (k Keeper) UpdatePricefeedStatus(ctx sdk.Context, marketID string) (ok bool) {
 	_, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
-	if err != nil {
+	if err != nil || k.pricefeedKeeper.IsMarketHalted(ctx, marketID) {
 		k.SetMarketStatus(ctx, marketID, false)
 		return false
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type CdpTestSuite struct {
@@ -391,6 +392,32 @@ func (suite *CdpTestSuite) TestMarketStatus() {
 	suite.Require().False(status)
 }
 
+func (suite *CdpTestSuite) TestUpdatePricefeedStatus_HaltedMarket() {
+	pk := suite.app.GetPriceFeedKeeper()
+	params := pk.GetParams(suite.ctx)
+	maxChange := sdk.MustNewDecFromStr("0.5")
+	for i, market := range params.Markets {
+		if market.MarketID == "xrp:usd" {
+			params.Markets[i].CircuitBreaker = pricefeedtypes.NewCircuitBreaker(&maxChange, nil, time.Hour)
+		}
+	}
+	pk.SetParams(suite.ctx, params)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	suite.True(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+
+	// a price moving past the max change halts the market, which is treated as down
+	_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "xrp:usd", sdk.MustNewDecFromStr("0.025"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	suite.Require().True(pk.IsMarketHalted(suite.ctx, "xrp:usd"))
+
+	price, err := pk.GetCurrentPrice(suite.ctx, "xrp:usd")
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("0.25"), price.Price)
+	suite.False(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+	suite.False(suite.keeper.GetMarketStatus(suite.ctx, "xrp:usd"))
+}
+
 func TestCdpTestSuite(t *testing.T) {
 	suite.Run(t, new(CdpTestSuite))
 }
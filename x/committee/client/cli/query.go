@@ -32,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 		getCmdQueryNextProposalID(),
 		getCmdQueryProposal(),
 		getCmdQueryProposals(),
+		getCmdQueryQueuedProposals(),
 		// votes
 		getCmdQueryVotes(),
 		// other
@@ -193,6 +194,40 @@ func getCmdQueryProposals() *cobra.Command {
 	}
 }
 
+// getCmdQueryQueuedProposals implements a query queued proposals command.
+func getCmdQueryQueuedProposals() *cobra.Command {
+	return &cobra.Command{
+		Use:   "queued-proposals [committee-id]",
+		Short: "Query passed proposals queued for execution, optionally for a committee",
+		Args:  cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s query %[2]s queued-proposals
+%[1]s query %[2]s queued-proposals 1`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var committeeID uint64
+			if len(args) > 0 {
+				committeeID, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("committee-id %s not a valid uint", args[0])
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.QueuedProposals(context.Background(), &types.QueryQueuedProposalsRequest{
+				CommitteeId: committeeID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
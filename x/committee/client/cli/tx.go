@@ -47,8 +47,11 @@ const COMMITTEE_CHANGE_PROPOSAL_EXAMPLE = `
       "permissions": [],
       "vote_threshold": "1.000000000000000000",
       "proposal_duration": "86400s",
-      "tally_option": "TALLY_OPTION_DEADLINE"
-    }
+      "tally_option": "TALLY_OPTION_DEADLINE",
+      "execution_delay": "3600s",
+      "guardian_committee_id": "1"
+    },
+    "member_weights": [{"address": "kava1ze7y9qwdddejmy7jlw4cymqqlt2wh05yhwmrv2", "weight": "2"}]
   }
 }
 `
@@ -62,6 +65,15 @@ const COMMITTEE_DELETE_PROPOSAL_EXAMPLE = `
 }
 `
 
+const VETO_PROPOSAL_EXAMPLE = `
+{
+	"@type": "/kava.committee.v1beta1.VetoProposal",
+  "title": "A Title",
+  "description": "A proposal description.",
+  "proposal_id": "1"
+}
+`
+
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -201,15 +213,18 @@ func GetGovCmdSubmitProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "committee [proposal-file] [deposit]",
 		Short: "Submit a governance proposal to change a committee.",
-		Long: fmt.Sprintf(`Submit a governance proposal to create, alter, or delete a committee.
+		Long: fmt.Sprintf(`Submit a governance proposal to create, alter, or delete a committee, or to veto a queued committee proposal.
 
 The proposal file must be the json encoded form of the proposal type you want to submit.
 For example, to create or update a committee:
 %s
 
-and to delete a committee:
+to delete a committee:
+%s
+
+and to veto a committee proposal queued for execution:
 %s
-`, COMMITTEE_CHANGE_PROPOSAL_EXAMPLE, COMMITTEE_DELETE_PROPOSAL_EXAMPLE),
+`, COMMITTEE_CHANGE_PROPOSAL_EXAMPLE, COMMITTEE_DELETE_PROPOSAL_EXAMPLE, VETO_PROPOSAL_EXAMPLE),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -92,6 +92,26 @@ func (s queryServer) Proposal(c context.Context, req *types.QueryProposalRequest
 	return &proposalResp, nil
 }
 
+// QueuedProposals implements the Query/QueuedProposals gRPC method
+func (s queryServer) QueuedProposals(c context.Context, req *types.QueryQueuedProposalsRequest) (*types.QueryQueuedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var proposalsResp []types.QueryProposalResponse
+	for _, proposal := range s.keeper.GetQueuedProposals(ctx) {
+		if req.CommitteeId != 0 && proposal.CommitteeID != req.CommitteeId {
+			continue
+		}
+		proposalsResp = append(proposalsResp, s.proposalResponseFromProposal(proposal))
+	}
+
+	return &types.QueryQueuedProposalsResponse{
+		Proposals: proposalsResp,
+	}, nil
+}
+
 // NextProposalID implements the Query/NextProposalID gRPC method
 func (s queryServer) NextProposalID(c context.Context, req *types.QueryNextProposalIDRequest) (*types.QueryNextProposalIDResponse, error) {
 	if req == nil {
@@ -188,10 +208,11 @@ func (s queryServer) RawParams(c context.Context, req *types.QueryRawParamsReque
 
 func (s queryServer) proposalResponseFromProposal(proposal types.Proposal) types.QueryProposalResponse {
 	return types.QueryProposalResponse{
-		PubProposal: proposal.Content,
-		ID:          proposal.ID,
-		CommitteeID: proposal.CommitteeID,
-		Deadline:    proposal.Deadline,
+		PubProposal:   proposal.Content,
+		ID:            proposal.ID,
+		CommitteeID:   proposal.CommitteeID,
+		Deadline:      proposal.Deadline,
+		ExecutionTime: proposal.ExecutionTime,
 	}
 }
 
@@ -23,10 +23,15 @@ type Keeper struct {
 
 	// Proposal router
 	router govv1beta1.Router
+
+	// Msg router and the authority msgs of ExecuteMsgsProposals are executed with
+	msgRouter types.MsgRouter
+	authority sdk.AccAddress
 }
 
 func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router,
 	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper,
+	msgRouter types.MsgRouter, authority sdk.AccAddress,
 ) Keeper {
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
@@ -39,9 +44,16 @@ func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.
 		accountKeeper: ak,
 		bankKeeper:    sk,
 		router:        router,
+		msgRouter:     msgRouter,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address msgs of ExecuteMsgsProposals must be signed by.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // ------------------------------------------
 //				Committees
 // ------------------------------------------
@@ -221,6 +233,18 @@ func (k Keeper) GetProposalsByCommittee(ctx sdk.Context, committeeID uint64) typ
 	return results
 }
 
+// GetQueuedProposals returns all passed proposals that are queued for execution.
+func (k Keeper) GetQueuedProposals(ctx sdk.Context) types.Proposals {
+	results := types.Proposals{}
+	k.IterateProposals(ctx, func(prop types.Proposal) bool {
+		if prop.IsQueued() {
+			results = append(results, prop)
+		}
+		return false
+	})
+	return results
+}
+
 // DeleteProposalAndVotes removes a proposal and its associated votes.
 func (k Keeper) DeleteProposalAndVotes(ctx sdk.Context, proposalID uint64) {
 	votes := k.GetVotesByProposal(ctx, proposalID)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -22,7 +23,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	}
 
 	// Check committee has permissions to enact proposal.
-	if !com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, pubProposal) {
+	if !k.hasPermissionsFor(ctx, com, pubProposal) {
 		return 0, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -56,6 +57,9 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 	if !found {
 		return errorsmod.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
+	if pr.IsQueued() {
+		return errorsmod.Wrapf(types.ErrProposalQueued, "%d", proposalID)
+	}
 	if pr.HasExpiredBy(ctx.BlockTime()) {
 		return errorsmod.Wrapf(types.ErrProposalExpired, "%s ≥ %s", ctx.BlockTime(), pr.Deadline)
 	}
@@ -97,13 +101,17 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		return err
 	}
 
-	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
-		return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
+	switch pubProposal.(type) {
+	case *types.ExecuteMsgsProposal, *types.VetoProposal:
+		// handled by the keeper
+	default:
+		if !k.router.HasRoute(pubProposal.ProposalRoute()) {
+			return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
+		}
 	}
 
 	// Run the proposal's changes through the associated handler using a cached version of state to ensure changes are not permanent.
 	cacheCtx, _ := ctx.CacheContext()
-	handler := k.router.GetRoute(pubProposal.ProposalRoute())
 
 	// Handle an edge case where a param change proposal causes the proposal handler to panic.
 	// A param change proposal with a registered subspace value but unregistered key value will cause a panic in the param change proposal handler.
@@ -115,40 +123,171 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		}
 	}()
 
-	if err := handler(cacheCtx, pubProposal); err != nil {
+	if err := k.handlePubProposal(cacheCtx, pubProposal); err != nil {
 		return err
 	}
 	return nil
 }
 
+// handlePubProposal makes the changes of a pubproposal, executing the msgs of ExecuteMsgsProposals, vetoing the
+// proposals of VetoProposals and routing other pubproposals to their gov proposal handler.
+func (k Keeper) handlePubProposal(ctx sdk.Context, pubProposal types.PubProposal) error {
+	switch proposal := pubProposal.(type) {
+	case *types.ExecuteMsgsProposal:
+		return k.executeMsgs(ctx, proposal)
+	case *types.VetoProposal:
+		return k.VetoProposal(ctx, proposal.ProposalID)
+	}
+	handler := k.router.GetRoute(pubProposal.ProposalRoute())
+	return handler(ctx, pubProposal)
+}
+
+// executeMsgs executes the msgs of a proposal in order with the authority of the keeper. Each msg must have the
+// authority as its only signer.
+func (k Keeper) executeMsgs(ctx sdk.Context, proposal *types.ExecuteMsgsProposal) error {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPubProposal, err.Error())
+	}
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(k.authority) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "msg %d must be signed by %s only", i, k.authority)
+		}
+
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "msg %d has unrecognized type %s", i, sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "msg %d", i)
+		}
+		for _, event := range res.GetEvents() {
+			ctx.EventManager().EmitEvent(sdk.Event(event))
+		}
+	}
+	return nil
+}
+
 func (k Keeper) ProcessProposals(ctx sdk.Context) {
 	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		// skip proposals vetoed by a proposal enacted earlier in the iteration
+		if _, found := k.GetProposal(ctx, proposal.ID); !found {
+			return false
+		}
+
 		committee, found := k.GetCommittee(ctx, proposal.CommitteeID)
 		if !found {
 			k.CloseProposal(ctx, proposal, types.Failed)
 			return false
 		}
 
+		if proposal.IsQueued() {
+			if proposal.IsExecutableBy(ctx.BlockTime()) {
+				k.executeProposal(ctx, proposal)
+			}
+			return false
+		}
+
 		if !proposal.HasExpiredBy(ctx.BlockTime()) {
 			if committee.GetTallyOption() == types.TALLY_OPTION_FIRST_PAST_THE_POST {
 				passed := k.GetProposalResult(ctx, proposal.ID, committee)
 				if passed {
-					outcome := k.attemptEnactProposal(ctx, proposal)
-					k.CloseProposal(ctx, proposal, outcome)
+					k.passProposal(ctx, committee, proposal)
 				}
 			}
 		} else {
 			passed := k.GetProposalResult(ctx, proposal.ID, committee)
-			outcome := types.Failed
 			if passed {
-				outcome = k.attemptEnactProposal(ctx, proposal)
+				k.passProposal(ctx, committee, proposal)
+			} else {
+				k.CloseProposal(ctx, proposal, types.Failed)
 			}
-			k.CloseProposal(ctx, proposal, outcome)
 		}
 		return false
 	})
 }
 
+// passProposal queues a passed proposal for execution after the execution delay of its committee, or executes it
+// if the committee has no delay. Veto proposals are always executed immediately so they can't be outlasted.
+func (k Keeper) passProposal(ctx sdk.Context, committee types.Committee, proposal types.Proposal) {
+	_, isVeto := proposal.GetContent().(*types.VetoProposal)
+	if committee.GetExecutionDelay() <= 0 || isVeto {
+		k.executeProposal(ctx, proposal)
+		return
+	}
+
+	proposal.ExecutionTime = ctx.BlockTime().Add(committee.GetExecutionDelay())
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalQueue,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyExecutionTime, proposal.ExecutionTime.String()),
+		),
+	)
+}
+
+// executeProposal attempts to enact a passed proposal and closes it with the outcome.
+func (k Keeper) executeProposal(ctx sdk.Context, proposal types.Proposal) {
+	outcome := k.attemptEnactProposal(ctx, proposal)
+	if outcome == types.Passed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalExecute,
+				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			),
+		)
+	}
+	k.CloseProposal(ctx, proposal, outcome)
+}
+
+// VetoProposal closes a queued proposal without executing it.
+func (k Keeper) VetoProposal(ctx sdk.Context, proposalID uint64) error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if !proposal.IsQueued() {
+		return errorsmod.Wrapf(types.ErrProposalNotQueued, "%d", proposalID)
+	}
+
+	k.CloseProposal(ctx, proposal, types.Vetoed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVeto,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+		),
+	)
+	return nil
+}
+
+// hasPermissionsFor returns whether a committee is authorized to enact a pubproposal. Veto proposals are only
+// allowed for the guardian committee of the committee the vetoed proposal was submitted to.
+func (k Keeper) hasPermissionsFor(ctx sdk.Context, com types.Committee, pubProposal types.PubProposal) bool {
+	veto, ok := pubProposal.(*types.VetoProposal)
+	if !ok {
+		return com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, pubProposal)
+	}
+
+	proposal, found := k.GetProposal(ctx, veto.ProposalID)
+	if !found {
+		return false
+	}
+	vetoedCom, found := k.GetCommittee(ctx, proposal.CommitteeID)
+	if !found {
+		return false
+	}
+	return vetoedCom.GetGuardianCommitteeID() != 0 && vetoedCom.GetGuardianCommitteeID() == com.GetID()
+}
+
 func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
 	switch com := committee.(type) {
 	case *types.MemberCommittee:
@@ -161,16 +300,22 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee
 }
 
 // GetMemberCommitteeProposalResult gets the result of a member committee proposal
-func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
-	currVotes := k.TallyMemberCommitteeVotes(ctx, proposalID)
-	possibleVotes := sdk.NewDec(int64(len(committee.GetMembers())))
+func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee *types.MemberCommittee) bool {
+	currVotes := k.TallyMemberCommitteeVotes(ctx, proposalID, committee)
+	possibleVotes := sdk.NewDecFromInt(committee.GetTotalWeight())
 	return currVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
 }
 
-// TallyMemberCommitteeVotes returns the polling status of a member committee vote
-func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64) (totalVotes sdk.Dec) {
+// TallyMemberCommitteeVotes returns the polling status of a member committee vote, which is the sum of the voting
+// weights of the members that voted
+func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64, committee *types.MemberCommittee) (totalVotes sdk.Dec) {
 	votes := k.GetVotesByProposal(ctx, proposalID)
-	return sdk.NewDec(int64(len(votes)))
+
+	totalWeight := sdkmath.ZeroInt()
+	for _, vote := range votes {
+		totalWeight = totalWeight.Add(sdkmath.NewIntFromUint64(committee.GetMemberWeight(vote.Voter)))
+	}
+	return sdk.NewDecFromInt(totalWeight)
 }
 
 // GetTokenCommitteeProposalResult gets the result of a token committee proposal
@@ -230,7 +375,7 @@ func (k Keeper) enactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	if !found {
 		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID)
 	}
-	if !com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, proposal.GetContent()) {
+	if !k.hasPermissionsFor(ctx, com, proposal.GetContent()) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -239,8 +384,7 @@ func (k Keeper) enactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	}
 
 	// enact the proposal
-	handler := k.router.GetRoute(proposal.GetContent().ProposalRoute())
-	if err := handler(ctx, proposal.GetContent()); err != nil {
+	if err := k.handlePubProposal(ctx, proposal.GetContent()); err != nil {
 		// the handler should not error as it was checked in ValidatePubProposal
 		panic(fmt.Sprintf("unexpected handler error: %s", err))
 	}
@@ -260,8 +404,8 @@ func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*t
 	var proposalTally types.QueryTallyResponse
 	switch com := committee.(type) {
 	case *types.MemberCommittee:
-		currVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID)
-		possibleVotes := sdk.NewDec(int64(len(com.Members)))
+		currVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID, com)
+		possibleVotes := sdk.NewDecFromInt(com.GetTotalWeight())
 		proposalTally = types.QueryTallyResponse{
 			ProposalID:    proposal.ID,
 			YesVotes:      currVotes,
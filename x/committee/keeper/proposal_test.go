@@ -3,8 +3,10 @@ package keeper_test
 import (
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
@@ -16,6 +18,7 @@ import (
 
 	"github.com/kava-labs/kava/x/committee/testutil"
 	"github.com/kava-labs/kava/x/committee/types"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
 	// "github.com/kava-labs/kava/x/pricefeed"
 )
 
@@ -426,7 +429,7 @@ func (suite *keeperTestSuite) TestTallyMemberCommitteeVotes() {
 		)
 
 		// Check that all votes are counted
-		currentVotes := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID)
+		currentVotes := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID, memberCom)
 		suite.Equal(tc.expectedVoteCount, currentVotes)
 	}
 }
@@ -589,12 +592,23 @@ func (suite *keeperTestSuite) TestGetMemberCommitteeProposalResult() {
 		time.Hour*24*7,
 		types.TALLY_OPTION_DEADLINE,
 	)
+	// the first member has a weight of 8 out of a total weight of 12
+	weightedCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:5],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+	)
+	weightedCom.SetMemberWeights([]types.MemberWeight{{Address: suite.Addresses[0], Weight: 8}})
 	var defaultID uint64 = 1
 	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
 
 	testcases := []struct {
 		name           string
-		committee      types.Committee
+		committee      *types.MemberCommittee
 		votes          []types.Vote
 		proposalPasses bool
 	}{
@@ -617,6 +631,34 @@ func (suite *keeperTestSuite) TestGetMemberCommitteeProposalResult() {
 			},
 			proposalPasses: false,
 		},
+		{
+			name:      "enough weighted votes",
+			committee: weightedCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.Addresses[0], VoteType: types.VOTE_TYPE_YES},
+				{ProposalID: defaultID, Voter: suite.Addresses[1], VoteType: types.VOTE_TYPE_YES},
+			},
+			proposalPasses: true,
+		},
+		{
+			name:      "not enough weighted votes from heaviest member",
+			committee: weightedCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.Addresses[0], VoteType: types.VOTE_TYPE_YES},
+			},
+			proposalPasses: false,
+		},
+		{
+			name:      "not enough weighted votes from most members",
+			committee: weightedCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.Addresses[1], VoteType: types.VOTE_TYPE_YES},
+				{ProposalID: defaultID, Voter: suite.Addresses[2], VoteType: types.VOTE_TYPE_YES},
+				{ProposalID: defaultID, Voter: suite.Addresses[3], VoteType: types.VOTE_TYPE_YES},
+				{ProposalID: defaultID, Voter: suite.Addresses[4], VoteType: types.VOTE_TYPE_YES},
+			},
+			proposalPasses: false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -837,6 +879,148 @@ func (suite *keeperTestSuite) TestCloseProposal() {
 	suite.False(found)
 }
 
+func (suite *keeperTestSuite) TestExecuteMsgsProposal() {
+	memberCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:2],
+		[]types.Permission{&types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{{
+			TypeURL: sdk.MsgTypeURL(&communitytypes.MsgUpdateParams{}),
+			FieldConstraints: []types.FieldConstraint{{
+				Path:          "params.staking_rewards_per_second",
+				AllowedValues: []string{`"1000.000000000000000000"`},
+			}},
+		}}}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(tApp.AppCodec(), []types.Committee{memberCom}, []types.Proposal{}, []types.Vote{}),
+	)
+	ctx := tApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+
+	newProposal := func(authority sdk.AccAddress, stakingRewardsPerSecond int64) *types.ExecuteMsgsProposal {
+		params := communitytypes.DefaultParams()
+		params.StakingRewardsPerSecond = sdkmath.LegacyNewDec(stakingRewardsPerSecond)
+		msg := communitytypes.NewMsgUpdateParams(authority, params)
+		proposal := types.MustNewExecuteMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{&msg})
+		return &proposal
+	}
+
+	// msgs must be signed by the committee authority
+	_, err := keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.GetID(), newProposal(suite.Addresses[0], 1000))
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.ErrorContains(err, "must be signed by")
+
+	// msgs must be allowed by the committee permissions
+	_, err = keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.GetID(), newProposal(keeper.GetAuthority(), 2000))
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.ErrorContains(err, "does not have permissions")
+
+	proposalID, err := keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.GetID(), newProposal(keeper.GetAuthority(), 1000))
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+
+	keeper.ProcessProposals(ctx)
+
+	_, found := keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	params, found := tApp.GetCommunityKeeper().GetParams(ctx)
+	suite.Require().True(found)
+	suite.Equal(sdkmath.LegacyNewDec(1000), params.StakingRewardsPerSecond)
+}
+
+func (suite *keeperTestSuite) TestProcessProposals_ExecutionDelay() {
+	delayedCom := types.MustNewMemberCommittee(
+		1,
+		"This committee is for testing.",
+		suite.Addresses[:2],
+		[]types.Permission{&types.TextPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	delayedCom.SetExecutionDelay(time.Hour)
+	delayedCom.SetGuardianCommitteeID(2)
+	guardianCom := types.MustNewMemberCommittee(
+		2,
+		"This committee is for testing.",
+		suite.Addresses[2:4],
+		[]types.Permission{&types.TextPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	// the execution delay of the guardian does not apply to vetoes
+	guardianCom.SetExecutionDelay(24 * time.Hour)
+
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(tApp.AppCodec(), []types.Committee{delayedCom, guardianCom}, []types.Proposal{}, []types.Vote{}),
+	)
+	ctx := tApp.NewContext(false, tmproto.Header{Height: 1, Time: firstBlockTime})
+	textProposal := govv1beta1.NewTextProposal("A Title", "A description of this proposal.")
+
+	// passed proposals are queued until the execution delay has passed
+	proposalID, err := keeper.SubmitProposal(ctx, suite.Addresses[0], delayedCom.GetID(), textProposal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	keeper.ProcessProposals(ctx)
+
+	proposal, found := keeper.GetProposal(ctx, proposalID)
+	suite.Require().True(found)
+	suite.True(proposal.IsQueued())
+	suite.Equal(firstBlockTime.Add(time.Hour), proposal.ExecutionTime)
+	suite.Equal(types.Proposals{proposal}, keeper.GetQueuedProposals(ctx))
+	suite.ErrorIs(keeper.AddVote(ctx, proposalID, suite.Addresses[1], types.VOTE_TYPE_YES), types.ErrProposalQueued)
+
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour - time.Second))
+	keeper.ProcessProposals(ctx)
+	_, found = keeper.GetProposal(ctx, proposalID)
+	suite.True(found)
+
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	keeper.ProcessProposals(ctx)
+	_, found = keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	suite.Equal(types.EventTypeProposalExecute, ctx.EventManager().Events()[0].Type)
+
+	// queued proposals can only be vetoed by the guardian committee
+	proposalID, err = keeper.SubmitProposal(ctx, suite.Addresses[0], delayedCom.GetID(), textProposal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	keeper.ProcessProposals(ctx)
+
+	vetoProposal := types.NewVetoProposal("A Title", "A description of this proposal.", proposalID)
+	_, err = keeper.SubmitProposal(ctx, suite.Addresses[0], delayedCom.GetID(), &vetoProposal)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	vetoID, err := keeper.SubmitProposal(ctx, suite.Addresses[2], guardianCom.GetID(), &vetoProposal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, vetoID, suite.Addresses[2], types.VOTE_TYPE_YES))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.ProcessProposals(ctx)
+	_, found = keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	_, found = keeper.GetProposal(ctx, vetoID)
+	suite.False(found)
+	suite.Empty(keeper.GetQueuedProposals(ctx))
+
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	suite.Contains(eventTypes, types.EventTypeProposalVeto)
+	suite.Contains(eventTypes, types.EventTypeProposalExecute)
+}
+
 func committeeGenState(cdc codec.Codec, committees []types.Committee, proposals []types.Proposal, votes []types.Vote) app.GenesisState {
 	gs := types.NewGenesisState(
 		uint64(len(proposals)+1),
@@ -16,6 +16,8 @@ func NewProposalHandler(k keeper.Keeper) govv1beta1.Handler {
 			return handleCommitteeChangeProposal(ctx, k, c)
 		case *types.CommitteeDeleteProposal:
 			return handleCommitteeDeleteProposal(ctx, k, c)
+		case *types.VetoProposal:
+			return handleVetoProposal(ctx, k, c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
@@ -53,3 +55,11 @@ func handleCommitteeDeleteProposal(ctx sdk.Context, k keeper.Keeper, committeePr
 	k.DeleteCommittee(ctx, committeeProposal.CommitteeID)
 	return nil
 }
+
+func handleVetoProposal(ctx sdk.Context, k keeper.Keeper, vetoProposal *types.VetoProposal) error {
+	if err := vetoProposal.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPubProposal, err.Error())
+	}
+
+	return k.VetoProposal(ctx, vetoProposal.ProposalID)
+}
@@ -236,6 +236,74 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_DeleteCommittee() {
 	}
 }
 
+func (suite *ProposalHandlerTestSuite) TestProposalHandler_VetoProposal() {
+	queuedProposal := types.MustNewProposal(
+		govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 2, 1, testTime.Add(7*24*time.Hour),
+	)
+	queuedProposal.ExecutionTime = testTime.Add(time.Hour)
+	genesis := types.NewGenesisState(
+		3,
+		suite.testGenesis.GetCommittees(),
+		append(suite.testGenesis.Proposals, queuedProposal),
+		suite.testGenesis.Votes,
+	)
+
+	testCases := []struct {
+		name       string
+		proposal   types.VetoProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			proposal:   types.NewVetoProposal("A Title", "A proposal description.", queuedProposal.ID),
+			expectPass: true,
+		},
+		{
+			name:       "proposal not queued",
+			proposal:   types.NewVetoProposal("A Title", "A proposal description.", 1),
+			expectPass: false,
+		},
+		{
+			name:       "unknown proposal",
+			proposal:   types.NewVetoProposal("A Title", "A proposal description.", 3),
+			expectPass: false,
+		},
+		{
+			name:       "invalid title",
+			proposal:   types.NewVetoProposal("", "A proposal description.", queuedProposal.ID),
+			expectPass: false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Setup
+			suite.app = app.NewTestApp()
+			suite.keeper = suite.app.GetCommitteeKeeper()
+			suite.app = suite.app.InitializeFromGenesisStates(
+				NewCommitteeGenState(suite.app.AppCodec(), genesis),
+			)
+			suite.ctx = suite.app.NewContext(true, tmproto.Header{Height: 1, Time: testTime})
+			handler := committee.NewProposalHandler(suite.keeper)
+
+			// Run
+			err := handler(suite.ctx, &tc.proposal)
+
+			// Check
+			if tc.expectPass {
+				suite.NoError(err)
+				// check the vetoed proposal has been removed without affecting other proposals
+				_, found := suite.keeper.GetProposal(suite.ctx, tc.proposal.ProposalID)
+				suite.False(found)
+				_, found = suite.keeper.GetProposal(suite.ctx, 1)
+				suite.True(found)
+			} else {
+				suite.Error(err)
+				testutil.AssertProtoMessageJSON(suite.T(), suite.app.AppCodec(), genesis, committee.ExportGenesis(suite.ctx, suite.keeper))
+			}
+		})
+	}
+}
+
 func TestProposalHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProposalHandlerTestSuite))
 }
@@ -60,6 +60,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*PubProposal)(nil), nil)
 	cdc.RegisterConcrete(CommitteeChangeProposal{}, "kava/CommitteeChangeProposal", nil)
 	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal", nil)
+	cdc.RegisterConcrete(ExecuteMsgsProposal{}, "kava/ExecuteMsgsProposal", nil)
+	cdc.RegisterConcrete(VetoProposal{}, "kava/VetoProposal", nil)
 
 	// Committees
 	cdc.RegisterInterface((*Committee)(nil), nil)
@@ -76,6 +78,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(CommunityCDPRepayDebtPermission{}, "kava/CommunityCDPRepayDebtPermission", nil)
 	cdc.RegisterConcrete(CommunityCDPWithdrawCollateralPermission{}, "kava/CommunityCDPWithdrawCollateralPermission", nil)
 	cdc.RegisterConcrete(CommunityPoolLendWithdrawPermission{}, "kava/CommunityPoolLendWithdrawPermission", nil)
+	cdc.RegisterConcrete(AllowedMsgsPermission{}, "kava/AllowedMsgsPermission", nil)
 
 	// Msgs
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "kava/MsgSubmitProposal")
@@ -114,6 +117,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&CommunityCDPRepayDebtPermission{},
 		&CommunityCDPWithdrawCollateralPermission{},
 		&CommunityPoolLendWithdrawPermission{},
+		&AllowedMsgsPermission{},
 	)
 
 	// Need to register PubProposal here since we use this as alias for the x/gov Content interface for all the proposal implementations used in this module.
@@ -131,11 +135,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&communitytypes.CommunityCDPRepayDebtProposal{},
 		&communitytypes.CommunityCDPWithdrawCollateralProposal{},
 		&communitytypes.CommunityPoolLendWithdrawProposal{},
+		&ExecuteMsgsProposal{},
+		&VetoProposal{},
 	)
 
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&CommitteeChangeProposal{},
 		&CommitteeDeleteProposal{},
+		&VetoProposal{},
 	)
 }
@@ -4,6 +4,7 @@ import (
 	fmt "fmt"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -56,6 +57,13 @@ type Committee interface {
 	SetVoteThreshold(sdk.Dec)
 
 	GetTallyOption() TallyOption
+
+	GetExecutionDelay() time.Duration
+	SetExecutionDelay(time.Duration)
+
+	GetGuardianCommitteeID() uint64
+	SetGuardianCommitteeID(uint64)
+
 	Validate() error
 
 	String() string
@@ -143,10 +151,13 @@ func (c BaseCommittee) String() string {
   	Permissions:               			%s
   	VoteThreshold:            		  %s
 	ProposalDuration:        						%s
-	TallyOption:   						%s`,
+	TallyOption:   						%s
+	ExecutionDelay:        						%s
+	GuardianCommitteeID:   						%d`,
 		c.ID, c.Description, c.GetMembers(), c.Permissions,
 		c.VoteThreshold.String(), c.ProposalDuration.String(),
-		c.TallyOption.String(),
+		c.TallyOption.String(), c.ExecutionDelay.String(),
+		c.GuardianCommitteeID,
 	)
 }
 
@@ -169,6 +180,22 @@ func (c *BaseCommittee) SetProposalDuration(proposalDuration time.Duration) {
 // GetTallyOption is a getter for committee TallyOption
 func (c BaseCommittee) GetTallyOption() TallyOption { return c.TallyOption }
 
+// GetExecutionDelay is a getter for committee ExecutionDelay
+func (c BaseCommittee) GetExecutionDelay() time.Duration { return c.ExecutionDelay }
+
+// SetExecutionDelay is a setter for committee ExecutionDelay
+func (c *BaseCommittee) SetExecutionDelay(executionDelay time.Duration) {
+	c.ExecutionDelay = executionDelay
+}
+
+// GetGuardianCommitteeID is a getter for committee GuardianCommitteeID
+func (c BaseCommittee) GetGuardianCommitteeID() uint64 { return c.GuardianCommitteeID }
+
+// SetGuardianCommitteeID is a setter for committee GuardianCommitteeID
+func (c *BaseCommittee) SetGuardianCommitteeID(guardianCommitteeID uint64) {
+	c.GuardianCommitteeID = guardianCommitteeID
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (c BaseCommittee) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, any := range c.Permissions {
@@ -213,6 +240,11 @@ func (c BaseCommittee) Validate() error {
 		if p == nil {
 			return fmt.Errorf("committee cannot have a nil permission")
 		}
+		if v, ok := p.(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	if c.ProposalDuration < 0 {
@@ -228,6 +260,14 @@ func (c BaseCommittee) Validate() error {
 		return fmt.Errorf("invalid tally option: %d", c.TallyOption)
 	}
 
+	if c.ExecutionDelay < 0 {
+		return fmt.Errorf("invalid execution delay: %s", c.ExecutionDelay)
+	}
+
+	if c.GuardianCommitteeID != 0 && c.GuardianCommitteeID == c.ID {
+		return fmt.Errorf("committee cannot be its own guardian committee")
+	}
+
 	return nil
 }
 
@@ -266,6 +306,57 @@ func MustNewMemberCommittee(id uint64, description string, members []sdk.AccAddr
 // GetType is a getter for committee type
 func (c MemberCommittee) GetType() string { return MemberCommitteeType }
 
+// SetMemberWeights is a setter for committee MemberWeights
+func (c *MemberCommittee) SetMemberWeights(memberWeights []MemberWeight) {
+	c.MemberWeights = memberWeights
+}
+
+// GetMemberWeight returns the voting weight of an address, which is one for members without a weight and zero for
+// addresses that are not members
+func (c MemberCommittee) GetMemberWeight(addr sdk.AccAddress) uint64 {
+	if !c.HasMember(addr) {
+		return 0
+	}
+	for _, mw := range c.MemberWeights {
+		if mw.Address.Equals(addr) {
+			return mw.Weight
+		}
+	}
+	return 1
+}
+
+// GetTotalWeight returns the sum of the voting weights of all members
+func (c MemberCommittee) GetTotalWeight() sdkmath.Int {
+	total := sdkmath.ZeroInt()
+	for _, m := range c.GetMembers() {
+		total = total.Add(sdkmath.NewIntFromUint64(c.GetMemberWeight(m)))
+	}
+	return total
+}
+
+// Validate validates the committee's fields
+func (c MemberCommittee) Validate() error {
+	if err := c.BaseCommittee.Validate(); err != nil {
+		return err
+	}
+
+	weighted := make(map[string]bool, len(c.MemberWeights))
+	for _, mw := range c.MemberWeights {
+		if !c.HasMember(mw.Address) {
+			return fmt.Errorf("member weight address %s is not a committee member", mw.Address)
+		}
+		if weighted[mw.Address.String()] {
+			return fmt.Errorf("committee cannot have duplicate member weights, %s", mw.Address)
+		}
+		if mw.Weight == 0 {
+			return fmt.Errorf("invalid weight for member %s: must be positive", mw.Address)
+		}
+		weighted[mw.Address.String()] = true
+	}
+
+	return nil
+}
+
 // NewTokenCommittee instantiates a new instance of TokenCommittee
 func NewTokenCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission,
 	threshold sdk.Dec, duration time.Duration, tallyOption TallyOption, quorum sdk.Dec, tallyDenom string,
@@ -441,6 +532,16 @@ func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(p.Content, &content)
 }
 
+// IsQueued returns whether the proposal has passed and is queued for execution.
+func (p Proposal) IsQueued() bool {
+	return !p.ExecutionTime.IsZero()
+}
+
+// IsExecutableBy returns whether a queued proposal can be executed at a certain time.
+func (p Proposal) IsExecutableBy(time time.Time) bool {
+	return p.IsQueued() && !time.Before(p.ExecutionTime)
+}
+
 // HasExpiredBy calculates if the proposal will have expired by a certain time.
 // All votes must be cast before deadline, those cast at time == deadline are not valid
 func (p Proposal) HasExpiredBy(time time.Time) bool {
@@ -73,6 +73,11 @@ type BaseCommittee struct {
 	// The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
 	ProposalDuration time.Duration `protobuf:"bytes,6,opt,name=proposal_duration,json=proposalDuration,proto3,stdduration" json:"proposal_duration"`
 	TallyOption      TallyOption   `protobuf:"varint,7,opt,name=tally_option,json=tallyOption,proto3,enum=kava.committee.v1beta1.TallyOption" json:"tally_option,omitempty"`
+	// The length of time a passed proposal is queued for before it is executed, during which it can be vetoed by the
+	// guardian committee or a gov proposal. Proposals are executed as soon as they pass if it is zero.
+	ExecutionDelay time.Duration `protobuf:"bytes,8,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay"`
+	// ID of the committee that can veto the queued proposals of this committee. Zero if there is no guardian committee.
+	GuardianCommitteeID uint64 `protobuf:"varint,9,opt,name=guardian_committee_id,json=guardianCommitteeId,proto3" json:"guardian_committee_id,omitempty"`
 }
 
 func (m *BaseCommittee) Reset()      { *m = BaseCommittee{} }
@@ -107,9 +112,11 @@ func (m *BaseCommittee) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BaseCommittee proto.InternalMessageInfo
 
-// MemberCommittee is an alias of BaseCommittee
+// MemberCommittee is a committee of members that vote on proposals with their voting weights
 type MemberCommittee struct {
 	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
+	// Voting weights of members. Members without a weight have a weight of one.
+	MemberWeights []MemberWeight `protobuf:"bytes,2,rep,name=member_weights,json=memberWeights,proto3" json:"member_weights"`
 }
 
 func (m *MemberCommittee) Reset()      { *m = MemberCommittee{} }
@@ -144,6 +151,45 @@ func (m *MemberCommittee) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MemberCommittee proto.InternalMessageInfo
 
+// MemberWeight is the voting weight of a member of a MemberCommittee
+type MemberWeight struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	Weight  uint64                                        `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *MemberWeight) Reset()         { *m = MemberWeight{} }
+func (m *MemberWeight) String() string { return proto.CompactTextString(m) }
+func (*MemberWeight) ProtoMessage()    {}
+func (*MemberWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2549fd9d70ca349, []int{2}
+}
+func (m *MemberWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberWeight.Merge(m, src)
+}
+func (m *MemberWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberWeight proto.InternalMessageInfo
+
 // TokenCommittee supports voting on proposals by token holders
 type TokenCommittee struct {
 	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
@@ -154,7 +200,7 @@ type TokenCommittee struct {
 func (m *TokenCommittee) Reset()      { *m = TokenCommittee{} }
 func (*TokenCommittee) ProtoMessage() {}
 func (*TokenCommittee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2549fd9d70ca349, []int{2}
+	return fileDescriptor_a2549fd9d70ca349, []int{3}
 }
 func (m *TokenCommittee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,6 +233,7 @@ func init() {
 	proto.RegisterEnum("kava.committee.v1beta1.TallyOption", TallyOption_name, TallyOption_value)
 	proto.RegisterType((*BaseCommittee)(nil), "kava.committee.v1beta1.BaseCommittee")
 	proto.RegisterType((*MemberCommittee)(nil), "kava.committee.v1beta1.MemberCommittee")
+	proto.RegisterType((*MemberWeight)(nil), "kava.committee.v1beta1.MemberWeight")
 	proto.RegisterType((*TokenCommittee)(nil), "kava.committee.v1beta1.TokenCommittee")
 }
 
@@ -195,48 +242,56 @@ func init() {
 }
 
 var fileDescriptor_a2549fd9d70ca349 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xb6, 0x93, 0x90, 0xd2, 0x75, 0x1b, 0xd2, 0xa5, 0x54, 0x4e, 0x85, 0x6c, 0xab, 0x40, 0x15,
-	0x81, 0x62, 0xab, 0xe1, 0xc6, 0x2d, 0xae, 0x13, 0xd5, 0x52, 0x69, 0x22, 0xc7, 0x3d, 0xc0, 0xc5,
-	0xb2, 0xe3, 0x25, 0xb5, 0x1a, 0x67, 0x83, 0x77, 0x53, 0x35, 0x6f, 0xc0, 0x91, 0x63, 0x8f, 0x48,
-	0xbc, 0x42, 0x1f, 0xa2, 0xea, 0xa9, 0xe2, 0x84, 0x38, 0x84, 0x92, 0x3e, 0x05, 0x9c, 0x90, 0xff,
-	0x1a, 0x17, 0x8a, 0x04, 0x07, 0x4e, 0xde, 0xfd, 0xe6, 0x9b, 0x99, 0xfd, 0x66, 0x3e, 0x19, 0x6c,
-	0x1e, 0xda, 0x47, 0xb6, 0xd2, 0xc3, 0xbe, 0xef, 0x51, 0x8a, 0x90, 0x72, 0xb4, 0xe5, 0x20, 0x6a,
-	0x6f, 0xcd, 0x11, 0x79, 0x14, 0x60, 0x8a, 0xe1, 0x5a, 0xc8, 0x93, 0xe7, 0x68, 0xc2, 0x5b, 0xaf,
-	0xf4, 0x30, 0xf1, 0x31, 0xb1, 0x22, 0x96, 0x12, 0x5f, 0xe2, 0x94, 0xf5, 0xd5, 0x3e, 0xee, 0xe3,
-	0x18, 0x0f, 0x4f, 0x09, 0x5a, 0xe9, 0x63, 0xdc, 0x1f, 0x20, 0x25, 0xba, 0x39, 0xe3, 0x37, 0x8a,
-	0x3d, 0x9c, 0x24, 0x21, 0xe1, 0xd7, 0x90, 0x3b, 0x0e, 0x6c, 0xea, 0xe1, 0x61, 0x1c, 0xdf, 0xf8,
-	0x9e, 0x07, 0xcb, 0xaa, 0x4d, 0xd0, 0x76, 0xfa, 0x0a, 0xb8, 0x06, 0x72, 0x9e, 0xcb, 0xb3, 0x12,
-	0x5b, 0x2d, 0xa8, 0xc5, 0xd9, 0x54, 0xcc, 0xe9, 0x9a, 0x91, 0xf3, 0x5c, 0x28, 0x01, 0xce, 0x45,
-	0xa4, 0x17, 0x78, 0xa3, 0x30, 0x9d, 0xcf, 0x49, 0x6c, 0x75, 0xd1, 0xc8, 0x42, 0xd0, 0x01, 0x0b,
-	0x3e, 0xf2, 0x1d, 0x14, 0x10, 0x3e, 0x2f, 0xe5, 0xab, 0x4b, 0xea, 0xce, 0x8f, 0xa9, 0x58, 0xeb,
-	0x7b, 0xf4, 0x60, 0xec, 0x84, 0x32, 0x13, 0x29, 0xc9, 0xa7, 0x46, 0xdc, 0x43, 0x85, 0x4e, 0x46,
-	0x88, 0xc8, 0x8d, 0x5e, 0xaf, 0xe1, 0xba, 0x01, 0x22, 0xe4, 0xd3, 0x69, 0xed, 0x7e, 0x22, 0x38,
-	0x41, 0xd4, 0x09, 0x45, 0xc4, 0x48, 0x0b, 0xc3, 0x16, 0xe0, 0x46, 0x28, 0xf0, 0x3d, 0x42, 0x3c,
-	0x3c, 0x24, 0x7c, 0x41, 0xca, 0x57, 0xb9, 0xfa, 0xaa, 0x1c, 0xab, 0x94, 0x53, 0x95, 0x72, 0x63,
-	0x38, 0x51, 0x4b, 0xe7, 0xa7, 0x35, 0xd0, 0xb9, 0x26, 0x1b, 0xd9, 0x44, 0xb8, 0x0f, 0x4a, 0x47,
-	0x98, 0x22, 0x8b, 0x1e, 0x04, 0x88, 0x1c, 0xe0, 0x81, 0xcb, 0xdf, 0x09, 0x05, 0xa9, 0xf2, 0xd9,
-	0x54, 0x64, 0xbe, 0x4c, 0xc5, 0xcd, 0xbf, 0x78, 0xb6, 0x86, 0x7a, 0xc6, 0x72, 0x58, 0xc5, 0x4c,
-	0x8b, 0xc0, 0x0e, 0x58, 0x19, 0x05, 0x78, 0x84, 0x89, 0x3d, 0xb0, 0xd2, 0x49, 0xf3, 0x45, 0x89,
-	0xad, 0x72, 0xf5, 0xca, 0x6f, 0x8f, 0xd4, 0x12, 0x82, 0x7a, 0x37, 0x6c, 0x7a, 0xf2, 0x55, 0x64,
-	0x8d, 0x72, 0x9a, 0x9d, 0xc6, 0x60, 0x0b, 0x2c, 0x51, 0x7b, 0x30, 0x98, 0x58, 0x38, 0x9e, 0xfb,
-	0x82, 0xc4, 0x56, 0x4b, 0xf5, 0x47, 0xf2, 0xed, 0xde, 0x91, 0xcd, 0x90, 0xdb, 0x8e, 0xa8, 0x06,
-	0x47, 0xe7, 0x97, 0x17, 0x2b, 0x27, 0x1f, 0x44, 0xe6, 0xfc, 0xb4, 0xb6, 0x78, 0xbd, 0xe9, 0x8d,
-	0x63, 0x70, 0xef, 0x65, 0x34, 0xd6, 0xf9, 0xf2, 0x0d, 0x50, 0x72, 0x6c, 0x82, 0xac, 0xeb, 0xc2,
-	0x91, 0x11, 0xb8, 0xfa, 0x93, 0x3f, 0xf5, 0xbb, 0xe1, 0x1d, 0xb5, 0x70, 0x31, 0x15, 0x59, 0x63,
-	0xd9, 0xc9, 0x82, 0xb7, 0x75, 0xbe, 0x64, 0x41, 0xc9, 0xc4, 0x87, 0x68, 0xf8, 0x5f, 0x3b, 0xc3,
-	0x16, 0x28, 0xbe, 0x1d, 0xe3, 0x60, 0xec, 0xc7, 0x6e, 0xfd, 0xe7, 0xe5, 0x26, 0xd9, 0x50, 0x04,
-	0xf1, 0x28, 0x2d, 0x17, 0x0d, 0xb1, 0xcf, 0xe7, 0x23, 0xeb, 0x83, 0x08, 0xd2, 0x42, 0xe4, 0x16,
-	0x89, 0x4f, 0x03, 0xc0, 0x65, 0x76, 0x01, 0x1f, 0x02, 0xde, 0x6c, 0xec, 0xee, 0xbe, 0xb2, 0xda,
-	0x1d, 0x53, 0x6f, 0xef, 0x59, 0xfb, 0x7b, 0xdd, 0x4e, 0x73, 0x5b, 0x6f, 0xe9, 0x4d, 0xad, 0xcc,
-	0xc0, 0xc7, 0x40, 0xba, 0x11, 0x6d, 0xe9, 0x46, 0xd7, 0xb4, 0x3a, 0x8d, 0xae, 0x69, 0x99, 0x3b,
-	0x4d, 0xab, 0xd3, 0xee, 0x9a, 0x65, 0x16, 0x56, 0xc0, 0x83, 0x1b, 0x2c, 0xad, 0xd9, 0xd0, 0x76,
-	0xf5, 0xbd, 0x66, 0x39, 0xb7, 0x5e, 0x78, 0xf7, 0x51, 0x60, 0x54, 0xfd, 0xec, 0x9b, 0xc0, 0x9c,
-	0xcd, 0x04, 0xf6, 0x62, 0x26, 0xb0, 0x97, 0x33, 0x81, 0x7d, 0x7f, 0x25, 0x30, 0x17, 0x57, 0x02,
-	0xf3, 0xf9, 0x4a, 0x60, 0x5e, 0x3f, 0xcb, 0xa8, 0x0e, 0x67, 0x5a, 0x1b, 0xd8, 0x0e, 0x89, 0x4e,
-	0xca, 0x71, 0xe6, 0x6f, 0x15, 0xc9, 0x77, 0x8a, 0x91, 0x4b, 0x9f, 0xff, 0x0c, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x0b, 0x28, 0x7d, 0xcc, 0x04, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5d, 0x6f, 0xd2, 0x60,
+	0x14, 0x6e, 0x81, 0xb1, 0xed, 0x65, 0x30, 0xd6, 0x7d, 0x58, 0x16, 0xd3, 0x36, 0x73, 0x2e, 0x44,
+	0x43, 0xc9, 0xf0, 0xce, 0x3b, 0xba, 0x82, 0x6b, 0xc4, 0x81, 0xa5, 0x8b, 0xd1, 0x9b, 0xa6, 0xa5,
+	0xaf, 0xa5, 0x19, 0xe5, 0xc5, 0xbe, 0x65, 0x8e, 0x7f, 0x60, 0xbc, 0xf2, 0xc2, 0x8b, 0x5d, 0x9a,
+	0xf8, 0x17, 0xf6, 0x23, 0x96, 0x79, 0xb3, 0x78, 0x65, 0xbc, 0xc0, 0xc9, 0xfe, 0x85, 0x57, 0xa6,
+	0x1f, 0x7c, 0xb9, 0x2d, 0x59, 0x8c, 0x5e, 0xd1, 0xf3, 0x9c, 0xe7, 0x9c, 0x9e, 0xe7, 0xf0, 0x1c,
+	0x00, 0x5b, 0x07, 0xda, 0xa1, 0x96, 0x6f, 0x20, 0xdb, 0xb6, 0x5c, 0x17, 0xc2, 0xfc, 0xe1, 0xb6,
+	0x0e, 0x5d, 0x6d, 0x7b, 0x8c, 0xf0, 0x1d, 0x07, 0xb9, 0x88, 0x5a, 0xf3, 0x78, 0xfc, 0x18, 0x0d,
+	0x79, 0xeb, 0x99, 0x06, 0xc2, 0x36, 0xc2, 0xaa, 0xcf, 0xca, 0x07, 0x41, 0x50, 0xb2, 0xbe, 0x62,
+	0x22, 0x13, 0x05, 0xb8, 0xf7, 0x14, 0xa2, 0x19, 0x13, 0x21, 0xb3, 0x05, 0xf3, 0x7e, 0xa4, 0x77,
+	0x5f, 0xe7, 0xb5, 0x76, 0x2f, 0x4c, 0x31, 0x7f, 0xa6, 0x8c, 0xae, 0xa3, 0xb9, 0x16, 0x6a, 0x07,
+	0xf9, 0x8d, 0x8f, 0x33, 0x20, 0x29, 0x68, 0x18, 0xee, 0x0c, 0xa7, 0xa0, 0xd6, 0x40, 0xc4, 0x32,
+	0x68, 0x92, 0x23, 0xb3, 0x31, 0x21, 0x3e, 0xe8, 0xb3, 0x11, 0x49, 0x94, 0x23, 0x96, 0x41, 0x71,
+	0x20, 0x61, 0x40, 0xdc, 0x70, 0xac, 0x8e, 0x57, 0x4e, 0x47, 0x38, 0x32, 0x3b, 0x2f, 0x4f, 0x42,
+	0x94, 0x0e, 0x66, 0x6d, 0x68, 0xeb, 0xd0, 0xc1, 0x74, 0x94, 0x8b, 0x66, 0x17, 0x84, 0xdd, 0x5f,
+	0x7d, 0x36, 0x67, 0x5a, 0x6e, 0xb3, 0xab, 0x7b, 0x32, 0x43, 0x29, 0xe1, 0x47, 0x0e, 0x1b, 0x07,
+	0x79, 0xb7, 0xd7, 0x81, 0x98, 0x2f, 0x36, 0x1a, 0x45, 0xc3, 0x70, 0x20, 0xc6, 0x5f, 0x4f, 0x72,
+	0xcb, 0xa1, 0xe0, 0x10, 0x11, 0x7a, 0x2e, 0xc4, 0xf2, 0xb0, 0x31, 0x55, 0x06, 0x89, 0x0e, 0x74,
+	0x6c, 0x0b, 0x63, 0x0b, 0xb5, 0x31, 0x1d, 0xe3, 0xa2, 0xd9, 0x44, 0x61, 0x85, 0x0f, 0x54, 0xf2,
+	0x43, 0x95, 0x7c, 0xb1, 0xdd, 0x13, 0x52, 0x67, 0x27, 0x39, 0x50, 0x1b, 0x91, 0xe5, 0xc9, 0x42,
+	0x6a, 0x1f, 0xa4, 0x0e, 0x91, 0x0b, 0x55, 0xb7, 0xe9, 0x40, 0xdc, 0x44, 0x2d, 0x83, 0x9e, 0xf1,
+	0x04, 0x09, 0xfc, 0x69, 0x9f, 0x25, 0xbe, 0xf7, 0xd9, 0xad, 0x5b, 0x8c, 0x2d, 0xc2, 0x86, 0x9c,
+	0xf4, 0xba, 0x28, 0xc3, 0x26, 0x54, 0x0d, 0x2c, 0x75, 0x1c, 0xd4, 0x41, 0x58, 0x6b, 0xa9, 0xc3,
+	0x4d, 0xd3, 0x71, 0x8e, 0xcc, 0x26, 0x0a, 0x99, 0x2b, 0x43, 0x8a, 0x21, 0x41, 0x98, 0xf3, 0x5e,
+	0x7a, 0xfc, 0x83, 0x25, 0xe5, 0xf4, 0xb0, 0x7a, 0x98, 0xa3, 0xca, 0x60, 0xc1, 0xd5, 0x5a, 0xad,
+	0x9e, 0x8a, 0x82, 0xbd, 0xcf, 0x72, 0x64, 0x36, 0x55, 0xb8, 0xc7, 0x5f, 0xef, 0x1d, 0x5e, 0xf1,
+	0xb8, 0x55, 0x9f, 0x2a, 0x27, 0xdc, 0x71, 0x40, 0x55, 0xc0, 0x22, 0x3c, 0x82, 0x8d, 0xae, 0x17,
+	0xa8, 0x06, 0x6c, 0x69, 0x3d, 0x7a, 0xee, 0xf6, 0x73, 0xa5, 0x46, 0xb5, 0xa2, 0x57, 0x4a, 0x3d,
+	0x05, 0xab, 0x66, 0x57, 0x73, 0x0c, 0x4b, 0x6b, 0xab, 0xa3, 0x21, 0x54, 0xcb, 0xa0, 0xe7, 0x7d,
+	0xdf, 0xdc, 0x19, 0xf4, 0xd9, 0xe5, 0x27, 0x21, 0x61, 0x64, 0x2d, 0x49, 0x94, 0x97, 0xcd, 0x2b,
+	0xa0, 0xf1, 0x78, 0xe9, 0xf8, 0x13, 0x4b, 0x9c, 0x9d, 0xe4, 0xe6, 0x47, 0xe0, 0xc6, 0x17, 0x12,
+	0x2c, 0x3e, 0xf3, 0xbf, 0xf2, 0xb1, 0x31, 0x65, 0x90, 0xd2, 0x35, 0x0c, 0xc7, 0xef, 0xf3, 0x4d,
+	0x9a, 0x28, 0xdc, 0xbf, 0x69, 0x17, 0x53, 0xbe, 0x16, 0x62, 0xe7, 0x7d, 0x96, 0x94, 0x93, 0xfa,
+	0x94, 0xd9, 0x9f, 0x83, 0x54, 0xe0, 0x2c, 0xf5, 0x2d, 0xb4, 0xcc, 0xa6, 0x8b, 0xe9, 0x88, 0xef,
+	0xa8, 0xcd, 0x9b, 0x7a, 0x06, 0x43, 0xbd, 0xf0, 0xc9, 0x42, 0xcc, 0xdb, 0x8f, 0x9c, 0xb4, 0x27,
+	0x30, 0x7c, 0x9d, 0x9a, 0xf7, 0x24, 0x58, 0x98, 0x2c, 0xf4, 0x2e, 0x45, 0x0b, 0xec, 0xed, 0x6b,
+	0xf8, 0xa7, 0x97, 0x12, 0x36, 0xa6, 0xd6, 0x40, 0x3c, 0xd0, 0xe4, 0x9f, 0x6a, 0x4c, 0x0e, 0xa3,
+	0x8d, 0x0b, 0x12, 0xa4, 0x14, 0x74, 0x00, 0xdb, 0xff, 0x77, 0xb3, 0x65, 0x10, 0x7f, 0xd3, 0x45,
+	0x4e, 0xd7, 0xa6, 0x23, 0x7f, 0x75, 0x58, 0x61, 0x35, 0xc5, 0x82, 0xc0, 0xc6, 0xaa, 0x01, 0xdb,
+	0xc8, 0xa6, 0xa3, 0xfe, 0xcf, 0x0e, 0xf0, 0x21, 0xd1, 0x43, 0xae, 0xd9, 0xf7, 0x03, 0x07, 0x24,
+	0x26, 0xee, 0x80, 0xba, 0x0b, 0x68, 0xa5, 0x58, 0xa9, 0xbc, 0x54, 0xab, 0x35, 0x45, 0xaa, 0xee,
+	0xa9, 0xfb, 0x7b, 0xf5, 0x5a, 0x69, 0x47, 0x2a, 0x4b, 0x25, 0x31, 0x4d, 0x50, 0x9b, 0x80, 0x9b,
+	0xca, 0x96, 0x25, 0xb9, 0xae, 0xa8, 0xb5, 0x62, 0x5d, 0x51, 0x95, 0xdd, 0x92, 0x5a, 0xab, 0xd6,
+	0x95, 0x34, 0x49, 0x65, 0xc0, 0xea, 0x14, 0x4b, 0x2c, 0x15, 0xc5, 0x8a, 0xb4, 0x57, 0x4a, 0x47,
+	0xd6, 0x63, 0xef, 0x3e, 0x33, 0x84, 0x20, 0x9d, 0xfe, 0x64, 0x88, 0xd3, 0x01, 0x43, 0x9e, 0x0f,
+	0x18, 0xf2, 0x62, 0xc0, 0x90, 0x1f, 0x2e, 0x19, 0xe2, 0xfc, 0x92, 0x21, 0xbe, 0x5d, 0x32, 0xc4,
+	0xab, 0x87, 0x13, 0xaa, 0xbd, 0x9d, 0xe6, 0x5a, 0x9a, 0x8e, 0xfd, 0xa7, 0xfc, 0xd1, 0xc4, 0x3f,
+	0x85, 0x2f, 0x5f, 0x8f, 0xfb, 0x97, 0xf8, 0xe8, 0xf7, 0x00, 0x70, 0x21, 0x5c, 0xf2, 0x48, 0x06,
+	0x00, 0x00,
 }
 
 func (m *BaseCommittee) Marshal() (dAtA []byte, err error) {
@@ -259,18 +314,31 @@ func (m *BaseCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.TallyOption != 0 {
-		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
+	if m.GuardianCommitteeID != 0 {
+		i = encodeVarintCommittee(dAtA, i, uint64(m.GuardianCommitteeID))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x48
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProposalDuration):])
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExecutionDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionDelay):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintCommittee(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x42
+	if m.TallyOption != 0 {
+		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProposalDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCommittee(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0x32
 	{
 		size := m.VoteThreshold.Size()
@@ -340,6 +408,20 @@ func (m *MemberCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MemberWeights) > 0 {
+		for iNdEx := len(m.MemberWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommittee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.BaseCommittee != nil {
 		{
 			size, err := m.BaseCommittee.MarshalToSizedBuffer(dAtA[:i])
@@ -355,6 +437,41 @@ func (m *MemberCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MemberWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintCommittee(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCommittee(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TokenCommittee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -450,6 +567,11 @@ func (m *BaseCommittee) Size() (n int) {
 	if m.TallyOption != 0 {
 		n += 1 + sovCommittee(uint64(m.TallyOption))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionDelay)
+	n += 1 + l + sovCommittee(uint64(l))
+	if m.GuardianCommitteeID != 0 {
+		n += 1 + sovCommittee(uint64(m.GuardianCommitteeID))
+	}
 	return n
 }
 
@@ -463,6 +585,28 @@ func (m *MemberCommittee) Size() (n int) {
 		l = m.BaseCommittee.Size()
 		n += 1 + l + sovCommittee(uint64(l))
 	}
+	if len(m.MemberWeights) > 0 {
+		for _, e := range m.MemberWeights {
+			l = e.Size()
+			n += 1 + l + sovCommittee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemberWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCommittee(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovCommittee(uint64(m.Weight))
+	}
 	return n
 }
 
@@ -723,6 +867,58 @@ func (m *BaseCommittee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianCommitteeID", wireType)
+			}
+			m.GuardianCommitteeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianCommitteeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
@@ -809,6 +1005,143 @@ func (m *MemberCommittee) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberWeights = append(m.MemberWeights, MemberWeight{})
+			if err := m.MemberWeights[len(m.MemberWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommittee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommittee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
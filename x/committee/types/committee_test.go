@@ -184,6 +184,67 @@ func TestBaseCommittee(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "execution delay and guardian",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetExecutionDelay(time.Hour)
+				committee.SetGuardianCommitteeID(2)
+				return committee, nil
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative execution delay",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetExecutionDelay(-time.Hour)
+				return committee, nil
+			},
+			expectPass: false,
+		},
+		{
+			name: "committee is its own guardian",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetGuardianCommitteeID(1)
+				return committee, nil
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -230,6 +291,86 @@ func TestMemberCommittee(t *testing.T) {
 			},
 			expectPass: true,
 		},
+		{
+			name: "member weights",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetMemberWeights([]types.MemberWeight{{Address: addresses[0], Weight: 3}, {Address: addresses[1], Weight: 2}})
+				return committee, nil
+			},
+			expectPass: true,
+		},
+		{
+			name: "member weight of non-member",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetMemberWeights([]types.MemberWeight{{Address: addresses[2], Weight: 3}})
+				return committee, nil
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate member weights",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetMemberWeights([]types.MemberWeight{{Address: addresses[0], Weight: 3}, {Address: addresses[0], Weight: 2}})
+				return committee, nil
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero member weight",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This member committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetMemberWeights([]types.MemberWeight{{Address: addresses[0], Weight: 0}})
+				return committee, nil
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -248,6 +389,30 @@ func TestMemberCommittee(t *testing.T) {
 	}
 }
 
+func TestMemberCommittee_GetMemberWeight(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest2"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest3"))),
+	}
+	committee := types.MustNewMemberCommittee(
+		1,
+		"This member committee is for testing.",
+		addresses[:2],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	require.Equal(t, sdk.NewInt(2), committee.GetTotalWeight())
+
+	committee.SetMemberWeights([]types.MemberWeight{{Address: addresses[0], Weight: 5}})
+	require.Equal(t, uint64(5), committee.GetMemberWeight(addresses[0]))
+	require.Equal(t, uint64(1), committee.GetMemberWeight(addresses[1]))
+	require.Equal(t, uint64(0), committee.GetMemberWeight(addresses[2]))
+	require.Equal(t, sdk.NewInt(6), committee.GetTotalWeight())
+}
+
 // TestTokenCommittee tests unique TokenCommittee functionality
 func TestTokenCommittee(t *testing.T) {
 	addresses := []sdk.AccAddress{
@@ -2,14 +2,18 @@ package types
 
 // Module event types
 const (
-	EventTypeProposalSubmit = "proposal_submit"
-	EventTypeProposalClose  = "proposal_close"
-	EventTypeProposalVote   = "proposal_vote"
+	EventTypeProposalSubmit  = "proposal_submit"
+	EventTypeProposalClose   = "proposal_close"
+	EventTypeProposalVote    = "proposal_vote"
+	EventTypeProposalQueue   = "proposal_queue"
+	EventTypeProposalExecute = "proposal_execute"
+	EventTypeProposalVeto    = "proposal_veto"
 
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
 	AttributeKeyProposalID          = "proposal_id"
 	AttributeKeyDeadline            = "deadline"
+	AttributeKeyExecutionTime       = "execution_time"
 	AttributeKeyProposalCloseStatus = "status"
 	AttributeKeyVoter               = "voter"
 	AttributeKeyVote                = "vote"
@@ -113,6 +113,8 @@ type Proposal struct {
 	ID          uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	CommitteeID uint64     `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
 	Deadline    time.Time  `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	// Time a passed proposal is queued to be executed at. Zero if the proposal has not been queued.
+	ExecutionTime time.Time `protobuf:"bytes,5,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
@@ -199,48 +201,49 @@ func init() {
 }
 
 var fileDescriptor_919b27ac60d8c5fd = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x1d, 0x53, 0x92, 0x4b, 0x1a, 0xd2, 0xa3, 0xad, 0xd2, 0x08, 0xd9, 0x55, 0xc5, 0x50,
-	0x81, 0x62, 0xab, 0x65, 0x41, 0x15, 0x48, 0xc4, 0x49, 0x00, 0x2f, 0x69, 0x71, 0x42, 0xa5, 0x32,
-	0x10, 0x39, 0xf1, 0x61, 0xac, 0x26, 0xbe, 0x28, 0x77, 0x8d, 0x9a, 0x6f, 0xd0, 0xb1, 0x23, 0x23,
-	0x12, 0x4c, 0xcc, 0xfd, 0x10, 0x55, 0xa7, 0x8a, 0x89, 0x01, 0xb9, 0xc8, 0xfd, 0x06, 0x8c, 0x4c,
-	0xe8, 0xce, 0x7f, 0x12, 0x51, 0x3a, 0xf9, 0xdd, 0x7b, 0xbf, 0xf7, 0xe7, 0xf7, 0x7b, 0x4f, 0x06,
-	0x0f, 0x0f, 0xed, 0x89, 0xad, 0xf7, 0xf1, 0x70, 0xe8, 0x51, 0x8a, 0x90, 0x3e, 0xd9, 0xea, 0x21,
-	0x6a, 0x6f, 0xe9, 0x2e, 0xf2, 0x11, 0xf1, 0x88, 0x36, 0x1a, 0x63, 0x8a, 0xe1, 0x2a, 0x43, 0x69,
-	0x29, 0x4a, 0x8b, 0x51, 0x95, 0xb5, 0x3e, 0x26, 0x43, 0x4c, 0xba, 0x1c, 0xa5, 0x47, 0x8f, 0x28,
-	0xa5, 0xb2, 0xec, 0x62, 0x17, 0x47, 0x7e, 0x66, 0xc5, 0xde, 0x35, 0x17, 0x63, 0x77, 0x80, 0x74,
-	0xfe, 0xea, 0x1d, 0x7d, 0xd0, 0x6d, 0x7f, 0x1a, 0x87, 0xd4, 0x7f, 0x43, 0xd4, 0x1b, 0x22, 0x42,
-	0xed, 0xe1, 0x28, 0x02, 0x6c, 0x7c, 0x95, 0x40, 0xe1, 0x55, 0x34, 0x56, 0x9b, 0xda, 0x14, 0xc1,
-	0x67, 0xa0, 0xe4, 0xa3, 0x63, 0xca, 0xba, 0x8f, 0x30, 0xb1, 0x07, 0x5d, 0xcf, 0x29, 0x8b, 0xeb,
-	0xe2, 0xa6, 0x6c, 0xc0, 0x30, 0x50, 0x8b, 0x2d, 0x74, 0x4c, 0xf7, 0xe2, 0x90, 0xd9, 0xb0, 0x8a,
-	0xfe, 0xfc, 0xdb, 0x81, 0x75, 0x00, 0x52, 0x42, 0xa4, 0x2c, 0xad, 0x67, 0x36, 0xf3, 0xdb, 0xcb,
-	0x5a, 0x34, 0x84, 0x96, 0x0c, 0xa1, 0xd5, 0xfc, 0xa9, 0xb1, 0x78, 0x71, 0x56, 0xcd, 0xd5, 0x13,
-	0xac, 0x35, 0x97, 0x06, 0xdf, 0x80, 0x5c, 0xd2, 0x9d, 0x94, 0x33, 0xbc, 0xc6, 0xba, 0xf6, 0x7f,
-	0xb1, 0xb4, 0xa4, 0xb7, 0xb1, 0x74, 0x1e, 0xa8, 0xc2, 0xb7, 0x2b, 0x35, 0x97, 0x78, 0x88, 0x35,
-	0xab, 0x02, 0x9f, 0x82, 0x3b, 0x13, 0x4c, 0x11, 0x29, 0xcb, 0xbc, 0xdc, 0x83, 0xdb, 0xca, 0xed,
-	0x63, 0x8a, 0x0c, 0x99, 0x95, 0xb2, 0xa2, 0x84, 0x1d, 0xf9, 0xe4, 0xb3, 0x2a, 0x6c, 0xfc, 0x16,
-	0x41, 0x36, 0x29, 0x0c, 0x5b, 0xe0, 0x6e, 0x1f, 0xfb, 0x14, 0xf9, 0x94, 0x2b, 0x73, 0x1b, 0x43,
-	0xe5, 0xe2, 0xac, 0x5a, 0x89, 0xd7, 0xe7, 0xe2, 0x49, 0xda, 0xa3, 0x1e, 0xe5, 0x5a, 0x49, 0x11,
-	0xb8, 0x0a, 0x24, 0xcf, 0x29, 0x4b, 0x5c, 0xe4, 0x85, 0x30, 0x50, 0x25, 0xb3, 0x61, 0x49, 0x9e,
-	0x03, 0xb7, 0x41, 0x21, 0x9d, 0x90, 0xad, 0x21, 0xc3, 0x11, 0xf7, 0xc2, 0x40, 0xcd, 0xa7, 0xc2,
-	0x99, 0x0d, 0x2b, 0x9f, 0x82, 0x4c, 0x07, 0xbe, 0x00, 0x59, 0x07, 0xd9, 0xce, 0xc0, 0xf3, 0x51,
-	0x59, 0xe6, 0xc3, 0x55, 0x6e, 0x0c, 0xd7, 0x49, 0x6e, 0xc0, 0xc8, 0x32, 0xa6, 0xa7, 0x57, 0xaa,
-	0x68, 0xa5, 0x59, 0x3b, 0x59, 0x46, 0xf8, 0x13, 0x23, 0xfd, 0x53, 0x04, 0x32, 0x13, 0x04, 0xea,
-	0x20, 0x7f, 0xf3, 0x1c, 0x8a, 0x61, 0xa0, 0x82, 0xb9, 0x53, 0x00, 0xa3, 0xd9, 0x19, 0xbc, 0x8f,
-	0xe4, 0x1e, 0x73, 0x52, 0x05, 0xe3, 0xf5, 0x9f, 0x40, 0xad, 0xba, 0x1e, 0xfd, 0x78, 0xd4, 0x63,
-	0x9a, 0xc7, 0x37, 0x1d, 0x7f, 0xaa, 0xc4, 0x39, 0xd4, 0xe9, 0x74, 0x84, 0x88, 0x56, 0xeb, 0xf7,
-	0x6b, 0x8e, 0x33, 0x46, 0x84, 0x7c, 0x3f, 0xab, 0xde, 0x8f, 0xa5, 0x8b, 0x3d, 0xc6, 0x94, 0x22,
-	0x12, 0x2d, 0x65, 0x0c, 0x9f, 0x83, 0x1c, 0x33, 0xba, 0x2c, 0x8d, 0xcb, 0x52, 0xbc, 0xfd, 0x42,
-	0x18, 0x83, 0xce, 0x74, 0x84, 0xac, 0xec, 0x24, 0xb6, 0xa2, 0x9d, 0x3e, 0x72, 0x41, 0x36, 0x89,
-	0xc1, 0x35, 0xb0, 0xb2, 0xbf, 0xdb, 0x69, 0x76, 0x3b, 0x07, 0x7b, 0xcd, 0xee, 0xdb, 0x56, 0x7b,
-	0xaf, 0x59, 0x37, 0x5f, 0x9a, 0xcd, 0x46, 0x49, 0x80, 0x4b, 0x60, 0x71, 0x16, 0x3a, 0x68, 0xb6,
-	0x4b, 0x22, 0x2c, 0x81, 0xc2, 0xcc, 0xd5, 0xda, 0x2d, 0x49, 0x70, 0x05, 0x2c, 0xcd, 0x3c, 0x35,
-	0xa3, 0xdd, 0xa9, 0x99, 0xad, 0x52, 0xa6, 0x22, 0x9f, 0x7c, 0x51, 0x04, 0xa3, 0x79, 0x1e, 0x2a,
-	0xe2, 0x65, 0xa8, 0x88, 0xbf, 0x42, 0x45, 0x3c, 0xbd, 0x56, 0x84, 0xcb, 0x6b, 0x45, 0xf8, 0x71,
-	0xad, 0x08, 0xef, 0x1e, 0xcf, 0x89, 0xc2, 0xc6, 0xaf, 0x0e, 0xec, 0x1e, 0xe1, 0x96, 0x7e, 0x3c,
-	0xf7, 0xff, 0xe0, 0xea, 0xf4, 0x16, 0xf8, 0x02, 0x9f, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x6a,
-	0x30, 0x5b, 0x09, 0x5e, 0x04, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0x8d, 0x1d, 0xb7, 0xbf, 0x64, 0x93, 0xe6, 0x97, 0x2e, 0x6d, 0xe5, 0x46, 0xc8, 0xae, 0x2a,
+	0x0e, 0x15, 0x28, 0xb6, 0x5a, 0x2e, 0xa8, 0x02, 0x89, 0x38, 0x09, 0x60, 0x21, 0xa5, 0xc5, 0x09,
+	0x95, 0xca, 0x81, 0xc8, 0x89, 0x17, 0x63, 0x35, 0xf1, 0x46, 0xd9, 0x6d, 0x94, 0x7c, 0x83, 0x1e,
+	0x7b, 0xe4, 0x88, 0x04, 0x27, 0xce, 0xbd, 0x73, 0xad, 0x7a, 0xaa, 0x38, 0x71, 0x40, 0x29, 0x72,
+	0xbf, 0x05, 0x27, 0xb4, 0xeb, 0x3f, 0x89, 0x28, 0x3d, 0x70, 0xca, 0xec, 0xcc, 0x9b, 0x37, 0x33,
+	0x6f, 0x26, 0x06, 0xf7, 0x8e, 0xec, 0x91, 0xad, 0x77, 0x71, 0xbf, 0xef, 0x51, 0x8a, 0x90, 0x3e,
+	0xda, 0xee, 0x20, 0x6a, 0x6f, 0xeb, 0x2e, 0xf2, 0x11, 0xf1, 0x88, 0x36, 0x18, 0x62, 0x8a, 0xe1,
+	0x1a, 0x43, 0x69, 0x09, 0x4a, 0x8b, 0x50, 0xa5, 0xf5, 0x2e, 0x26, 0x7d, 0x4c, 0xda, 0x1c, 0xa5,
+	0x87, 0x8f, 0x30, 0xa5, 0xb4, 0xe2, 0x62, 0x17, 0x87, 0x7e, 0x66, 0x45, 0xde, 0x75, 0x17, 0x63,
+	0xb7, 0x87, 0x74, 0xfe, 0xea, 0x1c, 0xbf, 0xd3, 0x6d, 0x7f, 0x12, 0x85, 0xd4, 0x3f, 0x43, 0xd4,
+	0xeb, 0x23, 0x42, 0xed, 0xfe, 0x20, 0x04, 0x6c, 0x7e, 0x16, 0x41, 0xfe, 0x79, 0xd8, 0x56, 0x93,
+	0xda, 0x14, 0xc1, 0xc7, 0xa0, 0xe8, 0xa3, 0x31, 0x65, 0xd5, 0x07, 0x98, 0xd8, 0xbd, 0xb6, 0xe7,
+	0xc8, 0xc2, 0x86, 0xb0, 0x25, 0x19, 0x30, 0x98, 0xaa, 0x85, 0x06, 0x1a, 0xd3, 0xfd, 0x28, 0x64,
+	0xd6, 0xac, 0x82, 0x3f, 0xff, 0x76, 0x60, 0x15, 0x80, 0x64, 0x20, 0x22, 0x8b, 0x1b, 0xe9, 0xad,
+	0xdc, 0xce, 0x8a, 0x16, 0x36, 0xa1, 0xc5, 0x4d, 0x68, 0x15, 0x7f, 0x62, 0x2c, 0x5d, 0x9c, 0x95,
+	0xb3, 0xd5, 0x18, 0x6b, 0xcd, 0xa5, 0xc1, 0x57, 0x20, 0x1b, 0x57, 0x27, 0x72, 0x9a, 0x73, 0x6c,
+	0x68, 0x7f, 0x17, 0x4b, 0x8b, 0x6b, 0x1b, 0xcb, 0xe7, 0x53, 0x35, 0xf5, 0xe5, 0x4a, 0xcd, 0xc6,
+	0x1e, 0x62, 0xcd, 0x58, 0xe0, 0x23, 0xb0, 0x30, 0xc2, 0x14, 0x11, 0x59, 0xe2, 0x74, 0x77, 0x6f,
+	0xa3, 0x3b, 0xc0, 0x14, 0x19, 0x12, 0xa3, 0xb2, 0xc2, 0x84, 0x5d, 0xe9, 0xe4, 0xa3, 0x9a, 0xda,
+	0xfc, 0x2a, 0x82, 0x4c, 0x4c, 0x0c, 0x1b, 0xe0, 0xbf, 0x2e, 0xf6, 0x29, 0xf2, 0x29, 0x57, 0xe6,
+	0xb6, 0x09, 0x95, 0x8b, 0xb3, 0x72, 0x29, 0x5a, 0x9f, 0x8b, 0x47, 0x49, 0x8d, 0x6a, 0x98, 0x6b,
+	0xc5, 0x24, 0x70, 0x0d, 0x88, 0x9e, 0x23, 0x8b, 0x5c, 0xe4, 0xc5, 0x60, 0xaa, 0x8a, 0x66, 0xcd,
+	0x12, 0x3d, 0x07, 0xee, 0x80, 0x7c, 0xd2, 0x21, 0x5b, 0x43, 0x9a, 0x23, 0xfe, 0x0f, 0xa6, 0x6a,
+	0x2e, 0x11, 0xce, 0xac, 0x59, 0xb9, 0x04, 0x64, 0x3a, 0xf0, 0x29, 0xc8, 0x38, 0xc8, 0x76, 0x7a,
+	0x9e, 0x8f, 0x64, 0x89, 0x37, 0x57, 0xba, 0xd1, 0x5c, 0x2b, 0xbe, 0x01, 0x23, 0xc3, 0x26, 0x3d,
+	0xbd, 0x52, 0x05, 0x2b, 0xc9, 0x82, 0x2f, 0x41, 0x01, 0x8d, 0x51, 0xf7, 0x98, 0x7a, 0xd8, 0x6f,
+	0xb3, 0x73, 0x91, 0x17, 0xfe, 0x81, 0x67, 0x29, 0xc9, 0x65, 0xd1, 0xdd, 0x0c, 0x53, 0xef, 0x03,
+	0x53, 0xf0, 0x87, 0x00, 0x24, 0xa6, 0x2e, 0xd4, 0x41, 0xee, 0xe6, 0x6d, 0x15, 0x82, 0xa9, 0x0a,
+	0xe6, 0xee, 0x0a, 0x0c, 0x66, 0x37, 0xf5, 0x36, 0xdc, 0xdd, 0x90, 0x2b, 0x94, 0x37, 0x5e, 0xfc,
+	0x9a, 0xaa, 0x65, 0xd7, 0xa3, 0xef, 0x8f, 0x3b, 0x6c, 0x81, 0xd1, 0x1f, 0x24, 0xfa, 0x29, 0x13,
+	0xe7, 0x48, 0xa7, 0x93, 0x01, 0x22, 0x5a, 0xa5, 0xdb, 0xad, 0x38, 0xce, 0x10, 0x11, 0xf2, 0xed,
+	0xac, 0x7c, 0x27, 0xda, 0x43, 0xe4, 0x31, 0x26, 0x14, 0x91, 0x70, 0xc3, 0x43, 0xf8, 0x04, 0x64,
+	0x99, 0xd1, 0x66, 0x69, 0x5c, 0xe3, 0xc2, 0xed, 0xe7, 0xc6, 0x26, 0x68, 0x4d, 0x06, 0xc8, 0xca,
+	0x8c, 0x22, 0x2b, 0x3c, 0x90, 0xfb, 0x2e, 0xc8, 0xc4, 0x31, 0xb8, 0x0e, 0x56, 0x0f, 0xf6, 0x5a,
+	0xf5, 0x76, 0xeb, 0x70, 0xbf, 0xde, 0x7e, 0xdd, 0x68, 0xee, 0xd7, 0xab, 0xe6, 0x33, 0xb3, 0x5e,
+	0x2b, 0xa6, 0xe0, 0x32, 0x58, 0x9a, 0x85, 0x0e, 0xeb, 0xcd, 0xa2, 0x00, 0x8b, 0x20, 0x3f, 0x73,
+	0x35, 0xf6, 0x8a, 0x22, 0x5c, 0x05, 0xcb, 0x33, 0x4f, 0xc5, 0x68, 0xb6, 0x2a, 0x66, 0xa3, 0x98,
+	0x2e, 0x49, 0x27, 0x9f, 0x94, 0x94, 0x51, 0x3f, 0x0f, 0x14, 0xe1, 0x32, 0x50, 0x84, 0x9f, 0x81,
+	0x22, 0x9c, 0x5e, 0x2b, 0xa9, 0xcb, 0x6b, 0x25, 0xf5, 0xfd, 0x5a, 0x49, 0xbd, 0x79, 0x30, 0x27,
+	0x0a, 0x6b, 0xbf, 0xdc, 0xb3, 0x3b, 0x84, 0x5b, 0xfa, 0x78, 0xee, 0x63, 0xc4, 0xd5, 0xe9, 0x2c,
+	0xf2, 0x2d, 0x3e, 0xfc, 0x3d, 0x00, 0x93, 0xea, 0x56, 0x7c, 0xab, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -333,13 +336,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintGenesis(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0x22
 	if m.CommitteeID != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CommitteeID))
@@ -465,6 +476,8 @@ func (m *Proposal) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -800,6 +813,39 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
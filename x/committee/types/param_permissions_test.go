@@ -703,14 +703,28 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_SliceSubparamComparis
 				"base_asset": "xrp",
 				"quote_asset": "usdx",
 				"oracles": [],
-				"active": true
+				"active": true,
+				"pool_price_source": {"window": "0"},
+				"aggregation": {"oracle_weights": null},
+				"commit_reveal": {},
+				"twap_price_source": {"window": "0"},
+				"oracle_monitor": {"update_window": "0"},
+				"derived_price_source": {"terms": null},
+				"circuit_breaker": {"window": "0"}
 			},
 			{
 				"market_id": "btc:usd",
 				"base_asset": "btc",
 				"quote_asset": "usd",
 				"oracles": ["%s"],
-				"active": false
+				"active": false,
+				"pool_price_source": {"window": "0"},
+				"aggregation": {"oracle_weights": null},
+				"commit_reveal": {},
+				"twap_price_source": {"window": "0"},
+				"oracle_monitor": {"update_window": "0"},
+				"derived_price_source": {"terms": null},
+				"circuit_breaker": {"window": "0"}
 			}]`, oracles[1].String()),
 		},
 		{
@@ -721,14 +735,28 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_SliceSubparamComparis
 				"base_asset": "xrp",
 				"quote_asset": "usdx",
 				"oracles": ["%s"],
-				"active": true
+				"active": true,
+				"pool_price_source": {"window": "0"},
+				"aggregation": {"oracle_weights": null},
+				"commit_reveal": {},
+				"twap_price_source": {"window": "0"},
+				"oracle_monitor": {"update_window": "0"},
+				"derived_price_source": {"terms": null},
+				"circuit_breaker": {"window": "0"}
 			},
 			{
 				"market_id": "btc:usd",
 				"base_asset": "btc",
 				"quote_asset": "usd",
 				"oracles": ["%s"],
-				"active": false
+				"active": false,
+				"pool_price_source": {"window": "0"},
+				"aggregation": {"oracle_weights": null},
+				"commit_reveal": {},
+				"twap_price_source": {"window": "0"},
+				"oracle_monitor": {"update_window": "0"},
+				"derived_price_source": {"terms": null},
+				"circuit_breaker": {"window": "0"}
 			}]`, oracles[0].String(), oracles[2].String()),
 		},
 	}
@@ -2,10 +2,12 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	fmt "fmt"
 	"reflect"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -57,6 +59,7 @@ var (
 	_ Permission = CommunityCDPRepayDebtPermission{}
 	_ Permission = CommunityPoolLendWithdrawPermission{}
 	_ Permission = CommunityCDPWithdrawCollateralPermission{}
+	_ Permission = AllowedMsgsPermission{}
 )
 
 // Allows implement permission interface for GodPermission.
@@ -124,6 +127,121 @@ func (perm ParamsChangePermission) Allows(ctx sdk.Context, pk ParamKeeper, p Pub
 
 type AllowedParamsChanges []AllowedParamsChange
 
+// Allows implement permission interface for AllowedMsgsPermission.
+func (perm AllowedMsgsPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*ExecuteMsgsProposal)
+	if !ok {
+		return false
+	}
+	msgs, err := proposal.GetMsgs()
+	if err != nil || len(msgs) == 0 {
+		return false
+	}
+
+	// Every msg of the proposal must be allowed by one of the allowed msgs.
+	for _, msg := range msgs {
+		if !perm.AllowedMsgs.allows(msg) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks the allowed msgs have type urls and valid field constraints.
+func (perm AllowedMsgsPermission) Validate() error {
+	for _, allowed := range perm.AllowedMsgs {
+		if !strings.HasPrefix(allowed.TypeURL, "/") {
+			return fmt.Errorf("invalid allowed msg type url: %s", allowed.TypeURL)
+		}
+		for _, constraint := range allowed.FieldConstraints {
+			if strings.TrimSpace(constraint.Path) == "" {
+				return fmt.Errorf("allowed msg %s has a field constraint with a blank path", allowed.TypeURL)
+			}
+			if len(constraint.AllowedValues) == 0 {
+				return fmt.Errorf("allowed msg %s field %s has no allowed values", allowed.TypeURL, constraint.Path)
+			}
+			for _, value := range constraint.AllowedValues {
+				if !json.Valid([]byte(value)) {
+					return fmt.Errorf("allowed msg %s field %s has an invalid JSON value: %s", allowed.TypeURL, constraint.Path, value)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type AllowedMsgs []AllowedMsg
+
+// allows returns true if any of the allowed msgs allows the msg.
+func (allowedMsgs AllowedMsgs) allows(msg sdk.Msg) bool {
+	for _, allowed := range allowedMsgs {
+		if allowed.allows(msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// allows returns true if the msg has the allowed type url and meets all of the field constraints.
+func (allowed AllowedMsg) allows(msg sdk.Msg) bool {
+	if allowed.TypeURL != sdk.MsgTypeURL(msg) {
+		return false
+	}
+	if len(allowed.FieldConstraints) == 0 {
+		return true
+	}
+
+	// Fields are matched against the proto JSON of the msg, which includes fields set to their default value.
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return false
+	}
+	var msgValue interface{}
+	if err := json.Unmarshal(bz, &msgValue); err != nil {
+		return false
+	}
+
+	for _, constraint := range allowed.FieldConstraints {
+		if !constraint.allows(msgValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// allows returns true if the field of the decoded msg JSON is set to one of the allowed values.
+func (constraint FieldConstraint) allows(msgValue interface{}) bool {
+	field, err := lookupField(msgValue, constraint.Path)
+	if err != nil {
+		return false
+	}
+	for _, value := range constraint.AllowedValues {
+		var allowedValue interface{}
+		if err := json.Unmarshal([]byte(value), &allowedValue); err != nil {
+			continue
+		}
+		if reflect.DeepEqual(field, allowedValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupField returns the value at a dot separated path of object keys in a decoded JSON value.
+func lookupField(value interface{}, path string) (interface{}, error) {
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("field path does not match an object")
+		}
+		value, ok = object[key]
+		if !ok {
+			return nil, fmt.Errorf("field %s not found", key)
+		}
+	}
+	return value, nil
+}
+
 // Get searches the allowedParamsChange slice for the first item matching a subspace and key.
 // It returns false if not found.
 func (changes AllowedParamsChanges) Get(subspace, key string) (AllowedParamsChange, bool) {
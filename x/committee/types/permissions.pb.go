@@ -430,6 +430,162 @@ func (m *SubparamRequirement) GetAllowedSubparamAttrChanges() []string {
 	return nil
 }
 
+// AllowedMsgsPermission allows ExecuteMsgsProposals where every msg is allowed by one of the allowed msgs.
+type AllowedMsgsPermission struct {
+	AllowedMsgs AllowedMsgs `protobuf:"bytes,1,rep,name=allowed_msgs,json=allowedMsgs,proto3,castrepeated=AllowedMsgs" json:"allowed_msgs"`
+}
+
+func (m *AllowedMsgsPermission) Reset()         { *m = AllowedMsgsPermission{} }
+func (m *AllowedMsgsPermission) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsgsPermission) ProtoMessage()    {}
+func (*AllowedMsgsPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{9}
+}
+func (m *AllowedMsgsPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsgsPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsgsPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsgsPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsgsPermission.Merge(m, src)
+}
+func (m *AllowedMsgsPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsgsPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsgsPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsgsPermission proto.InternalMessageInfo
+
+func (m *AllowedMsgsPermission) GetAllowedMsgs() AllowedMsgs {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+// AllowedMsg allows msgs of a type that meet all of its field constraints.
+type AllowedMsg struct {
+	// type_url is the type url of the msg, such as /kava.community.v1beta1.MsgUpdateParams
+	TypeURL string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// field_constraints restrict the values of fields of the msg. Msgs of the type with any field values are allowed
+	// if empty.
+	FieldConstraints []FieldConstraint `protobuf:"bytes,2,rep,name=field_constraints,json=fieldConstraints,proto3" json:"field_constraints"`
+}
+
+func (m *AllowedMsg) Reset()         { *m = AllowedMsg{} }
+func (m *AllowedMsg) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsg) ProtoMessage()    {}
+func (*AllowedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{10}
+}
+func (m *AllowedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsg.Merge(m, src)
+}
+func (m *AllowedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsg proto.InternalMessageInfo
+
+func (m *AllowedMsg) GetTypeURL() string {
+	if m != nil {
+		return m.TypeURL
+	}
+	return ""
+}
+
+func (m *AllowedMsg) GetFieldConstraints() []FieldConstraint {
+	if m != nil {
+		return m.FieldConstraints
+	}
+	return nil
+}
+
+// FieldConstraint restricts a field of a msg to a set of values.
+type FieldConstraint struct {
+	// path is a dot separated path of the field in the proto JSON of the msg, such as params.staking_rewards_per_second
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// allowed_values are the JSON encoded values the field can be set to
+	AllowedValues []string `protobuf:"bytes,2,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+}
+
+func (m *FieldConstraint) Reset()         { *m = FieldConstraint{} }
+func (m *FieldConstraint) String() string { return proto.CompactTextString(m) }
+func (*FieldConstraint) ProtoMessage()    {}
+func (*FieldConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{11}
+}
+func (m *FieldConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldConstraint.Merge(m, src)
+}
+func (m *FieldConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldConstraint proto.InternalMessageInfo
+
+func (m *FieldConstraint) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FieldConstraint) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GodPermission)(nil), "kava.committee.v1beta1.GodPermission")
 	proto.RegisterType((*SoftwareUpgradePermission)(nil), "kava.committee.v1beta1.SoftwareUpgradePermission")
@@ -440,6 +596,9 @@ func init() {
 	proto.RegisterType((*ParamsChangePermission)(nil), "kava.committee.v1beta1.ParamsChangePermission")
 	proto.RegisterType((*AllowedParamsChange)(nil), "kava.committee.v1beta1.AllowedParamsChange")
 	proto.RegisterType((*SubparamRequirement)(nil), "kava.committee.v1beta1.SubparamRequirement")
+	proto.RegisterType((*AllowedMsgsPermission)(nil), "kava.committee.v1beta1.AllowedMsgsPermission")
+	proto.RegisterType((*AllowedMsg)(nil), "kava.committee.v1beta1.AllowedMsg")
+	proto.RegisterType((*FieldConstraint)(nil), "kava.committee.v1beta1.FieldConstraint")
 }
 
 func init() {
@@ -447,39 +606,48 @@ func init() {
 }
 
 var fileDescriptor_bdfaf7be16465ae4 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0x87, 0x1b, 0xb3, 0x88, 0x3b, 0xe2, 0xb2, 0x64, 0x4b, 0xc9, 0x86, 0x35, 0x2d, 0xf5, 0x12,
-	0x28, 0x9b, 0x50, 0xc5, 0xcb, 0xde, 0xda, 0xae, 0x78, 0xf1, 0x50, 0xb2, 0x8a, 0xe0, 0x25, 0x4c,
-	0x9a, 0x31, 0x0d, 0x3b, 0xc9, 0xc4, 0x79, 0x27, 0xed, 0x16, 0x04, 0xbf, 0x82, 0x5f, 0x43, 0xcf,
-	0x7e, 0x88, 0xc5, 0xd3, 0x1e, 0x3d, 0xa9, 0xb4, 0x1f, 0xc3, 0x8b, 0xe4, 0x6f, 0x03, 0x1b, 0x72,
-	0x9b, 0x79, 0xf3, 0xfc, 0xde, 0xc9, 0x33, 0x2f, 0x83, 0x8c, 0x6b, 0xbc, 0xc2, 0xd6, 0x82, 0x85,
-	0x61, 0x20, 0x04, 0x21, 0xd6, 0x6a, 0xec, 0x12, 0x81, 0xc7, 0x56, 0x4c, 0x78, 0x18, 0x00, 0x04,
-	0x2c, 0x02, 0x33, 0xe6, 0x4c, 0x30, 0xa5, 0x97, 0x92, 0x66, 0x45, 0x9a, 0x05, 0xa9, 0x9d, 0x2e,
-	0x18, 0x84, 0x0c, 0x9c, 0x8c, 0xb2, 0xf2, 0x4d, 0x1e, 0xd1, 0xba, 0x3e, 0xf3, 0x59, 0x5e, 0x4f,
-	0x57, 0x79, 0x75, 0xd8, 0x47, 0x4f, 0x5e, 0x33, 0x6f, 0x5e, 0x1d, 0x70, 0x71, 0xf4, 0xf3, 0xc7,
-	0x39, 0xda, 0xef, 0x87, 0x23, 0x74, 0x7a, 0xc5, 0x3e, 0x8a, 0x35, 0xe6, 0xe4, 0x5d, 0xec, 0x73,
-	0xec, 0x91, 0x16, 0x78, 0x80, 0x8e, 0xde, 0x92, 0x1b, 0xd1, 0x42, 0x8c, 0x51, 0x7f, 0xc6, 0xc2,
-	0x30, 0x89, 0x02, 0xb1, 0x99, 0x5d, 0xce, 0x6d, 0x12, 0xe3, 0xcd, 0x25, 0x71, 0xdb, 0x22, 0x17,
-	0xc8, 0xa8, 0x47, 0xde, 0x07, 0x62, 0xe9, 0x71, 0xbc, 0x9e, 0x31, 0x4a, 0xb1, 0x20, 0x1c, 0xd3,
-	0x96, 0xec, 0x4b, 0xf4, 0xac, 0xca, 0xce, 0x19, 0xa3, 0x6f, 0x48, 0xe4, 0x95, 0x0d, 0x5a, 0x62,
-	0xdf, 0x24, 0xd4, 0x9b, 0x63, 0x8e, 0x43, 0x98, 0x2d, 0x71, 0xe4, 0xd7, 0x94, 0x95, 0x2f, 0xa8,
-	0x87, 0x29, 0x65, 0x6b, 0xe2, 0x39, 0x71, 0x46, 0x38, 0x8b, 0x0c, 0x01, 0x55, 0x1a, 0xc8, 0xc6,
-	0xe3, 0xe7, 0x23, 0xb3, 0x79, 0x34, 0xe6, 0x24, 0x4f, 0xd5, 0xdb, 0x4e, 0xcf, 0x6e, 0x7f, 0xf7,
-	0x3b, 0xdf, 0xff, 0xf4, 0xbb, 0x0d, 0x1f, 0xc1, 0xee, 0xe2, 0x86, 0xea, 0xbd, 0x7f, 0xfd, 0x27,
-	0xa1, 0x93, 0x86, 0xb8, 0xa2, 0xa1, 0x47, 0x90, 0xb8, 0x10, 0xe3, 0x05, 0x51, 0xa5, 0x81, 0x64,
-	0x1c, 0xda, 0xd5, 0x5e, 0x39, 0x46, 0xf2, 0x35, 0xd9, 0xa8, 0x0f, 0xb2, 0x72, 0xba, 0x54, 0x26,
-	0xe8, 0x29, 0x04, 0x91, 0x4f, 0x89, 0x03, 0x89, 0x9b, 0x89, 0x39, 0xa5, 0x26, 0x16, 0x82, 0x83,
-	0x2a, 0x0f, 0x64, 0xe3, 0xd0, 0xd6, 0x72, 0xe8, 0xaa, 0x60, 0x8a, 0x73, 0x27, 0x29, 0xa1, 0x00,
-	0x3a, 0x0b, 0x13, 0x2a, 0x82, 0xaa, 0x03, 0x38, 0x9c, 0x7c, 0x4a, 0x02, 0x4e, 0x42, 0x12, 0x09,
-	0x50, 0x0f, 0xda, 0xef, 0xa7, 0xec, 0x69, 0xef, 0x33, 0xd3, 0x83, 0xf4, 0x7e, 0x6c, 0x2d, 0x6b,
-	0x5b, 0x7e, 0x87, 0x1a, 0x00, 0xc3, 0xcf, 0xe8, 0xa4, 0x21, 0x58, 0x0a, 0x4a, 0x7b, 0xc1, 0x63,
-	0x24, 0xaf, 0x30, 0x2d, 0x95, 0x57, 0x98, 0xa6, 0xca, 0xa5, 0xe2, 0xde, 0x59, 0x08, 0x5e, 0x0d,
-	0xb4, 0x50, 0x2e, 0xa0, 0xca, 0x59, 0x08, 0x5e, 0xcc, 0x62, 0xfa, 0xea, 0x76, 0xab, 0x4b, 0x77,
-	0x5b, 0x5d, 0xfa, 0xbb, 0xd5, 0xa5, 0xaf, 0x3b, 0xbd, 0x73, 0xb7, 0xd3, 0x3b, 0xbf, 0x76, 0x7a,
-	0xe7, 0xc3, 0xc8, 0x0f, 0xc4, 0x32, 0x71, 0x53, 0x4f, 0x2b, 0x15, 0x3e, 0xa7, 0xd8, 0x85, 0x6c,
-	0x65, 0xdd, 0xd4, 0x5e, 0xb8, 0xd8, 0xc4, 0x04, 0xdc, 0x87, 0xd9, 0x5b, 0x7c, 0xf1, 0x3f, 0x00,
-	0x00, 0xff, 0xff, 0x64, 0xe8, 0xa8, 0x0a, 0x00, 0x04, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x4e, 0x13, 0x5f,
+	0x14, 0xc7, 0x3b, 0x94, 0xfc, 0x80, 0xd3, 0x1f, 0x88, 0x03, 0x92, 0xd2, 0x60, 0xdb, 0xd4, 0xa8,
+	0x4d, 0x08, 0x6d, 0xd0, 0xb8, 0x61, 0x47, 0x8b, 0xba, 0x81, 0xa4, 0x19, 0x40, 0x13, 0x36, 0x93,
+	0x3b, 0xed, 0x65, 0x3a, 0xe1, 0xce, 0xdc, 0xf1, 0x9e, 0x3b, 0x85, 0x26, 0x26, 0x2e, 0x7c, 0x01,
+	0x5e, 0x43, 0xd7, 0x3e, 0x04, 0x71, 0xc5, 0xd2, 0x15, 0x9a, 0xf2, 0x18, 0x6e, 0xcc, 0x9d, 0x7f,
+	0x1d, 0xa5, 0xd6, 0xdd, 0xbd, 0x67, 0x3e, 0xdf, 0x73, 0xcf, 0xf7, 0x9c, 0x93, 0x81, 0xfa, 0x19,
+	0x19, 0x90, 0x66, 0x97, 0xbb, 0xae, 0x23, 0x25, 0xa5, 0xcd, 0xc1, 0xb6, 0x45, 0x25, 0xd9, 0x6e,
+	0xfa, 0x54, 0xb8, 0x0e, 0xa2, 0xc3, 0x3d, 0x6c, 0xf8, 0x82, 0x4b, 0xae, 0xaf, 0x29, 0xb2, 0x91,
+	0x92, 0x8d, 0x98, 0x2c, 0xad, 0x77, 0x39, 0xba, 0x1c, 0xcd, 0x90, 0x6a, 0x46, 0x97, 0x48, 0x52,
+	0x5a, 0xb5, 0xb9, 0xcd, 0xa3, 0xb8, 0x3a, 0x45, 0xd1, 0x5a, 0x05, 0x16, 0x5f, 0xf3, 0x5e, 0x27,
+	0x7d, 0x60, 0x67, 0xe9, 0xeb, 0x97, 0x2d, 0x18, 0xdf, 0x6b, 0x9b, 0xb0, 0x7e, 0xc8, 0x4f, 0xe5,
+	0x39, 0x11, 0xf4, 0xd8, 0xb7, 0x05, 0xe9, 0xd1, 0x29, 0x70, 0x15, 0x96, 0x8e, 0xe8, 0x85, 0x9c,
+	0x42, 0x6c, 0x43, 0xa5, 0xcd, 0x5d, 0x37, 0xf0, 0x1c, 0x39, 0x6c, 0xef, 0x75, 0x0c, 0xea, 0x93,
+	0xe1, 0x1e, 0xb5, 0xa6, 0x49, 0x76, 0xa0, 0x9e, 0x95, 0xbc, 0x75, 0x64, 0xbf, 0x27, 0xc8, 0x79,
+	0x9b, 0x33, 0x46, 0x24, 0x15, 0x84, 0x4d, 0xd1, 0xbe, 0x80, 0x47, 0xa9, 0xb6, 0xc3, 0x39, 0xdb,
+	0xa7, 0x5e, 0x2f, 0x49, 0x30, 0x45, 0xf6, 0x49, 0x83, 0xb5, 0x0e, 0x11, 0xc4, 0xc5, 0x76, 0x9f,
+	0x78, 0x76, 0xc6, 0xb2, 0xfe, 0x01, 0xd6, 0x08, 0x63, 0xfc, 0x9c, 0xf6, 0x4c, 0x3f, 0x24, 0xcc,
+	0x6e, 0x88, 0x60, 0x51, 0xab, 0xe6, 0xeb, 0x85, 0x67, 0x9b, 0x8d, 0xc9, 0xa3, 0x69, 0xec, 0x46,
+	0xaa, 0x6c, 0xda, 0xd6, 0xc6, 0xd5, 0x4d, 0x25, 0xf7, 0xf9, 0x7b, 0x65, 0x75, 0xc2, 0x47, 0x34,
+	0x56, 0xc9, 0x84, 0xe8, 0x9d, 0x5a, 0x7f, 0x6a, 0xb0, 0x32, 0x41, 0xae, 0x97, 0x60, 0x1e, 0x03,
+	0x0b, 0x7d, 0xd2, 0xa5, 0x45, 0xad, 0xaa, 0xd5, 0x17, 0x8c, 0xf4, 0xae, 0x2f, 0x43, 0xfe, 0x8c,
+	0x0e, 0x8b, 0x33, 0x61, 0x58, 0x1d, 0xf5, 0x5d, 0x78, 0x88, 0x8e, 0x67, 0x33, 0x6a, 0x62, 0x60,
+	0x85, 0xc6, 0xcc, 0xc4, 0x26, 0x91, 0x52, 0x60, 0x31, 0x5f, 0xcd, 0xd7, 0x17, 0x8c, 0x52, 0x04,
+	0x1d, 0xc6, 0x4c, 0xfc, 0xee, 0xae, 0x22, 0x74, 0x84, 0x0d, 0x37, 0x60, 0xd2, 0x49, 0x33, 0xa0,
+	0x29, 0xe8, 0xbb, 0xc0, 0x11, 0xd4, 0xa5, 0x9e, 0xc4, 0xe2, 0xec, 0xf4, 0xfe, 0x24, 0x39, 0x8d,
+	0xb1, 0xa6, 0x35, 0xab, 0xfa, 0x63, 0x94, 0xc2, 0xb4, 0xc9, 0x77, 0xcc, 0x00, 0x58, 0x7b, 0x0f,
+	0x2b, 0x13, 0x84, 0x89, 0x41, 0x6d, 0x6c, 0x70, 0x19, 0xf2, 0x03, 0xc2, 0x12, 0xcb, 0x03, 0xc2,
+	0x94, 0xe5, 0xc4, 0xe2, 0xd8, 0xb3, 0x94, 0x22, 0x1d, 0x68, 0x6c, 0x39, 0x86, 0x52, 0xcf, 0x52,
+	0x8a, 0x78, 0x16, 0xb5, 0x8f, 0x1a, 0x3c, 0x88, 0x7b, 0x70, 0x80, 0x36, 0x66, 0xd6, 0xe4, 0x04,
+	0xfe, 0x4f, 0x92, 0xbb, 0x68, 0x27, 0xcb, 0x51, 0xfb, 0xc7, 0x72, 0x1c, 0xa0, 0xdd, 0x5a, 0x89,
+	0x77, 0xa2, 0x90, 0x49, 0x6c, 0x14, 0xc8, 0xf8, 0x72, 0x67, 0x03, 0x2e, 0x35, 0x80, 0x31, 0xac,
+	0x3f, 0x81, 0x79, 0x39, 0xf4, 0xa9, 0x19, 0x08, 0x16, 0x35, 0xa0, 0x55, 0x18, 0xdd, 0x54, 0xe6,
+	0x8e, 0x86, 0x3e, 0x3d, 0x36, 0xf6, 0x8d, 0x39, 0xf5, 0xf1, 0x58, 0x30, 0xfd, 0x04, 0xee, 0x9f,
+	0x3a, 0x94, 0xf5, 0xcc, 0x2e, 0xf7, 0x50, 0x0a, 0xe2, 0xa8, 0x21, 0xcd, 0x84, 0x75, 0x3e, 0xfd,
+	0x5b, 0x9d, 0xaf, 0x94, 0xa0, 0x9d, 0xf2, 0xf1, 0x80, 0x96, 0x4f, 0x7f, 0x0f, 0x63, 0x6d, 0x1f,
+	0xee, 0xfd, 0x81, 0xea, 0x3a, 0xcc, 0xfa, 0x44, 0xf6, 0xe3, 0x99, 0x84, 0x67, 0xfd, 0x31, 0x2c,
+	0x25, 0x5d, 0x1a, 0x10, 0x16, 0xd0, 0xe8, 0xfd, 0x05, 0x63, 0x31, 0x8e, 0xbe, 0x09, 0x83, 0xad,
+	0x97, 0x57, 0xa3, 0xb2, 0x76, 0x3d, 0x2a, 0x6b, 0x3f, 0x46, 0x65, 0xed, 0xf2, 0xb6, 0x9c, 0xbb,
+	0xbe, 0x2d, 0xe7, 0xbe, 0xdd, 0x96, 0x73, 0x27, 0x9b, 0xb6, 0x23, 0xfb, 0x81, 0xa5, 0x2a, 0x6d,
+	0xaa, 0x92, 0xb7, 0x18, 0xb1, 0x30, 0x3c, 0x35, 0x2f, 0x32, 0x3f, 0x52, 0x65, 0x19, 0xad, 0xff,
+	0xc2, 0x5f, 0xde, 0xf3, 0x5f, 0x03, 0x00, 0x3b, 0xae, 0xa9, 0xc5, 0x67, 0x05, 0x00, 0x00,
 }
 
 func (m *GodPermission) Marshal() (dAtA []byte, err error) {
@@ -763,6 +931,126 @@ func (m *SubparamRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowedMsgsPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsgsPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsgsPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldConstraints) > 0 {
+		for iNdEx := len(m.FieldConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeURL) > 0 {
+		i -= len(m.TypeURL)
+		copy(dAtA[i:], m.TypeURL)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.TypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPermissions(v)
 	base := offset
@@ -895,6 +1183,59 @@ func (m *SubparamRequirement) Size() (n int) {
 	return n
 }
 
+func (m *AllowedMsgsPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for _, e := range m.AllowedMsgs {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeURL)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.FieldConstraints) > 0 {
+		for _, e := range m.FieldConstraints {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovPermissions(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1611,6 +1952,320 @@ func (m *SubparamRequirement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AllowedMsgsPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgsPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgsPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgs = append(m.AllowedMsgs, AllowedMsg{})
+			if err := m.AllowedMsgs[len(m.AllowedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldConstraints = append(m.FieldConstraints, FieldConstraint{})
+			if err := m.FieldConstraints[len(m.FieldConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPermissions(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
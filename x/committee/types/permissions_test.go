@@ -5,8 +5,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
@@ -293,6 +296,165 @@ func TestParamsChangePermission_SimpleParamsChange_Allows(t *testing.T) {
 	}
 }
 
+func TestAllowedMsgsPermission_Allows(t *testing.T) {
+	authority := authtypes.NewModuleAddress("gov")
+	updateParamsTypeURL := sdk.MsgTypeURL(&communitytypes.MsgUpdateParams{})
+	newUpdateParamsMsg := func(stakingRewardsPerSecond string) sdk.Msg {
+		params := communitytypes.DefaultParams()
+		params.StakingRewardsPerSecond = sdkmath.LegacyMustNewDecFromStr(stakingRewardsPerSecond)
+		msg := communitytypes.NewMsgUpdateParams(authority, params)
+		return &msg
+	}
+	newProposal := func(msgs ...sdk.Msg) types.PubProposal {
+		proposal := types.MustNewExecuteMsgsProposal("A Title", "A description of this proposal.", msgs)
+		return &proposal
+	}
+
+	testcases := []struct {
+		name       string
+		permission types.AllowedMsgsPermission
+		proposal   types.PubProposal
+		allowed    bool
+	}{
+		{
+			name: "allowed msg type without constraints",
+			permission: types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{TypeURL: updateParamsTypeURL},
+			}},
+			proposal: newProposal(newUpdateParamsMsg("1"), newUpdateParamsMsg("2")),
+			allowed:  true,
+		},
+		{
+			name: "allowed field value",
+			permission: types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{TypeURL: updateParamsTypeURL, FieldConstraints: []types.FieldConstraint{
+					{Path: "params.staking_rewards_per_second", AllowedValues: []string{`"1.000000000000000000"`, `"2.000000000000000000"`}},
+				}},
+			}},
+			proposal: newProposal(newUpdateParamsMsg("2")),
+			allowed:  true,
+		},
+		{
+			name: "disallowed field value",
+			permission: types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{TypeURL: updateParamsTypeURL, FieldConstraints: []types.FieldConstraint{
+					{Path: "params.staking_rewards_per_second", AllowedValues: []string{`"1.000000000000000000"`}},
+				}},
+			}},
+			proposal: newProposal(newUpdateParamsMsg("1"), newUpdateParamsMsg("2")),
+			allowed:  false,
+		},
+		{
+			name: "constraint on missing field",
+			permission: types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{TypeURL: updateParamsTypeURL, FieldConstraints: []types.FieldConstraint{
+					{Path: "params.missing", AllowedValues: []string{`"1"`}},
+				}},
+			}},
+			proposal: newProposal(newUpdateParamsMsg("1")),
+			allowed:  false,
+		},
+		{
+			name: "allowed by any allowed msg",
+			permission: types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{TypeURL: updateParamsTypeURL, FieldConstraints: []types.FieldConstraint{
+					{Path: "params.staking_rewards_per_second", AllowedValues: []string{`"1.000000000000000000"`}},
+				}},
+				{TypeURL: updateParamsTypeURL, FieldConstraints: []types.FieldConstraint{
+					{Path: "params.staking_rewards_per_second", AllowedValues: []string{`"2.000000000000000000"`}},
+				}},
+			}},
+			proposal: newProposal(newUpdateParamsMsg("1"), newUpdateParamsMsg("2")),
+			allowed:  true,
+		},
+		{
+			name: "disallowed msg type",
+			permission: types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{TypeURL: updateParamsTypeURL},
+			}},
+			proposal: newProposal(
+				newUpdateParamsMsg("1"),
+				banktypes.NewMsgSend(authority, authority, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1))),
+			),
+			allowed: false,
+		},
+		{
+			name: "fails for wrong proposal",
+			permission: types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{TypeURL: updateParamsTypeURL},
+			}},
+			proposal: govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
+			allowed:  false,
+		},
+		{
+			name: "fails for nil proposal",
+			permission: types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{TypeURL: updateParamsTypeURL},
+			}},
+			proposal: nil,
+			allowed:  false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, tc.permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
+func TestAllowedMsgsPermission_Validate(t *testing.T) {
+	testcases := []struct {
+		name       string
+		allowedMsg types.AllowedMsg
+		expectErr  string
+	}{
+		{
+			name: "valid",
+			allowedMsg: types.AllowedMsg{TypeURL: "/kava.community.v1beta1.MsgUpdateParams", FieldConstraints: []types.FieldConstraint{
+				{Path: "params.staking_rewards_per_second", AllowedValues: []string{`"1.000000000000000000"`}},
+			}},
+		},
+		{
+			name:       "invalid type url",
+			allowedMsg: types.AllowedMsg{TypeURL: "kava.community.v1beta1.MsgUpdateParams"},
+			expectErr:  "invalid allowed msg type url",
+		},
+		{
+			name: "blank path",
+			allowedMsg: types.AllowedMsg{TypeURL: "/kava.community.v1beta1.MsgUpdateParams", FieldConstraints: []types.FieldConstraint{
+				{Path: " ", AllowedValues: []string{`"1"`}},
+			}},
+			expectErr: "blank path",
+		},
+		{
+			name: "no allowed values",
+			allowedMsg: types.AllowedMsg{TypeURL: "/kava.community.v1beta1.MsgUpdateParams", FieldConstraints: []types.FieldConstraint{
+				{Path: "params"},
+			}},
+			expectErr: "no allowed values",
+		},
+		{
+			name: "invalid JSON value",
+			allowedMsg: types.AllowedMsg{TypeURL: "/kava.community.v1beta1.MsgUpdateParams", FieldConstraints: []types.FieldConstraint{
+				{Path: "params", AllowedValues: []string{"1.0.0"}},
+			}},
+			expectErr: "invalid JSON value",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.AllowedMsgsPermission{AllowedMsgs: types.AllowedMsgs{tc.allowedMsg}}.Validate()
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectErr)
+			}
+		})
+	}
+}
+
 func newTestParamsChangeProposalWithChanges(changes []paramsproposal.ParamChange) types.PubProposal {
 	return paramsproposal.NewParameterChangeProposal(
 		"A Title",
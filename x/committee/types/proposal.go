@@ -1,14 +1,20 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 const (
 	ProposalTypeCommitteeChange = "CommitteeChange"
 	ProposalTypeCommitteeDelete = "CommitteeDelete"
+	ProposalTypeExecuteMsgs     = "ExecuteMsgs"
+	ProposalTypeVeto            = "Veto"
 )
 
 // ProposalOutcome indicates the status of a proposal when it's closed and deleted from the store
@@ -21,12 +27,15 @@ const (
 	Failed
 	// Invalid indicates that proposal passed but an error occurred when attempting to enact it
 	Invalid
+	// Vetoed indicates that the proposal passed but was vetoed while queued for execution
+	Vetoed
 )
 
 var toString = map[ProposalOutcome]string{
 	Passed:  "Passed",
 	Failed:  "Failed",
 	Invalid: "Invalid",
+	Vetoed:  "Vetoed",
 }
 
 func (p ProposalOutcome) String() string {
@@ -34,16 +43,17 @@ func (p ProposalOutcome) String() string {
 }
 
 // ensure proposal types fulfill the PubProposal interface and the gov Content interface.
-var _, _ govv1beta1.Content = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}
-var _, _ PubProposal = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}
+var _, _, _, _ govv1beta1.Content = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}, &ExecuteMsgsProposal{}, &VetoProposal{}
+var _, _, _, _ PubProposal = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}, &ExecuteMsgsProposal{}, &VetoProposal{}
 
-// ensure CommitteeChangeProposal fulfill the codectypes.UnpackInterfacesMessage interface
-var _ codectypes.UnpackInterfacesMessage = &CommitteeChangeProposal{}
+// ensure CommitteeChangeProposal and ExecuteMsgsProposal fulfill the codectypes.UnpackInterfacesMessage interface
+var _, _ codectypes.UnpackInterfacesMessage = &CommitteeChangeProposal{}, &ExecuteMsgsProposal{}
 
 func init() {
 	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
 	govv1beta1.RegisterProposalType(ProposalTypeCommitteeChange)
 	govv1beta1.RegisterProposalType(ProposalTypeCommitteeDelete)
+	govv1beta1.RegisterProposalType(ProposalTypeVeto)
 }
 
 func NewCommitteeChangeProposal(title string, description string, newCommittee Committee) (CommitteeChangeProposal, error) {
@@ -132,3 +142,90 @@ func (cdp CommitteeDeleteProposal) ProposalType() string { return ProposalTypeCo
 func (cdp CommitteeDeleteProposal) ValidateBasic() error {
 	return govv1beta1.ValidateAbstract(&cdp)
 }
+
+func NewExecuteMsgsProposal(title string, description string, msgs []sdk.Msg) (ExecuteMsgsProposal, error) {
+	msgsAny, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return ExecuteMsgsProposal{}, err
+	}
+	return ExecuteMsgsProposal{
+		Title:       title,
+		Description: description,
+		Msgs:        msgsAny,
+	}, nil
+}
+
+func MustNewExecuteMsgsProposal(title string, description string, msgs []sdk.Msg) ExecuteMsgsProposal {
+	proposal, err := NewExecuteMsgsProposal(title, description, msgs)
+	if err != nil {
+		panic(err)
+	}
+	return proposal
+}
+
+// GetTitle returns the title of the proposal.
+func (emp ExecuteMsgsProposal) GetTitle() string { return emp.Title }
+
+// GetDescription returns the description of the proposal.
+func (emp ExecuteMsgsProposal) GetDescription() string { return emp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (emp ExecuteMsgsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (emp ExecuteMsgsProposal) ProposalType() string { return ProposalTypeExecuteMsgs }
+
+// GetMsgs returns the unpacked msgs of the proposal.
+func (emp ExecuteMsgsProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(emp.Msgs, "kava.committee.v1beta1.ExecuteMsgsProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (emp ExecuteMsgsProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, emp.Msgs)
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (emp ExecuteMsgsProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(&emp); err != nil {
+		return err
+	}
+	if len(emp.Msgs) == 0 {
+		return errorsmod.Wrap(ErrInvalidPubProposal, "proposal must contain at least one msg")
+	}
+	msgs, err := emp.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(ErrInvalidPubProposal, fmt.Sprintf("msg %d: %s", i, err))
+		}
+	}
+	return nil
+}
+
+func NewVetoProposal(title string, description string, proposalID uint64) VetoProposal {
+	return VetoProposal{
+		Title:       title,
+		Description: description,
+		ProposalID:  proposalID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (vp VetoProposal) GetTitle() string { return vp.Title }
+
+// GetDescription returns the description of the proposal.
+func (vp VetoProposal) GetDescription() string { return vp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (vp VetoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (vp VetoProposal) ProposalType() string { return ProposalTypeVeto }
+
+// ValidateBasic runs basic stateless validity checks
+func (vp VetoProposal) ValidateBasic() error {
+	return govv1beta1.ValidateAbstract(&vp)
+}
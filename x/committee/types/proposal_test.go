@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+)
+
+func TestExecuteMsgsProposal_ValidateBasic(t *testing.T) {
+	validMsg := communitytypes.NewMsgUpdateParams(authtypes.NewModuleAddress("gov"), communitytypes.DefaultParams())
+	invalidMsg := communitytypes.NewMsgUpdateParams(sdk.AccAddress{}, communitytypes.DefaultParams())
+
+	testcases := []struct {
+		name       string
+		title      string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			title:      "A Title",
+			msgs:       []sdk.Msg{&validMsg},
+			expectPass: true,
+		},
+		{
+			name:       "missing title",
+			title:      "",
+			msgs:       []sdk.Msg{&validMsg},
+			expectPass: false,
+		},
+		{
+			name:       "no msgs",
+			title:      "A Title",
+			msgs:       nil,
+			expectPass: false,
+		},
+		{
+			name:       "invalid msg",
+			title:      "A Title",
+			msgs:       []sdk.Msg{&validMsg, &invalidMsg},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := types.MustNewExecuteMsgsProposal(tc.title, "A description of this proposal.", tc.msgs)
+			err := proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+
+				msgs, err := proposal.GetMsgs()
+				require.NoError(t, err)
+				require.Equal(t, tc.msgs, msgs)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -308,6 +308,8 @@ type QueryProposalResponse struct {
 	ID          uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	CommitteeID uint64     `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
 	Deadline    time.Time  `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	// execution_time is the time a queued proposal is executed at, zero if the proposal is not queued
+	ExecutionTime time.Time `protobuf:"bytes,5,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
 }
 
 func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
@@ -343,6 +345,83 @@ func (m *QueryProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryProposalResponse proto.InternalMessageInfo
 
+// QueryQueuedProposalsRequest defines the request type for querying x/committee queued proposals.
+type QueryQueuedProposalsRequest struct {
+	// committee_id filters the proposals by committee, all committees if zero
+	CommitteeId uint64 `protobuf:"varint,1,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+}
+
+func (m *QueryQueuedProposalsRequest) Reset()         { *m = QueryQueuedProposalsRequest{} }
+func (m *QueryQueuedProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalsRequest) ProtoMessage()    {}
+func (*QueryQueuedProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{8}
+}
+func (m *QueryQueuedProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalsRequest.Merge(m, src)
+}
+func (m *QueryQueuedProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalsRequest proto.InternalMessageInfo
+
+// QueryQueuedProposalsResponse defines the response type for querying x/committee queued proposals.
+type QueryQueuedProposalsResponse struct {
+	Proposals []QueryProposalResponse `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+}
+
+func (m *QueryQueuedProposalsResponse) Reset()         { *m = QueryQueuedProposalsResponse{} }
+func (m *QueryQueuedProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalsResponse) ProtoMessage()    {}
+func (*QueryQueuedProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{9}
+}
+func (m *QueryQueuedProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalsResponse.Merge(m, src)
+}
+func (m *QueryQueuedProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalsResponse proto.InternalMessageInfo
+
 // QueryNextProposalIDRequest defines the request type for querying x/committee NextProposalID.
 type QueryNextProposalIDRequest struct {
 }
@@ -351,7 +430,7 @@ func (m *QueryNextProposalIDRequest) Reset()         { *m = QueryNextProposalIDR
 func (m *QueryNextProposalIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextProposalIDRequest) ProtoMessage()    {}
 func (*QueryNextProposalIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{8}
+	return fileDescriptor_b81d271efeb6eee5, []int{10}
 }
 func (m *QueryNextProposalIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +468,7 @@ func (m *QueryNextProposalIDResponse) Reset()         { *m = QueryNextProposalID
 func (m *QueryNextProposalIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextProposalIDResponse) ProtoMessage()    {}
 func (*QueryNextProposalIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{9}
+	return fileDescriptor_b81d271efeb6eee5, []int{11}
 }
 func (m *QueryNextProposalIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +507,7 @@ func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
 func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesRequest) ProtoMessage()    {}
 func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{10}
+	return fileDescriptor_b81d271efeb6eee5, []int{12}
 }
 func (m *QueryVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +548,7 @@ func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
 func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesResponse) ProtoMessage()    {}
 func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{11}
+	return fileDescriptor_b81d271efeb6eee5, []int{13}
 }
 func (m *QueryVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +587,7 @@ func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
 func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteRequest) ProtoMessage()    {}
 func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{12}
+	return fileDescriptor_b81d271efeb6eee5, []int{14}
 }
 func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +627,7 @@ func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
 func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteResponse) ProtoMessage()    {}
 func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{13}
+	return fileDescriptor_b81d271efeb6eee5, []int{15}
 }
 func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +665,7 @@ func (m *QueryTallyRequest) Reset()         { *m = QueryTallyRequest{} }
 func (m *QueryTallyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyRequest) ProtoMessage()    {}
 func (*QueryTallyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{14}
+	return fileDescriptor_b81d271efeb6eee5, []int{16}
 }
 func (m *QueryTallyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +709,7 @@ func (m *QueryTallyResponse) Reset()         { *m = QueryTallyResponse{} }
 func (m *QueryTallyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResponse) ProtoMessage()    {}
 func (*QueryTallyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{15}
+	return fileDescriptor_b81d271efeb6eee5, []int{17}
 }
 func (m *QueryTallyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +748,7 @@ func (m *QueryRawParamsRequest) Reset()         { *m = QueryRawParamsRequest{} }
 func (m *QueryRawParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawParamsRequest) ProtoMessage()    {}
 func (*QueryRawParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{16}
+	return fileDescriptor_b81d271efeb6eee5, []int{18}
 }
 func (m *QueryRawParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +786,7 @@ func (m *QueryRawParamsResponse) Reset()         { *m = QueryRawParamsResponse{}
 func (m *QueryRawParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawParamsResponse) ProtoMessage()    {}
 func (*QueryRawParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{17}
+	return fileDescriptor_b81d271efeb6eee5, []int{19}
 }
 func (m *QueryRawParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,6 +824,8 @@ func init() {
 	proto.RegisterType((*QueryProposalsResponse)(nil), "kava.committee.v1beta1.QueryProposalsResponse")
 	proto.RegisterType((*QueryProposalRequest)(nil), "kava.committee.v1beta1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "kava.committee.v1beta1.QueryProposalResponse")
+	proto.RegisterType((*QueryQueuedProposalsRequest)(nil), "kava.committee.v1beta1.QueryQueuedProposalsRequest")
+	proto.RegisterType((*QueryQueuedProposalsResponse)(nil), "kava.committee.v1beta1.QueryQueuedProposalsResponse")
 	proto.RegisterType((*QueryNextProposalIDRequest)(nil), "kava.committee.v1beta1.QueryNextProposalIDRequest")
 	proto.RegisterType((*QueryNextProposalIDResponse)(nil), "kava.committee.v1beta1.QueryNextProposalIDResponse")
 	proto.RegisterType((*QueryVotesRequest)(nil), "kava.committee.v1beta1.QueryVotesRequest")
@@ -762,83 +843,87 @@ func init() {
 }
 
 var fileDescriptor_b81d271efeb6eee5 = []byte{
-	// 1211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xc1, 0x6f, 0xe3, 0xc4,
-	0x17, 0xc7, 0xeb, 0x34, 0xed, 0x26, 0x2f, 0xdb, 0xfe, 0xfa, 0x1b, 0x95, 0x92, 0x86, 0x55, 0xd2,
-	0x35, 0xab, 0xa5, 0x5b, 0x88, 0x4d, 0x53, 0x50, 0x05, 0xa2, 0x82, 0x4d, 0xdb, 0x45, 0x11, 0x12,
-	0xea, 0x9a, 0xc2, 0x81, 0x95, 0x88, 0x26, 0xf5, 0x6c, 0x6a, 0x35, 0xb1, 0x5d, 0x8f, 0xd3, 0x36,
-	0x2a, 0xbd, 0x70, 0x47, 0x5a, 0x09, 0x81, 0xb4, 0x07, 0x24, 0x84, 0x40, 0x42, 0xe2, 0x86, 0xf6,
-	0x8f, 0xa8, 0xf6, 0xb4, 0x12, 0x17, 0xc4, 0x21, 0x40, 0xca, 0x1f, 0x82, 0x3c, 0x33, 0x9e, 0xb8,
-	0x49, 0xdb, 0xb8, 0x39, 0x25, 0xb6, 0xdf, 0xfb, 0xce, 0x67, 0xde, 0xbc, 0x79, 0xef, 0x81, 0xba,
-	0x87, 0x0f, 0xb0, 0xbe, 0xe3, 0x34, 0x9b, 0x96, 0xef, 0x13, 0xa2, 0x1f, 0x2c, 0xd7, 0x88, 0x8f,
-	0x97, 0xf5, 0xfd, 0x16, 0xf1, 0xda, 0x9a, 0xeb, 0x39, 0xbe, 0x83, 0xe6, 0x02, 0x1b, 0x4d, 0xda,
-	0x68, 0xc2, 0x26, 0xb7, 0xb4, 0xe3, 0xd0, 0xa6, 0x43, 0xf5, 0x1a, 0xa6, 0x84, 0x3b, 0x48, 0x77,
-	0x17, 0xd7, 0x2d, 0x1b, 0xfb, 0x96, 0x63, 0x73, 0x8d, 0xdc, 0x3c, 0xb7, 0xad, 0xb2, 0x27, 0x9d,
-	0x3f, 0x88, 0x4f, 0xb3, 0x75, 0xa7, 0xee, 0xf0, 0xf7, 0xc1, 0x3f, 0xf1, 0xf6, 0x56, 0xdd, 0x71,
-	0xea, 0x0d, 0xa2, 0x63, 0xd7, 0xd2, 0xb1, 0x6d, 0x3b, 0x3e, 0x53, 0x0b, 0x7d, 0xe6, 0xc5, 0x57,
-	0xf6, 0x54, 0x6b, 0x3d, 0xd6, 0xb1, 0x2d, 0x68, 0x73, 0x85, 0xfe, 0x4f, 0xbe, 0xd5, 0x24, 0xd4,
-	0xc7, 0x4d, 0x57, 0x18, 0xdc, 0xb9, 0x64, 0xcb, 0x75, 0x62, 0x13, 0x6a, 0x89, 0x15, 0xd4, 0x2c,
-	0xcc, 0x3d, 0x0c, 0xb6, 0xb4, 0x1e, 0xda, 0x51, 0x83, 0xec, 0xb7, 0x08, 0xf5, 0xd5, 0x2f, 0xe0,
-	0xe5, 0x81, 0x2f, 0xd4, 0x75, 0x6c, 0x4a, 0xd0, 0x3a, 0x80, 0xd4, 0xa5, 0x59, 0x65, 0x61, 0x7c,
-	0x31, 0x53, 0x9a, 0xd5, 0x38, 0x90, 0x16, 0x02, 0x69, 0xf7, 0xed, 0x76, 0x79, 0xea, 0xf9, 0xb3,
-	0x62, 0x5a, 0x2a, 0x18, 0x11, 0x37, 0xf5, 0x5d, 0x78, 0xe9, 0xbc, 0xbe, 0x58, 0x18, 0xdd, 0x86,
-	0x9b, 0xd2, 0xac, 0x6a, 0x99, 0x59, 0x65, 0x41, 0x59, 0x4c, 0x1a, 0x19, 0xf9, 0xae, 0x62, 0xaa,
-	0x8f, 0xfa, 0xa9, 0x25, 0xda, 0x7d, 0x48, 0x4b, 0x43, 0xe6, 0x19, 0x93, 0xac, 0xe7, 0x25, 0xc1,
-	0xb6, 0x3c, 0xc7, 0x75, 0x28, 0x6e, 0xd0, 0x6b, 0x80, 0xed, 0x09, 0xb0, 0x88, 0xaf, 0x00, 0x7b,
-	0x08, 0x69, 0x37, 0x7c, 0x29, 0x42, 0x56, 0xd4, 0x2e, 0xce, 0x38, 0xed, 0x9c, 0x44, 0xa8, 0x50,
-	0x4e, 0x9e, 0x76, 0x0a, 0x63, 0x46, 0x4f, 0x45, 0x5d, 0x85, 0xd9, 0x3e, 0x4b, 0xce, 0x59, 0x80,
-	0x4c, 0x68, 0xd4, 0xc3, 0x84, 0xf0, 0x55, 0xc5, 0x54, 0xbf, 0x4e, 0xf4, 0x6d, 0x51, 0x52, 0x3e,
-	0x86, 0x9b, 0x6e, 0xab, 0x56, 0x0d, 0x6d, 0xaf, 0x8c, 0x60, 0xb1, 0xdb, 0x29, 0x64, 0xb6, 0x5a,
-	0xb5, 0x50, 0xe4, 0xf9, 0xb3, 0x62, 0x4e, 0x64, 0x7c, 0xdd, 0x39, 0x90, 0x9b, 0x59, 0x77, 0x6c,
-	0x9f, 0xd8, 0xbe, 0x91, 0x71, 0x7b, 0xa6, 0x68, 0x0e, 0x12, 0x96, 0x99, 0x4d, 0x04, 0x64, 0xe5,
-	0xc9, 0x6e, 0xa7, 0x90, 0xa8, 0x6c, 0x18, 0x09, 0xcb, 0x44, 0xa5, 0xbe, 0x10, 0x8f, 0x33, 0x8b,
-	0xff, 0x05, 0x2b, 0xc9, 0xb3, 0xaa, 0x6c, 0x9c, 0x8b, 0x39, 0xfa, 0x00, 0x52, 0x26, 0xc1, 0x66,
-	0xc3, 0xb2, 0x49, 0x36, 0xc9, 0x78, 0x73, 0x03, 0xbc, 0xdb, 0xe1, 0xe5, 0x28, 0xa7, 0x82, 0x28,
-	0x3e, 0xf9, 0xab, 0xa0, 0x18, 0xd2, 0x4b, 0xbd, 0x05, 0x39, 0x16, 0x8e, 0x8f, 0xc9, 0x91, 0x1f,
-	0x22, 0x56, 0x36, 0xc2, 0x8b, 0xf0, 0x08, 0x5e, 0xb9, 0xf0, 0xab, 0x08, 0xd9, 0x7b, 0x30, 0x63,
-	0x93, 0x23, 0xbf, 0x3a, 0x10, 0xf2, 0x32, 0xea, 0x76, 0x0a, 0xd3, 0x7d, 0x5e, 0xd3, 0x76, 0xf4,
-	0xd9, 0x54, 0xbf, 0x84, 0xff, 0x33, 0xf1, 0xcf, 0x1c, 0x5f, 0x5e, 0xbd, 0xa1, 0x07, 0x88, 0x1e,
-	0x00, 0xf4, 0x4a, 0x0f, 0x0b, 0x63, 0xa6, 0x74, 0x57, 0x13, 0xc1, 0x0f, 0xea, 0x94, 0xc6, 0x0b,
-	0x5b, 0x78, 0x06, 0x5b, 0xb8, 0x1e, 0x5e, 0x2f, 0x23, 0xe2, 0xa9, 0xfe, 0xa4, 0x00, 0x8a, 0x2e,
-	0x2f, 0xb6, 0xb4, 0x09, 0x13, 0x07, 0xc1, 0x0b, 0x91, 0xa7, 0xf7, 0xae, 0xcc, 0xd3, 0xc0, 0xb5,
-	0x2f, 0x47, 0xb9, 0x37, 0xfa, 0xf0, 0x02, 0xca, 0xd7, 0x86, 0x52, 0x72, 0xa5, 0x73, 0x98, 0x15,
-	0x98, 0x89, 0x2c, 0x15, 0x33, 0x46, 0xb3, 0x7c, 0x13, 0x1e, 0x5b, 0x38, 0xcd, 0x99, 0x3c, 0xf5,
-	0xa9, 0x12, 0x09, 0xb8, 0xdc, 0xb0, 0x7e, 0x81, 0x58, 0x79, 0xba, 0xdb, 0x29, 0x40, 0xe4, 0xe8,
-	0x86, 0x8a, 0xa3, 0x35, 0x48, 0x07, 0x7f, 0xaa, 0x7e, 0xdb, 0x25, 0x2c, 0x75, 0xa7, 0x4b, 0x0b,
-	0x97, 0xc5, 0x2e, 0x58, 0x7f, 0xbb, 0xed, 0x12, 0x23, 0x75, 0x20, 0xfe, 0xa9, 0x6f, 0x09, 0xb4,
-	0x6d, 0xdc, 0x68, 0xb4, 0x63, 0x5f, 0xe6, 0x5f, 0x92, 0xe2, 0x0c, 0x85, 0xdb, 0xa8, 0x5b, 0xfa,
-	0x08, 0xd2, 0x6d, 0x42, 0xab, 0xfc, 0xe0, 0xd9, 0xb6, 0xca, 0x5a, 0x70, 0x9a, 0x7f, 0x76, 0x0a,
-	0x77, 0xeb, 0x96, 0xbf, 0xdb, 0xaa, 0x05, 0xbb, 0x10, 0x3d, 0x4d, 0xfc, 0x14, 0xa9, 0xb9, 0xa7,
-	0x07, 0xbb, 0xa5, 0xda, 0x06, 0xd9, 0x31, 0x52, 0x6d, 0x42, 0x59, 0x26, 0xa1, 0x0a, 0xa4, 0x6c,
-	0x47, 0x68, 0x8d, 0x8f, 0xa4, 0x75, 0xc3, 0x76, 0xb8, 0xd4, 0x27, 0x30, 0xb5, 0xd3, 0xf2, 0x3c,
-	0x62, 0xfb, 0x42, 0x2f, 0x39, 0x92, 0xde, 0x4d, 0x21, 0xc2, 0x45, 0x3f, 0x85, 0x69, 0xd7, 0xa1,
-	0xd4, 0xaa, 0x35, 0x88, 0x50, 0x9d, 0x18, 0x49, 0x75, 0x2a, 0x54, 0x91, 0xb2, 0x3c, 0x01, 0x76,
-	0x3d, 0x42, 0x77, 0x9d, 0x86, 0x99, 0x9d, 0x1c, 0x4d, 0x96, 0xe5, 0x44, 0x28, 0x82, 0x1e, 0xc0,
-	0xe4, 0x7e, 0xcb, 0xf1, 0x5a, 0xcd, 0xec, 0x8d, 0x91, 0xe4, 0x84, 0xb7, 0xba, 0x29, 0xca, 0xbe,
-	0x81, 0x0f, 0xb7, 0xb0, 0x87, 0x9b, 0xb2, 0xe0, 0xe4, 0x20, 0x45, 0x5b, 0x35, 0xea, 0xe2, 0x1d,
-	0xde, 0x34, 0xd3, 0x86, 0x7c, 0x46, 0x33, 0x30, 0xbe, 0x47, 0xda, 0x22, 0xd1, 0x83, 0xbf, 0xea,
-	0x8a, 0x68, 0x72, 0x11, 0x19, 0x91, 0x74, 0xf3, 0x90, 0xf2, 0xf0, 0x61, 0xd5, 0xc4, 0x3e, 0x16,
-	0x3a, 0x37, 0x3c, 0x7c, 0xb8, 0x81, 0x7d, 0x5c, 0xfa, 0x2d, 0x03, 0x13, 0xcc, 0x0b, 0x3d, 0x55,
-	0x00, 0x7a, 0x43, 0x05, 0xd2, 0xae, 0xac, 0x2e, 0x03, 0x73, 0x49, 0x4e, 0x8f, 0x6d, 0xcf, 0xa1,
-	0xd4, 0xa5, 0xaf, 0x7e, 0xff, 0xf7, 0x9b, 0xc4, 0x1d, 0xa4, 0xea, 0x97, 0x4c, 0x44, 0xbd, 0xa1,
-	0x04, 0xfd, 0xac, 0x40, 0x6f, 0x28, 0x40, 0xc5, 0x78, 0x4b, 0x85, 0x64, 0x5a, 0x5c, 0x73, 0x01,
-	0xf6, 0x0e, 0x03, 0x5b, 0x41, 0xcb, 0xc3, 0xc1, 0xf4, 0xe3, 0x68, 0x5b, 0x3c, 0x41, 0xdf, 0x2a,
-	0x90, 0x96, 0x33, 0x06, 0x8a, 0x37, 0x48, 0xd0, 0x78, 0x9c, 0x03, 0xa3, 0x8b, 0x7a, 0x8f, 0x71,
-	0xbe, 0x8a, 0x6e, 0x5f, 0xc6, 0x29, 0x47, 0x12, 0xf4, 0x83, 0x02, 0x29, 0xd9, 0xe4, 0xdf, 0x88,
-	0x39, 0xdf, 0x70, 0xaa, 0xeb, 0x4d, 0x43, 0xea, 0x2a, 0x83, 0x5a, 0x46, 0xfa, 0x50, 0x28, 0xfd,
-	0x38, 0x52, 0x08, 0x4f, 0xd0, 0xaf, 0x0a, 0xf4, 0x35, 0x65, 0x54, 0xba, 0x72, 0xe9, 0x0b, 0xa7,
-	0x82, 0xdc, 0xca, 0xb5, 0x7c, 0x04, 0xf4, 0x9b, 0x0c, 0x7a, 0x09, 0x2d, 0x5e, 0x06, 0x1d, 0x4c,
-	0x07, 0xc5, 0x10, 0xb7, 0x68, 0x99, 0xe8, 0x7b, 0x05, 0x26, 0x78, 0x6d, 0x19, 0xde, 0x85, 0xe5,
-	0x01, 0x2f, 0xc5, 0x31, 0x15, 0x48, 0x6b, 0x0c, 0x69, 0x15, 0xbd, 0x7d, 0xcd, 0x38, 0xea, 0xbc,
-	0xc7, 0xff, 0xa8, 0x40, 0x32, 0x10, 0x44, 0x8b, 0x31, 0x86, 0x04, 0x4e, 0x17, 0x7f, 0x9c, 0x50,
-	0x37, 0x19, 0xdc, 0xfb, 0x68, 0x6d, 0x24, 0x38, 0xfd, 0x98, 0xb5, 0xe5, 0x13, 0x16, 0x44, 0xd6,
-	0x1d, 0x87, 0x04, 0x31, 0xda, 0x78, 0x87, 0x04, 0xf1, 0x5c, 0xb3, 0x1d, 0x3d, 0x88, 0x3e, 0xa3,
-	0xfa, 0x4e, 0x81, 0xb4, 0x2c, 0xa6, 0x43, 0x6e, 0x73, 0x7f, 0xed, 0x1e, 0x72, 0x9b, 0x07, 0x6a,
-	0xf4, 0xf0, 0x72, 0xe8, 0xe1, 0xc3, 0xa2, 0xcb, 0x7c, 0xca, 0x95, 0xd3, 0x7f, 0xf2, 0x63, 0xa7,
-	0xdd, 0xbc, 0xf2, 0xa2, 0x9b, 0x57, 0xfe, 0xee, 0xe6, 0x95, 0x27, 0x67, 0xf9, 0xb1, 0x17, 0x67,
-	0xf9, 0xb1, 0x3f, 0xce, 0xf2, 0x63, 0x9f, 0xbf, 0x1e, 0x69, 0x3f, 0x81, 0x56, 0xb1, 0x81, 0x6b,
-	0x94, 0xab, 0x1e, 0x45, 0x74, 0x59, 0x1f, 0xaa, 0x4d, 0xb2, 0x59, 0x7c, 0xe5, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb4, 0xd1, 0x58, 0x0c, 0x8a, 0x0f, 0x00, 0x00,
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xd1, 0x6f, 0xdb, 0xd4,
+	0x17, 0xc7, 0xeb, 0x34, 0xed, 0x92, 0x93, 0x36, 0xdb, 0xef, 0xaa, 0xeb, 0x2f, 0xf3, 0xa6, 0x64,
+	0x33, 0xd3, 0xe8, 0x0a, 0xb1, 0x69, 0x3a, 0x34, 0x81, 0xa8, 0xd8, 0xd2, 0x76, 0x28, 0x9a, 0x84,
+	0x5a, 0x53, 0x78, 0x60, 0x12, 0xd1, 0x4d, 0x7c, 0x97, 0x5a, 0x4d, 0x6c, 0xd7, 0xd7, 0x6e, 0x1b,
+	0x95, 0xbe, 0xf0, 0x17, 0x4c, 0x42, 0x20, 0xed, 0x01, 0x09, 0x21, 0x90, 0x90, 0x90, 0x78, 0xda,
+	0x1f, 0x51, 0xed, 0x69, 0x12, 0x2f, 0x68, 0x0f, 0x01, 0x52, 0xfe, 0x10, 0xe4, 0xeb, 0x6b, 0xc7,
+	0x4d, 0xd3, 0xc6, 0x09, 0xe2, 0x29, 0xb6, 0xef, 0x39, 0xdf, 0xfb, 0xb9, 0xc7, 0xc7, 0xe7, 0x9c,
+	0x80, 0xb4, 0x83, 0xf7, 0xb0, 0x52, 0x37, 0x5b, 0x2d, 0xdd, 0x71, 0x08, 0x51, 0xf6, 0x96, 0x6a,
+	0xc4, 0xc1, 0x4b, 0xca, 0xae, 0x4b, 0xec, 0xb6, 0x6c, 0xd9, 0xa6, 0x63, 0xa2, 0x79, 0xcf, 0x46,
+	0x0e, 0x6d, 0x64, 0x6e, 0x23, 0x2e, 0xd6, 0x4d, 0xda, 0x32, 0xa9, 0x52, 0xc3, 0x94, 0xf8, 0x0e,
+	0xa1, 0xbb, 0x85, 0x1b, 0xba, 0x81, 0x1d, 0xdd, 0x34, 0x7c, 0x0d, 0xf1, 0x9a, 0x6f, 0x5b, 0x65,
+	0x77, 0x8a, 0x7f, 0xc3, 0x97, 0xe6, 0x1a, 0x66, 0xc3, 0xf4, 0x9f, 0x7b, 0x57, 0xfc, 0xe9, 0x8d,
+	0x86, 0x69, 0x36, 0x9a, 0x44, 0xc1, 0x96, 0xae, 0x60, 0xc3, 0x30, 0x1d, 0xa6, 0x16, 0xf8, 0x5c,
+	0xe3, 0xab, 0xec, 0xae, 0xe6, 0x3e, 0x55, 0xb0, 0xc1, 0x69, 0xc5, 0x42, 0xff, 0x92, 0xa3, 0xb7,
+	0x08, 0x75, 0x70, 0xcb, 0xe2, 0x06, 0xb7, 0xcf, 0x39, 0x72, 0x83, 0x18, 0x84, 0xea, 0x7c, 0x07,
+	0x29, 0x07, 0xf3, 0x9b, 0xde, 0x91, 0x56, 0x03, 0x3b, 0xaa, 0x92, 0x5d, 0x97, 0x50, 0x47, 0xfa,
+	0x02, 0xfe, 0x7f, 0x66, 0x85, 0x5a, 0xa6, 0x41, 0x09, 0x5a, 0x05, 0x08, 0x75, 0x69, 0x4e, 0xb8,
+	0x39, 0xb9, 0x90, 0x29, 0xcd, 0xc9, 0x3e, 0x90, 0x1c, 0x00, 0xc9, 0x0f, 0x8d, 0x76, 0x79, 0xf6,
+	0xe5, 0x8b, 0x62, 0x3a, 0x54, 0x50, 0x23, 0x6e, 0xd2, 0xfb, 0x70, 0xf5, 0xb4, 0x3e, 0xdf, 0x18,
+	0xdd, 0x82, 0x99, 0xd0, 0xac, 0xaa, 0x6b, 0x39, 0xe1, 0xa6, 0xb0, 0x90, 0x54, 0x33, 0xe1, 0xb3,
+	0x8a, 0x26, 0x3d, 0xe9, 0xa7, 0x0e, 0xd1, 0x1e, 0x42, 0x3a, 0x34, 0x64, 0x9e, 0x31, 0xc9, 0x7a,
+	0x5e, 0x21, 0xd8, 0x86, 0x6d, 0x5a, 0x26, 0xc5, 0x4d, 0x3a, 0x02, 0xd8, 0x0e, 0xcc, 0xf7, 0xfb,
+	0x72, 0xb0, 0x4d, 0x48, 0x5b, 0xc1, 0x43, 0x1e, 0xb2, 0xa2, 0x3c, 0x38, 0xe3, 0xe4, 0x53, 0x12,
+	0x81, 0x42, 0x39, 0x79, 0xdc, 0x29, 0x4c, 0xa8, 0x3d, 0x15, 0xe9, 0x3e, 0xcc, 0xf5, 0x59, 0xfa,
+	0x9c, 0x05, 0xc8, 0x04, 0x46, 0x3d, 0x4c, 0x08, 0x1e, 0x55, 0x34, 0xe9, 0x75, 0x02, 0xae, 0x0e,
+	0xdc, 0x03, 0x3d, 0x85, 0x19, 0xcb, 0xad, 0x55, 0x03, 0xdb, 0x0b, 0x23, 0x58, 0xec, 0x76, 0x0a,
+	0x99, 0x0d, 0xb7, 0x16, 0x88, 0xbc, 0x7c, 0x51, 0x14, 0x79, 0xc6, 0x37, 0xcc, 0xbd, 0xf0, 0x30,
+	0xab, 0xa6, 0xe1, 0x10, 0xc3, 0x51, 0x33, 0x56, 0xcf, 0x14, 0xcd, 0x43, 0x42, 0xd7, 0x72, 0x09,
+	0x8f, 0xac, 0x3c, 0xdd, 0xed, 0x14, 0x12, 0x95, 0x35, 0x35, 0xa1, 0x6b, 0xa8, 0xd4, 0x17, 0xe2,
+	0x49, 0x66, 0x71, 0xd9, 0xdb, 0x29, 0x7c, 0x57, 0x95, 0xb5, 0x53, 0x31, 0x47, 0x0f, 0x20, 0xa5,
+	0x11, 0xac, 0x35, 0x75, 0x83, 0xe4, 0x92, 0x8c, 0x57, 0x3c, 0xc3, 0xbb, 0x15, 0x7c, 0x1c, 0xe5,
+	0x94, 0x17, 0xc5, 0x67, 0x7f, 0x14, 0x04, 0x35, 0xf4, 0x42, 0x8f, 0x21, 0x4b, 0x0e, 0x48, 0xdd,
+	0xf5, 0x3e, 0xbd, 0xaa, 0xf7, 0x1d, 0xe5, 0xa6, 0x46, 0xd0, 0x99, 0x0d, 0x7d, 0xbd, 0x55, 0xe9,
+	0x01, 0x5c, 0x67, 0xb1, 0xdd, 0x74, 0x89, 0x4b, 0xb4, 0x71, 0x92, 0x68, 0x17, 0x6e, 0x0c, 0x56,
+	0xf8, 0xef, 0x52, 0xe9, 0x06, 0x88, 0xcc, 0xf2, 0x63, 0x72, 0xe0, 0x04, 0xd6, 0x95, 0xb5, 0xa0,
+	0x14, 0x3c, 0x81, 0xeb, 0x03, 0x57, 0x39, 0xcf, 0x07, 0x70, 0xc5, 0x20, 0x07, 0x4e, 0xf5, 0x4c,
+	0xd2, 0x95, 0x51, 0xb7, 0x53, 0xc8, 0xf6, 0x79, 0x65, 0x8d, 0xe8, 0xbd, 0x26, 0x7d, 0x09, 0xff,
+	0x63, 0xe2, 0x9f, 0x99, 0x0e, 0xa1, 0x71, 0x53, 0x18, 0x3d, 0x02, 0xe8, 0x15, 0x5f, 0x96, 0x48,
+	0x99, 0xd2, 0x1d, 0x99, 0xa7, 0x9f, 0x57, 0xa9, 0x65, 0xbf, 0xb4, 0x07, 0x71, 0xd8, 0xc0, 0x8d,
+	0xa0, 0xc0, 0xa8, 0x11, 0x4f, 0xe9, 0x47, 0x01, 0x50, 0x74, 0x7b, 0x7e, 0xa4, 0x75, 0x98, 0xda,
+	0xf3, 0x1e, 0xf0, 0xf0, 0xde, 0xbd, 0x30, 0xbc, 0x9e, 0x6b, 0x5f, 0x68, 0x7d, 0x6f, 0xf4, 0xd1,
+	0x00, 0xca, 0x37, 0x87, 0x52, 0xfa, 0x4a, 0xa7, 0x30, 0x2b, 0x70, 0x25, 0xb2, 0x55, 0xcc, 0x18,
+	0xcd, 0xf9, 0x87, 0xb0, 0xd9, 0xc6, 0x69, 0x9f, 0xc9, 0x96, 0x9e, 0x0b, 0x91, 0x80, 0x87, 0x07,
+	0x56, 0x06, 0x88, 0x95, 0xb3, 0xdd, 0x4e, 0x01, 0x22, 0xaf, 0x6e, 0xa8, 0x38, 0x5a, 0x81, 0xb4,
+	0x77, 0x51, 0x75, 0xda, 0x16, 0x61, 0x1f, 0x6f, 0xb6, 0x74, 0xf3, 0xbc, 0xd8, 0x79, 0xfb, 0x6f,
+	0xb5, 0x2d, 0xa2, 0xa6, 0xf6, 0xf8, 0x95, 0x74, 0x8f, 0xa3, 0x6d, 0xe1, 0x66, 0xb3, 0x1d, 0xbb,
+	0x9c, 0xfd, 0x9c, 0x04, 0x14, 0x75, 0x1b, 0xf7, 0x48, 0x8f, 0x21, 0xdd, 0x26, 0xb4, 0xea, 0xbf,
+	0x78, 0x76, 0xac, 0xb2, 0xec, 0xbd, 0xcd, 0xd7, 0x9d, 0xc2, 0x9d, 0x86, 0xee, 0x6c, 0xbb, 0x35,
+	0xef, 0x14, 0xbc, 0xab, 0xf3, 0x9f, 0x22, 0xd5, 0x76, 0x14, 0xef, 0xb4, 0x54, 0x5e, 0x23, 0x75,
+	0x35, 0xd5, 0x26, 0x94, 0x65, 0x12, 0xaa, 0x40, 0xca, 0x30, 0xb9, 0xd6, 0xe4, 0x58, 0x5a, 0x97,
+	0x0c, 0xd3, 0x97, 0xfa, 0x04, 0x66, 0xeb, 0xae, 0x6d, 0x13, 0xc3, 0xe1, 0x7a, 0xc9, 0xb1, 0xf4,
+	0x66, 0xb8, 0x88, 0x2f, 0xfa, 0x29, 0x64, 0x2d, 0x93, 0x52, 0xbd, 0xd6, 0x24, 0x5c, 0x75, 0x6a,
+	0x2c, 0xd5, 0xd9, 0x40, 0x25, 0x94, 0xf5, 0x13, 0x60, 0xdb, 0x26, 0x74, 0xdb, 0x6c, 0x6a, 0xb9,
+	0xe9, 0xf1, 0x64, 0x59, 0x4e, 0x04, 0x22, 0xe8, 0x11, 0x4c, 0xef, 0xba, 0xa6, 0xed, 0xb6, 0x72,
+	0x97, 0xc6, 0x92, 0xe3, 0xde, 0xd2, 0x3a, 0x6f, 0x7c, 0x2a, 0xde, 0xdf, 0xc0, 0x36, 0x6e, 0x85,
+	0x05, 0x47, 0x84, 0x14, 0x75, 0x6b, 0xd4, 0xc2, 0x75, 0x7f, 0x6c, 0x48, 0xab, 0xe1, 0x3d, 0xba,
+	0x02, 0x93, 0x3b, 0xa4, 0xcd, 0x13, 0xdd, 0xbb, 0x94, 0x96, 0x61, 0xbe, 0x5f, 0x86, 0x27, 0xdd,
+	0x35, 0x48, 0xd9, 0x78, 0xbf, 0xaa, 0x61, 0x07, 0x73, 0x9d, 0x4b, 0x36, 0xde, 0x5f, 0xc3, 0x0e,
+	0x2e, 0x9d, 0xcc, 0xc0, 0x14, 0xf3, 0x42, 0xcf, 0x05, 0x80, 0xde, 0x58, 0x85, 0xe4, 0x0b, 0xab,
+	0xcb, 0x99, 0xc9, 0x4c, 0x54, 0x62, 0xdb, 0xfb, 0x50, 0xd2, 0xe2, 0x57, 0xbf, 0xfd, 0xfd, 0x75,
+	0xe2, 0x36, 0x92, 0x94, 0x73, 0x66, 0xc2, 0x7a, 0x0f, 0xe6, 0x27, 0x01, 0x7a, 0x63, 0x11, 0x2a,
+	0xc6, 0xdb, 0x2a, 0x20, 0x93, 0xe3, 0x9a, 0x73, 0xb0, 0xf7, 0x18, 0xd8, 0x32, 0x5a, 0x1a, 0x0e,
+	0xa6, 0x1c, 0x46, 0xdb, 0xe6, 0x11, 0xfa, 0x46, 0x80, 0x74, 0xd8, 0x1a, 0x51, 0xbc, 0xfe, 0x47,
+	0xe3, 0x71, 0x9e, 0xe9, 0xb8, 0xd2, 0x5d, 0xc6, 0xf9, 0x06, 0xba, 0x75, 0x1e, 0x67, 0xd8, 0x49,
+	0xd1, 0xf7, 0x02, 0xa4, 0xc2, 0x31, 0xe7, 0xed, 0x98, 0x6d, 0xd9, 0xa7, 0x1a, 0xad, 0x89, 0x4b,
+	0xf7, 0x19, 0xd4, 0x12, 0x52, 0x86, 0x42, 0x29, 0x87, 0x91, 0x42, 0x78, 0x84, 0x7e, 0x15, 0xe0,
+	0x72, 0xdf, 0x6c, 0x81, 0x96, 0x2f, 0xdc, 0x7b, 0xf0, 0x2c, 0x23, 0xde, 0x1b, 0xcd, 0x89, 0x73,
+	0xbf, 0xc3, 0xb8, 0x17, 0xd1, 0x82, 0x72, 0xfe, 0x9f, 0x32, 0x97, 0x68, 0xc5, 0x5e, 0x4c, 0x7f,
+	0x11, 0xa0, 0x6f, 0x8a, 0x40, 0xa5, 0x0b, 0xb7, 0x1e, 0x38, 0xc6, 0x88, 0xcb, 0x23, 0xf9, 0xc4,
+	0xa5, 0xf5, 0xc6, 0x99, 0x90, 0xb5, 0xa8, 0x6b, 0xe8, 0x3b, 0x01, 0xa6, 0xfc, 0x62, 0x38, 0x7c,
+	0x6c, 0x08, 0x43, 0xb9, 0x18, 0xc7, 0x94, 0x23, 0xad, 0x30, 0xa4, 0xfb, 0xe8, 0xdd, 0x11, 0x5f,
+	0xbc, 0xe2, 0x0f, 0x25, 0x3f, 0x08, 0x90, 0xf4, 0x04, 0xd1, 0x42, 0x8c, 0xa9, 0xc6, 0xa7, 0x8b,
+	0x3f, 0xff, 0x48, 0xeb, 0x0c, 0xee, 0x43, 0xb4, 0x32, 0x16, 0x9c, 0x72, 0xe8, 0xfd, 0xd8, 0x47,
+	0x2c, 0x88, 0xac, 0x9d, 0x0f, 0x09, 0x62, 0x74, 0x52, 0x10, 0x17, 0xe3, 0x98, 0xfe, 0xdb, 0x20,
+	0x3a, 0x8c, 0xea, 0x5b, 0x01, 0xd2, 0x61, 0xf5, 0x1f, 0x52, 0x7e, 0xfa, 0x9b, 0x8d, 0x28, 0xc7,
+	0x35, 0x8f, 0x5b, 0xbf, 0x6d, 0xbc, 0x5f, 0xb4, 0x98, 0x4f, 0xb9, 0x72, 0xfc, 0x57, 0x7e, 0xe2,
+	0xb8, 0x9b, 0x17, 0x5e, 0x75, 0xf3, 0xc2, 0x9f, 0xdd, 0xbc, 0xf0, 0xec, 0x24, 0x3f, 0xf1, 0xea,
+	0x24, 0x3f, 0xf1, 0xfb, 0x49, 0x7e, 0xe2, 0xf3, 0xb7, 0x22, 0xfd, 0xd2, 0xd3, 0x2a, 0x36, 0x71,
+	0x8d, 0xfa, 0xaa, 0x07, 0x11, 0x5d, 0xd6, 0x38, 0x6b, 0xd3, 0xec, 0x6f, 0xcf, 0xf2, 0x3f, 0x03,
+	0x00, 0x03, 0xdb, 0x44, 0x5e, 0x3d, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -861,6 +946,8 @@ type QueryClient interface {
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
 	// Deposits queries a proposal based on proposal ID.
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// QueuedProposals queries the passed proposals that are queued for execution, optionally by committee ID.
+	QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error)
 	// NextProposalID queries the next proposal ID of the committee module.
 	NextProposalID(ctx context.Context, in *QueryNextProposalIDRequest, opts ...grpc.CallOption) (*QueryNextProposalIDResponse, error)
 	// Votes queries all votes for a single proposal ID.
@@ -917,6 +1004,15 @@ func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, op
 	return out, nil
 }
 
+func (c *queryClient) QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error) {
+	out := new(QueryQueuedProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kava.committee.v1beta1.Query/QueuedProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) NextProposalID(ctx context.Context, in *QueryNextProposalIDRequest, opts ...grpc.CallOption) (*QueryNextProposalIDResponse, error) {
 	out := new(QueryNextProposalIDResponse)
 	err := c.cc.Invoke(ctx, "/kava.committee.v1beta1.Query/NextProposalID", in, out, opts...)
@@ -972,6 +1068,8 @@ type QueryServer interface {
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
 	// Deposits queries a proposal based on proposal ID.
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// QueuedProposals queries the passed proposals that are queued for execution, optionally by committee ID.
+	QueuedProposals(context.Context, *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error)
 	// NextProposalID queries the next proposal ID of the committee module.
 	NextProposalID(context.Context, *QueryNextProposalIDRequest) (*QueryNextProposalIDResponse, error)
 	// Votes queries all votes for a single proposal ID.
@@ -1000,6 +1098,9 @@ func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryPropos
 func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
 }
+func (*UnimplementedQueryServer) QueuedProposals(ctx context.Context, req *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedProposals not implemented")
+}
 func (*UnimplementedQueryServer) NextProposalID(ctx context.Context, req *QueryNextProposalIDRequest) (*QueryNextProposalIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextProposalID not implemented")
 }
@@ -1092,6 +1193,24 @@ func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueuedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.committee.v1beta1.Query/QueuedProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedProposals(ctx, req.(*QueryQueuedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_NextProposalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNextProposalIDRequest)
 	if err := dec(in); err != nil {
@@ -1202,6 +1321,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Proposal",
 			Handler:    _Query_Proposal_Handler,
 		},
+		{
+			MethodName: "QueuedProposals",
+			Handler:    _Query_QueuedProposals_Handler,
+		},
 		{
 			MethodName: "NextProposalID",
 			Handler:    _Query_NextProposalID_Handler,
@@ -1463,13 +1586,21 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintQuery(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x22
 	if m.CommitteeID != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.CommitteeID))
@@ -1496,6 +1627,71 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryQueuedProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommitteeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommitteeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryNextProposalIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2011,6 +2207,35 @@ func (m *QueryProposalResponse) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
 	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQueuedProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitteeId != 0 {
+		n += 1 + sovQuery(uint64(m.CommitteeId))
+	}
+	return n
+}
+
+func (m *QueryQueuedProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2824,6 +3049,192 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeId", wireType)
+			}
+			m.CommitteeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, QueryProposalResponse{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
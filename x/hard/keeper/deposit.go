@@ -107,10 +107,25 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 // ValidateDeposit validates a deposit
 func (k Keeper) ValidateDeposit(ctx sdk.Context, coins sdk.Coins) error {
 	for _, depCoin := range coins {
-		_, foundMm := k.GetMoneyMarket(ctx, depCoin.Denom)
+		moneyMarket, foundMm := k.GetMoneyMarket(ctx, depCoin.Denom)
 		if !foundMm {
 			return errorsmod.Wrapf(types.ErrInvalidDepositDenom, "money market denom %s not found", depCoin.Denom)
 		}
+
+		// Validate the requested deposit amount for the asset against the money market's global supply limit
+		if moneyMarket.SupplyLimit.HasMaxLimit {
+			assetTotalSuppliedAmount := sdk.ZeroInt()
+			totalSuppliedCoins, found := k.GetSuppliedCoins(ctx)
+			if found {
+				assetTotalSuppliedAmount = totalSuppliedCoins.AmountOf(depCoin.Denom)
+			}
+			newProposedAssetTotalSuppliedAmount := sdk.NewDecFromInt(assetTotalSuppliedAmount.Add(depCoin.Amount))
+			if newProposedAssetTotalSuppliedAmount.GT(moneyMarket.SupplyLimit.MaximumLimit) {
+				return errorsmod.Wrapf(types.ErrGreaterThanAssetSupplyLimit,
+					"proposed deposit would result in %s supplied, but the maximum global asset supply limit is %s",
+					newProposedAssetTotalSuppliedAmount, moneyMarket.SupplyLimit.MaximumLimit)
+			}
+		}
 	}
 
 	return nil
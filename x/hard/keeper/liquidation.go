@@ -397,7 +397,7 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		liqMap[denom] = LiqData{priceData.Price, mm.CollateralLoanToValue(), mm.ConversionFactor}
 	}
 
 	return liqMap, nil
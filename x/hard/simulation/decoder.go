@@ -3,23 +3,66 @@ package simulation
 import (
 	"bytes"
 	"fmt"
-
-	"github.com/tendermint/tendermint/libs/kv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
-// DecodeStore unmarshals the KVPair's Value to the corresponding hard type
-func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
-	switch {
-	case bytes.Equal(kvA.Key[:1], types.DepositsKeyPrefix):
-		var depA, depB types.Deposit
-		cdc.MustUnmarshalBinaryBare(kvA.Value, &depA)
-		cdc.MustUnmarshalBinaryBare(kvB.Value, &depB)
-		return fmt.Sprintf("%s\n%s", depA, depB)
-	default:
-		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding hard type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.DepositsKeyPrefix):
+			var depA, depB types.Deposit
+			cdc.MustUnmarshal(kvA.Value, &depA)
+			cdc.MustUnmarshal(kvB.Value, &depB)
+			return fmt.Sprintf("%s\n%s", depA, depB)
+
+		case bytes.Equal(kvA.Key[:1], types.BorrowsKeyPrefix):
+			var borrowA, borrowB types.Borrow
+			cdc.MustUnmarshal(kvA.Value, &borrowA)
+			cdc.MustUnmarshal(kvB.Value, &borrowB)
+			return fmt.Sprintf("%s\n%s", borrowA, borrowB)
+
+		case bytes.Equal(kvA.Key[:1], types.BorrowedCoinsPrefix),
+			bytes.Equal(kvA.Key[:1], types.SuppliedCoinsPrefix),
+			bytes.Equal(kvA.Key[:1], types.TotalReservesPrefix):
+			var coinsA, coinsB types.CoinsProto
+			cdc.MustUnmarshal(kvA.Value, &coinsA)
+			cdc.MustUnmarshal(kvB.Value, &coinsB)
+			return fmt.Sprintf("%s\n%s", sdk.Coins(coinsA.Coins), sdk.Coins(coinsB.Coins))
+
+		case bytes.Equal(kvA.Key[:1], types.MoneyMarketsPrefix):
+			var mmA, mmB types.MoneyMarket
+			cdc.MustUnmarshal(kvA.Value, &mmA)
+			cdc.MustUnmarshal(kvB.Value, &mmB)
+			return fmt.Sprintf("%v\n%v", mmA, mmB)
+
+		case bytes.Equal(kvA.Key[:1], types.PreviousAccrualTimePrefix):
+			var timeA, timeB time.Time
+			if err := timeA.UnmarshalBinary(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := timeB.UnmarshalBinary(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%s\n%s", timeA, timeB)
+
+		case bytes.Equal(kvA.Key[:1], types.BorrowInterestFactorPrefix),
+			bytes.Equal(kvA.Key[:1], types.SupplyInterestFactorPrefix),
+			bytes.Equal(kvA.Key[:1], types.DelegatorInterestFactorPrefix):
+			var factorA, factorB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &factorA)
+			cdc.MustUnmarshal(kvB.Value, &factorB)
+			return fmt.Sprintf("%s\n%s", factorA.Dec, factorB.Dec)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
 	}
 }
@@ -3,33 +3,40 @@ package simulation
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/tendermint/tendermint/libs/kv"
-
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
-func makeTestCodec() (cdc *codec.Codec) {
-	cdc = codec.New()
-	sdk.RegisterCodec(cdc)
-	codec.RegisterCrypto(cdc)
-	types.RegisterCodec(cdc)
-	return
-}
-
-func TestDecodeDistributionStore(t *testing.T) {
-	cdc := makeTestCodec()
+func TestDecodeHardStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := NewDecodeStore(cdc)
 
-	deposit := types.NewDeposit(sdk.AccAddress("test"), sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(1))), types.SupplyInterestFactors{})
+	coins := sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(1)))
+	deposit := types.NewDeposit(sdk.AccAddress("test"), coins, types.SupplyInterestFactors{})
+	borrow := types.NewBorrow(sdk.AccAddress("test"), coins, types.BorrowInterestFactors{})
+	accrualTime := time.Now().UTC()
+	bAccrualTime, err := accrualTime.MarshalBinary()
+	require.NoError(t, err)
+	factor := sdk.MustNewDecFromStr("1.000001")
 
 	kvPairs := kv.Pairs{
-		kv.Pair{Key: []byte(types.DepositsKeyPrefix), Value: cdc.MustMarshalBinaryBare(deposit)},
-		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+		Pairs: []kv.Pair{
+			{Key: types.DepositsKeyPrefix, Value: cdc.MustMarshal(&deposit)},
+			{Key: types.BorrowsKeyPrefix, Value: cdc.MustMarshal(&borrow)},
+			{Key: types.SuppliedCoinsPrefix, Value: cdc.MustMarshal(&types.CoinsProto{Coins: coins})},
+			{Key: types.PreviousAccrualTimePrefix, Value: bAccrualTime},
+			{Key: types.BorrowInterestFactorPrefix, Value: cdc.MustMarshal(&sdk.DecProto{Dec: factor})},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
 	}
 
 	tests := []struct {
@@ -37,6 +44,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Deposit", fmt.Sprintf("%s\n%s", deposit, deposit)},
+		{"Borrow", fmt.Sprintf("%s\n%s", borrow, borrow)},
+		{"SuppliedCoins", fmt.Sprintf("%s\n%s", coins, coins)},
+		{"PreviousAccrualTime", fmt.Sprintf("%s\n%s", accrualTime, accrualTime)},
+		{"BorrowInterestFactor", fmt.Sprintf("%s\n%s", factor, factor)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -44,9 +55,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			switch i {
 			case len(tests) - 1:
-				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i], kvPairs[i]) }, tt.name)
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
 			default:
-				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name)
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
 			}
 		})
 	}
@@ -1,79 +1,136 @@
 package simulation
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/rand"
 
-	"github.com/cosmos/cosmos-sdk/codec"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
-// SecondsPerYear is the number of seconds in a year
-const (
-	SecondsPerYear = 31536000
-	// BaseAprPadding sets the minimum inflation to the calculated SPR inflation rate from being 0.0
-	BaseAprPadding = "0.000000003022265980"
+// simMoneyMarket describes an asset that is listed as a money market in simulations
+type simMoneyMarket struct {
+	denom        string
+	spotMarketID string
+	// maxBalance is the upper bound of the asset held by a simulated account at genesis
+	maxBalance sdkmath.Int
+}
+
+var (
+	// simMoneyMarkets are priced by the markets of the pricefeed simulation
+	simMoneyMarkets = []simMoneyMarket{
+		{denom: "bnb", spotMarketID: "bnb:usd", maxBalance: sdkmath.NewInt(100000000000)},  // 1,000 bnb
+		{denom: "btc", spotMarketID: "btc:usd", maxBalance: sdkmath.NewInt(500000000)},     // 5 btc
+		{denom: "xrp", spotMarketID: "xrp:usd", maxBalance: sdkmath.NewInt(2000000000000)}, // 20,000 xrp
+	}
+
+	// conversionFactor is the number of base units per whole unit for each simulated asset
+	conversionFactor = sdkmath.NewInt(100000000)
 )
 
 // RandomizedGenState generates a random GenesisState for hard module
 func RandomizedGenState(simState *module.SimulationState) {
-	// params := genRandomParams(simState)
-	// if err := params.Validate(); err != nil {
-	// 	panic(err)
-	// }
+	params := genRandomParams(simState.Rand)
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
 
-	hardGenesis := types.DefaultGenesisState()
+	hardGenesis := types.NewGenesisState(
+		params,
+		types.DefaultAccumulationTimes,
+		types.DefaultDeposits,
+		types.DefaultBorrows,
+		types.DefaultTotalSupplied,
+		types.DefaultTotalBorrowed,
+		types.DefaultTotalReserves,
+	)
 	if err := hardGenesis.Validate(); err != nil {
 		panic(err)
 	}
 
-	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, hardGenesis))
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(hardGenesis)
+	// Simulated accounts are only funded with the bond denom. Give them money market assets so that
+	// lending activity can be simulated.
+	fundAccounts(simState)
+
+	bz, err := json.MarshalIndent(&hardGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&hardGenesis)
+}
+
+func genRandomParams(r *rand.Rand) types.Params {
+	var moneyMarkets types.MoneyMarkets
+	for _, mm := range simMoneyMarkets {
+		moneyMarkets = append(moneyMarkets, genRandomMoneyMarket(r, mm))
+	}
+	return types.NewParams(moneyMarkets, types.DefaultMinimumBorrowUSDValue)
+}
+
+func genRandomMoneyMarket(r *rand.Rand, simMM simMoneyMarket) types.MoneyMarket {
+	// loan-to-value between 40% and 80%
+	ltv := sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 40, 81)), 2)
+	borrowLimit := types.NewBorrowLimit(false, sdk.ZeroDec(), ltv)
+
+	interestRateModel := types.NewInterestRateModel(
+		sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 6)), 2),   // base rate 0-5%
+		sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 21)), 2),  // base multiplier 1-20%
+		sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 50, 96)), 2), // kink 50-95%
+		sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 6)), 0),   // jump multiplier 1-5x
+	)
+
+	mm := types.NewMoneyMarket(
+		simMM.denom,
+		borrowLimit,
+		simMM.spotMarketID,
+		conversionFactor,
+		interestRateModel,
+		sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 21)), 2), // reserve factor 0-20%
+		sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 11)), 2), // keeper reward 1-10%
+	)
+
+	// occasionally cap the total supply of a market
+	if r.Intn(4) == 0 {
+		mm.SupplyLimit = types.NewSupplyLimit(true, sdk.NewDecFromInt(simMM.maxBalance.MulRaw(10)))
+	}
+	return mm
 }
 
-// func genRandomParams(simState *module.SimulationState) types.Params {
-// 	periods := genRandomPeriods(simState.Rand, simState.GenTimestamp)
-// 	params := types.NewParams(true, periods)
-// 	return params
-// }
-
-// func genRandomPeriods(r *rand.Rand, timestamp time.Time) types.Periods {
-// 	var periods types.Periods
-// 	numPeriods := simulation.RandIntBetween(r, 1, 10)
-// 	periodStart := timestamp
-// 	for i := 0; i < numPeriods; i++ {
-// 		// set periods to be between 1-3 days
-// 		durationMultiplier := simulation.RandIntBetween(r, 1, 3)
-// 		duration := time.Duration(int64(24*durationMultiplier)) * time.Hour
-// 		periodEnd := periodStart.Add(duration)
-// 		inflation := genRandomInflation(r)
-// 		period := types.NewPeriod(periodStart, periodEnd, inflation)
-// 		periods = append(periods, period)
-// 		periodStart = periodEnd
-// 	}
-// 	return periods
-// }
-
-// func genRandomInflation(r *rand.Rand) sdk.Dec {
-// 	// If sim.RandomDecAmount is less than base apr padding, add base apr padding
-// 	aprPadding, _ := sdk.NewDecFromStr(BaseAprPadding)
-// 	extraAprInflation := simulation.RandomDecAmount(r, sdk.MustNewDecFromStr("0.25"))
-// 	for extraAprInflation.LT(aprPadding) {
-// 		extraAprInflation = extraAprInflation.Add(aprPadding)
-// 	}
-// 	aprInflation := sdk.OneDec().Add(extraAprInflation)
-
-// 	// convert APR inflation to SPR (inflation per second)
-// 	inflationSpr, err := aprInflation.ApproxRoot(uint64(SecondsPerYear))
-// 	if err != nil {
-// 		panic(fmt.Sprintf("error generating random inflation %v", err))
-// 	}
-// 	return inflationSpr
-// }
-
-// func genRandomActive(r *rand.Rand) bool {
-// 	threshold := 50
-// 	value := simulation.RandIntBetween(r, 1, 100)
-// 	return value > threshold
-// }
+// fundAccounts adds a random amount of each money market asset to every simulated account in the bank genesis state
+func fundAccounts(simState *module.SimulationState) {
+	bankGenStateBz, found := simState.GenState[banktypes.ModuleName]
+	if !found {
+		panic("bank genesis state must be generated before hard genesis state")
+	}
+	var bankGenState banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(bankGenStateBz, &bankGenState)
+
+	added := sdk.NewCoins()
+	for _, acc := range simState.Accounts {
+		var coins sdk.Coins
+		for _, mm := range simMoneyMarkets {
+			amount := simulation.RandomAmount(simState.Rand, mm.maxBalance)
+			if amount.IsPositive() {
+				coins = append(coins, sdk.NewCoin(mm.denom, amount))
+			}
+		}
+		coins = sdk.NewCoins(coins...)
+		added = added.Add(coins...)
+
+		for i, balance := range bankGenState.Balances {
+			if balance.Address == acc.Address.String() {
+				bankGenState.Balances[i].Coins = balance.Coins.Add(coins...)
+			}
+		}
+	}
+	bankGenState.Supply = bankGenState.Supply.Add(added...)
+
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenState)
+}
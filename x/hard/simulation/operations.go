@@ -0,0 +1,478 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	appparams "github.com/kava-labs/kava/app/params"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgDeposit       = "op_weight_msg_hard_deposit"
+	OpWeightMsgWithdraw      = "op_weight_msg_hard_withdraw"
+	OpWeightMsgBorrow        = "op_weight_msg_hard_borrow"
+	OpWeightMsgBorrowToLimit = "op_weight_msg_hard_borrow_to_limit"
+	OpWeightMsgRepay         = "op_weight_msg_hard_repay"
+	OpWeightMsgLiquidate     = "op_weight_msg_hard_liquidate"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, pfk types.PricefeedKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgDeposit       int
+		weightMsgWithdraw      int
+		weightMsgBorrow        int
+		weightMsgBorrowToLimit int
+		weightMsgRepay         int
+		weightMsgLiquidate     int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeposit = appparams.DefaultWeightMsgDeposit
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdraw, &weightMsgWithdraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdraw = appparams.DefaultWeightMsgWithdraw
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgBorrow, &weightMsgBorrow, nil,
+		func(_ *rand.Rand) {
+			weightMsgBorrow = appparams.DefaultWeightMsgBorrow
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgBorrowToLimit, &weightMsgBorrowToLimit, nil,
+		func(_ *rand.Rand) {
+			weightMsgBorrowToLimit = appparams.DefaultWeightMsgBorrowToLimit
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRepay, &weightMsgRepay, nil,
+		func(_ *rand.Rand) {
+			weightMsgRepay = appparams.DefaultWeightMsgRepay
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgLiquidate, &weightMsgLiquidate, nil,
+		func(_ *rand.Rand) {
+			weightMsgLiquidate = appparams.DefaultWeightMsgLiquidate
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgDeposit,
+			SimulateMsgDeposit(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdraw,
+			SimulateMsgWithdraw(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBorrow,
+			SimulateMsgBorrow(ak, bk, pfk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBorrowToLimit,
+			SimulateMsgBorrowToLimit(ak, bk, pfk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRepay,
+			SimulateMsgRepay(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgLiquidate,
+			SimulateMsgLiquidate(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgDeposit deposits a random amount of a money market asset held by a random account
+func SimulateMsgDeposit(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgDeposit{}.Type()
+
+		acc, _ := simtypes.RandomAcc(r, accs)
+		moneyMarket, found := randomMoneyMarket(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no money markets"), nil, nil
+		}
+
+		balance := bk.SpendableCoins(ctx, acc.Address).AmountOf(moneyMarket.Denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate deposit amount"), nil, err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(moneyMarket.Denom, amount))
+
+		if err := tryInCacheContext(ctx, func(cacheCtx sdk.Context) error {
+			return k.Deposit(cacheCtx, acc.Address, coins)
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgDeposit(acc.Address, coins)
+		return deliverMsg(r, app, ctx, ak, bk, acc, &msg, msgType, coins)
+	}
+}
+
+// SimulateMsgWithdraw withdraws a random amount of a random depositor's deposit
+func SimulateMsgWithdraw(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgWithdraw{}.Type()
+
+		var depositors []sdk.AccAddress
+		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+			depositors = append(depositors, deposit.Depositor)
+			return false
+		})
+		acc, found := randomAccountFrom(r, accs, depositors)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no depositors"), nil, nil
+		}
+
+		deposit, found := k.GetSyncedDeposit(ctx, acc.Address)
+		if !found || deposit.Amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no deposit"), nil, nil
+		}
+		coins, err := randomPortion(r, deposit.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate withdraw amount"), nil, err
+		}
+
+		if err := tryInCacheContext(ctx, func(cacheCtx sdk.Context) error {
+			return k.Withdraw(cacheCtx, acc.Address, coins)
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgWithdraw(acc.Address, coins)
+		return deliverMsg(r, app, ctx, ak, bk, acc, &msg, msgType, nil)
+	}
+}
+
+// SimulateMsgBorrow borrows a random amount of a money market asset within a random depositor's borrow limit
+func SimulateMsgBorrow(
+	ak types.AccountKeeper, bk types.BankKeeper, pfk types.PricefeedKeeper, k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgBorrow{}.Type()
+
+		var depositors []sdk.AccAddress
+		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+			depositors = append(depositors, deposit.Depositor)
+			return false
+		})
+		acc, found := randomAccountFrom(r, accs, depositors)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no depositors"), nil, nil
+		}
+
+		moneyMarket, found := randomMoneyMarket(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no money markets"), nil, nil
+		}
+		if !moneyMarket.IsBorrowable() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "money market is not borrowable"), nil, nil
+		}
+
+		available, err := availableToBorrow(ctx, k, pfk, acc.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		if !available.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no borrowing capacity"), nil, nil
+		}
+
+		price, err := pfk.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		if err != nil || !price.Price.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no price for money market"), nil, nil
+		}
+
+		// borrow a random fraction of the remaining capacity
+		fraction := simtypes.RandomDecAmount(r, sdk.OneDec())
+		amount := available.Mul(fraction).Quo(price.Price).MulInt(moneyMarket.ConversionFactor).TruncateInt()
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "borrow amount rounds to zero"), nil, nil
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(moneyMarket.Denom, amount))
+
+		if err := tryInCacheContext(ctx, func(cacheCtx sdk.Context) error {
+			return k.Borrow(cacheCtx, acc.Address, coins)
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgBorrow(acc.Address, coins)
+		return deliverMsg(r, app, ctx, ak, bk, acc, &msg, msgType, nil)
+	}
+}
+
+// SimulateMsgBorrowToLimit borrows a money market asset a random depositor has not deposited, up to the
+// depositor's full borrow limit. Price moves of the borrowed asset or of the collateral then take the borrow
+// out of the valid loan-to-value range, which lets SimulateMsgLiquidate liquidate it.
+func SimulateMsgBorrowToLimit(
+	ak types.AccountKeeper, bk types.BankKeeper, pfk types.PricefeedKeeper, k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgBorrow{}.Type()
+
+		var depositors []sdk.AccAddress
+		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+			depositors = append(depositors, deposit.Depositor)
+			return false
+		})
+		acc, found := randomAccountFrom(r, accs, depositors)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no depositors"), nil, nil
+		}
+
+		deposit, _ := k.GetSyncedDeposit(ctx, acc.Address)
+		var moneyMarkets types.MoneyMarkets
+		for _, mm := range k.GetParams(ctx).MoneyMarkets {
+			if mm.IsBorrowable() && deposit.Amount.AmountOf(mm.Denom).IsZero() {
+				moneyMarkets = append(moneyMarkets, mm)
+			}
+		}
+		if len(moneyMarkets) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no borrowable money market without a deposit"), nil, nil
+		}
+		moneyMarket := moneyMarkets[r.Intn(len(moneyMarkets))]
+
+		available, err := availableToBorrow(ctx, k, pfk, acc.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		if !available.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no borrowing capacity"), nil, nil
+		}
+
+		price, err := pfk.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		if err != nil || !price.Price.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no price for money market"), nil, nil
+		}
+
+		amount := available.Quo(price.Price).MulInt(moneyMarket.ConversionFactor).TruncateInt()
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "borrow amount rounds to zero"), nil, nil
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(moneyMarket.Denom, amount))
+
+		if err := tryInCacheContext(ctx, func(cacheCtx sdk.Context) error {
+			return k.Borrow(cacheCtx, acc.Address, coins)
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgBorrow(acc.Address, coins)
+		return deliverMsg(r, app, ctx, ak, bk, acc, &msg, msgType, nil)
+	}
+}
+
+// SimulateMsgRepay repays a random amount of a random borrower's outstanding borrow
+func SimulateMsgRepay(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgRepay{}.Type()
+
+		var borrowers []sdk.AccAddress
+		k.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+			borrowers = append(borrowers, borrow.Borrower)
+			return false
+		})
+		acc, found := randomAccountFrom(r, accs, borrowers)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no borrowers"), nil, nil
+		}
+
+		borrow, found := k.GetSyncedBorrow(ctx, acc.Address)
+		if !found || borrow.Amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no borrow"), nil, nil
+		}
+
+		// fully repay half of the time, otherwise repay a random portion
+		var coins sdk.Coins
+		if r.Intn(2) == 0 {
+			coins = borrow.Amount
+		} else {
+			var err error
+			coins, err = randomPortion(r, borrow.Amount)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate repay amount"), nil, err
+			}
+		}
+
+		spendable := bk.SpendableCoins(ctx, acc.Address)
+		if !spendable.IsAllGTE(coins) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds"), nil, nil
+		}
+
+		if err := tryInCacheContext(ctx, func(cacheCtx sdk.Context) error {
+			return k.Repay(cacheCtx, acc.Address, acc.Address, coins)
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgRepay(acc.Address, acc.Address, coins)
+		return deliverMsg(r, app, ctx, ak, bk, acc, &msg, msgType, coins)
+	}
+}
+
+// SimulateMsgLiquidate liquidates the first borrower found outside of the valid loan-to-value range
+func SimulateMsgLiquidate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgLiquidate{}.Type()
+
+		keeperAcc, _ := simtypes.RandomAcc(r, accs)
+
+		var borrowers []sdk.AccAddress
+		k.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+			borrowers = append(borrowers, borrow.Borrower)
+			return false
+		})
+
+		for _, borrower := range borrowers {
+			if borrower.Equals(keeperAcc.Address) {
+				continue
+			}
+			if err := tryInCacheContext(ctx, func(cacheCtx sdk.Context) error {
+				return k.AttemptKeeperLiquidation(cacheCtx, keeperAcc.Address, borrower)
+			}); err != nil {
+				continue
+			}
+
+			msg := types.NewMsgLiquidate(keeperAcc.Address, borrower)
+			return deliverMsg(r, app, ctx, ak, bk, keeperAcc, &msg, msgType, nil)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no borrowers to liquidate"), nil, nil
+	}
+}
+
+// deliverMsg signs and delivers a msg from a simulated account, paying random fees
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	acc simtypes.Account, msg sdk.Msg, msgType string, coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      acc,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: coinsSpentInMsg,
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// tryInCacheContext runs fn against a branch of the state that is always discarded, so operations
+// only deliver msgs that are expected to succeed
+func tryInCacheContext(ctx sdk.Context, fn func(sdk.Context) error) error {
+	cacheCtx, _ := ctx.CacheContext()
+	return fn(cacheCtx)
+}
+
+// randomMoneyMarket picks a random money market from the module params
+func randomMoneyMarket(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.MoneyMarket, bool) {
+	moneyMarkets := k.GetParams(ctx).MoneyMarkets
+	if len(moneyMarkets) == 0 {
+		return types.MoneyMarket{}, false
+	}
+	return moneyMarkets[r.Intn(len(moneyMarkets))], true
+}
+
+// randomAccountFrom picks a random simulated account out of the given addresses
+func randomAccountFrom(r *rand.Rand, accs []simtypes.Account, addrs []sdk.AccAddress) (simtypes.Account, bool) {
+	r.Shuffle(len(addrs), func(i, j int) {
+		addrs[i], addrs[j] = addrs[j], addrs[i]
+	})
+	for _, addr := range addrs {
+		if acc, found := simtypes.FindAccount(accs, addr); found {
+			return acc, true
+		}
+	}
+	return simtypes.Account{}, false
+}
+
+// randomPortion returns a random positive amount of a random coin in coins
+func randomPortion(r *rand.Rand, coins sdk.Coins) (sdk.Coins, error) {
+	coin := coins[r.Intn(len(coins))]
+	amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)), nil
+}
+
+// availableToBorrow returns the USD value a depositor can borrow before reaching their borrow limit
+func availableToBorrow(
+	ctx sdk.Context, k keeper.Keeper, pfk types.PricefeedKeeper, depositor sdk.AccAddress,
+) (sdk.Dec, error) {
+	deposit, _ := k.GetSyncedDeposit(ctx, depositor)
+	borrow, _ := k.GetSyncedBorrow(ctx, depositor)
+
+	borrowLimit, err := usdValue(ctx, k, pfk, deposit.Amount, true)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	borrowed, err := usdValue(ctx, k, pfk, borrow.Amount, false)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return borrowLimit.Sub(borrowed), nil
+}
+
+// usdValue returns the USD value of coins held in money markets, optionally weighted by each market's
+// collateral loan-to-value
+func usdValue(
+	ctx sdk.Context, k keeper.Keeper, pfk types.PricefeedKeeper, coins sdk.Coins, applyLtv bool,
+) (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	for _, coin := range coins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdk.Dec{}, types.ErrMoneyMarketNotFound
+		}
+		price, err := pfk.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		value := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(price.Price)
+		if applyLtv {
+			value = value.Mul(moneyMarket.CollateralLoanToValue())
+		}
+		total = total.Add(value)
+	}
+	return total, nil
+}
@@ -67,4 +67,8 @@ var (
 	ErrExceedsProtocolBorrowableBalance = errorsmod.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = errorsmod.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrMarketNotBorrowable error for when a user attempts to borrow from a collateral-only money market
+	ErrMarketNotBorrowable = errorsmod.Register(ModuleName, 33, "money market is not borrowable")
+	// ErrGreaterThanAssetSupplyLimit error for when a proposed deposit would increase supplied amount over the asset's supply limit
+	ErrGreaterThanAssetSupplyLimit = errorsmod.Register(ModuleName, 34, "fails global asset supply limit validation")
 )
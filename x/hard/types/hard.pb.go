@@ -74,6 +74,11 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel                      `protobuf:"bytes,5,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model"`
 	ReserveFactor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor"`
 	KeeperRewardPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
+	// collateral_only markets can be deposited as collateral but never borrowed.
+	CollateralOnly bool `protobuf:"varint,8,opt,name=collateral_only,json=collateralOnly,proto3" json:"collateral_only,omitempty"`
+	// borrow_only markets can be borrowed but deposits provide no collateral value.
+	BorrowOnly  bool        `protobuf:"varint,9,opt,name=borrow_only,json=borrowOnly,proto3" json:"borrow_only,omitempty"`
+	SupplyLimit SupplyLimit `protobuf:"bytes,10,opt,name=supply_limit,json=supplyLimit,proto3" json:"supply_limit"`
 }
 
 func (m *MoneyMarket) Reset()         { *m = MoneyMarket{} }
@@ -149,6 +154,45 @@ func (m *BorrowLimit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BorrowLimit proto.InternalMessageInfo
 
+// SupplyLimit enforces a cap on the total amount deposited into a money market.
+type SupplyLimit struct {
+	HasMaxLimit  bool                                   `protobuf:"varint,1,opt,name=has_max_limit,json=hasMaxLimit,proto3" json:"has_max_limit"`
+	MaximumLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maximum_limit,json=maximumLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_limit"`
+}
+
+func (m *SupplyLimit) Reset()         { *m = SupplyLimit{} }
+func (m *SupplyLimit) String() string { return proto.CompactTextString(m) }
+func (*SupplyLimit) ProtoMessage()    {}
+func (*SupplyLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{3}
+}
+func (m *SupplyLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyLimit.Merge(m, src)
+}
+func (m *SupplyLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyLimit proto.InternalMessageInfo
+
 // InterestRateModel contains information about an asset's interest rate.
 type InterestRateModel struct {
 	BaseRateAPY    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_rate_apy,json=baseRateApy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_rate_apy"`
@@ -161,7 +205,7 @@ func (m *InterestRateModel) Reset()         { *m = InterestRateModel{} }
 func (m *InterestRateModel) String() string { return proto.CompactTextString(m) }
 func (*InterestRateModel) ProtoMessage()    {}
 func (*InterestRateModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{3}
+	return fileDescriptor_23a5de800263a2ff, []int{4}
 }
 func (m *InterestRateModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +245,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{4}
+	return fileDescriptor_23a5de800263a2ff, []int{5}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +285,7 @@ func (m *Borrow) Reset()         { *m = Borrow{} }
 func (m *Borrow) String() string { return proto.CompactTextString(m) }
 func (*Borrow) ProtoMessage()    {}
 func (*Borrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{5}
+	return fileDescriptor_23a5de800263a2ff, []int{6}
 }
 func (m *Borrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +324,7 @@ func (m *SupplyInterestFactor) Reset()         { *m = SupplyInterestFactor{} }
 func (m *SupplyInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactor) ProtoMessage()    {}
 func (*SupplyInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{6}
+	return fileDescriptor_23a5de800263a2ff, []int{7}
 }
 func (m *SupplyInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +363,7 @@ func (m *BorrowInterestFactor) Reset()         { *m = BorrowInterestFactor{} }
 func (m *BorrowInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactor) ProtoMessage()    {}
 func (*BorrowInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{7}
+	return fileDescriptor_23a5de800263a2ff, []int{8}
 }
 func (m *BorrowInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +401,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{8}
+	return fileDescriptor_23a5de800263a2ff, []int{9}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,6 +434,7 @@ func init() {
 	proto.RegisterType((*Params)(nil), "kava.hard.v1beta1.Params")
 	proto.RegisterType((*MoneyMarket)(nil), "kava.hard.v1beta1.MoneyMarket")
 	proto.RegisterType((*BorrowLimit)(nil), "kava.hard.v1beta1.BorrowLimit")
+	proto.RegisterType((*SupplyLimit)(nil), "kava.hard.v1beta1.SupplyLimit")
 	proto.RegisterType((*InterestRateModel)(nil), "kava.hard.v1beta1.InterestRateModel")
 	proto.RegisterType((*Deposit)(nil), "kava.hard.v1beta1.Deposit")
 	proto.RegisterType((*Borrow)(nil), "kava.hard.v1beta1.Borrow")
@@ -401,64 +446,68 @@ func init() {
 func init() { proto.RegisterFile("kava/hard/v1beta1/hard.proto", fileDescriptor_23a5de800263a2ff) }
 
 var fileDescriptor_23a5de800263a2ff = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xf7, 0x9e, 0x3f, 0x92, 0x8c, 0xed, 0x23, 0xde, 0xdc, 0xa1, 0x4d, 0x04, 0xeb, 0xc8, 0x42,
-	0x70, 0x8d, 0x6d, 0x02, 0x82, 0x8a, 0xe6, 0x16, 0x0b, 0x38, 0x81, 0x25, 0x6b, 0x8f, 0x20, 0x25,
-	0x42, 0x5a, 0xc6, 0xbb, 0x2f, 0x77, 0x83, 0x3d, 0x3b, 0xab, 0x99, 0xb1, 0x63, 0x77, 0xb4, 0x34,
-	0x88, 0x3f, 0x82, 0x8a, 0x0e, 0xe9, 0xfe, 0x88, 0x2b, 0xa3, 0x54, 0x88, 0xc2, 0x80, 0xaf, 0xa3,
-	0xa6, 0xa2, 0x42, 0xf3, 0xe1, 0x8f, 0x5c, 0x1c, 0x29, 0xa7, 0x58, 0x88, 0x6a, 0x77, 0xe6, 0xbd,
-	0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x9b, 0x99, 0x87, 0xde, 0x18, 0xe0, 0x31, 0x6e, 0x9f, 0x62, 0x9e,
-	0xb4, 0xc7, 0xf7, 0xfa, 0x20, 0xf1, 0x3d, 0x3d, 0x68, 0x65, 0x9c, 0x49, 0xe6, 0xd6, 0x94, 0xb5,
-	0xa5, 0x27, 0xac, 0xf5, 0x8e, 0x1f, 0x33, 0x41, 0x99, 0x68, 0xf7, 0xb1, 0x80, 0xe5, 0x92, 0x98,
-	0x91, 0xd4, 0x2c, 0xb9, 0x73, 0xdb, 0xd8, 0x23, 0x3d, 0x6a, 0x9b, 0x81, 0x35, 0xed, 0x9d, 0xb0,
-	0x13, 0x66, 0xe6, 0xd5, 0x9f, 0x99, 0x6d, 0xfc, 0xed, 0xa0, 0x52, 0x0f, 0x73, 0x4c, 0x85, 0xfb,
-	0x00, 0x55, 0x29, 0x4b, 0x61, 0x1a, 0x51, 0xcc, 0x07, 0x20, 0x85, 0xe7, 0xdc, 0xcd, 0x1f, 0x94,
-	0xdf, 0xf3, 0x5b, 0xcf, 0xd1, 0x68, 0x75, 0x95, 0x5f, 0x57, 0xbb, 0x05, 0x7b, 0xe7, 0xb3, 0x7a,
-	0xee, 0xe7, 0xdf, 0xeb, 0x95, 0xb5, 0x49, 0x11, 0x56, 0xe8, 0xda, 0xc8, 0xfd, 0xc1, 0x41, 0x1e,
-	0x25, 0x29, 0xa1, 0x23, 0x1a, 0xf5, 0x19, 0xe7, 0xec, 0x71, 0x34, 0x12, 0x49, 0x34, 0xc6, 0xc3,
-	0x11, 0x78, 0x3b, 0x77, 0x9d, 0x83, 0x1b, 0xc1, 0x7d, 0x05, 0xf3, 0xdb, 0xac, 0xfe, 0xf6, 0x09,
-	0x91, 0xa7, 0xa3, 0x7e, 0x2b, 0x66, 0xd4, 0xf2, 0xb7, 0x9f, 0xa6, 0x48, 0x06, 0x6d, 0x39, 0xcd,
-	0x40, 0xb4, 0x3a, 0x10, 0xcf, 0x67, 0xf5, 0xfd, 0xae, 0x41, 0x0c, 0x34, 0xe0, 0xfd, 0xe3, 0xce,
-	0x57, 0x0a, 0xee, 0xe9, 0x59, 0x13, 0xd9, 0xbc, 0x3b, 0x10, 0x87, 0xfb, 0xf4, 0x19, 0x27, 0x91,
-	0x68, 0xa7, 0xc6, 0x79, 0x01, 0x95, 0xd7, 0xf8, 0xba, 0x7b, 0xa8, 0x98, 0x40, 0xca, 0xa8, 0xe7,
-	0x28, 0x32, 0xa1, 0x19, 0xb8, 0x9f, 0xa2, 0x8a, 0x65, 0x3b, 0x24, 0x94, 0x48, 0xcd, 0x74, 0xb3,
-	0x20, 0x06, 0xfe, 0x0b, 0xe5, 0x15, 0x14, 0x54, 0x26, 0x61, 0xb9, 0xbf, 0x9a, 0x72, 0x3f, 0x44,
-	0xbb, 0x22, 0x63, 0xd2, 0x2a, 0x1b, 0x91, 0xc4, 0xcb, 0xeb, 0xa4, 0x6f, 0xce, 0x67, 0xf5, 0xca,
-	0x71, 0xc6, 0xa4, 0xa1, 0x71, 0xd4, 0x09, 0x2b, 0x62, 0x35, 0x4a, 0x5c, 0x82, 0x6a, 0x31, 0x4b,
-	0xc7, 0xc0, 0x05, 0x61, 0x69, 0xf4, 0x08, 0xc7, 0x92, 0x71, 0xaf, 0xa0, 0x97, 0x7e, 0x74, 0x05,
-	0xbd, 0x8e, 0x52, 0xb9, 0x26, 0xcb, 0x51, 0x2a, 0xc3, 0x9b, 0x2b, 0xd8, 0x4f, 0x34, 0xaa, 0xfb,
-	0x10, 0xdd, 0x22, 0xa9, 0x04, 0x0e, 0x42, 0x46, 0x1c, 0x4b, 0x88, 0x28, 0x4b, 0x60, 0xe8, 0x15,
-	0x75, 0xca, 0x6f, 0x6d, 0x48, 0xf9, 0xc8, 0x7a, 0x87, 0x58, 0x42, 0x57, 0xf9, 0xda, 0xc4, 0x6b,
-	0xe4, 0xb2, 0xc1, 0x8d, 0xd1, 0x2e, 0x07, 0x01, 0x7c, 0x0c, 0x8b, 0x1c, 0x4a, 0x57, 0xce, 0xa1,
-	0x03, 0xf1, 0xa5, 0xad, 0xad, 0x5a, 0x4c, 0x9b, 0xc0, 0x18, 0x79, 0x03, 0x80, 0x0c, 0x78, 0xc4,
-	0xe1, 0x31, 0xe6, 0x49, 0x94, 0x01, 0x8f, 0x21, 0x95, 0xf8, 0x04, 0xbc, 0x6b, 0x5b, 0x08, 0xf7,
-	0xba, 0x41, 0x0f, 0x35, 0x78, 0x6f, 0x89, 0xdd, 0xf8, 0x7e, 0x07, 0x95, 0xd7, 0xb6, 0xdf, 0xfd,
-	0x00, 0x55, 0x4f, 0xb1, 0x88, 0x28, 0x9e, 0xd8, 0xaa, 0x51, 0x25, 0x75, 0x3d, 0xa8, 0xfd, 0x35,
-	0xab, 0x3f, 0x6b, 0x08, 0xcb, 0xa7, 0x58, 0x74, 0xf1, 0xc4, 0x2c, 0xc3, 0xa8, 0x4a, 0xf1, 0x44,
-	0x9f, 0x90, 0x55, 0xb1, 0xbd, 0x2a, 0xe7, 0x8a, 0x85, 0x34, 0x21, 0xbe, 0x41, 0xd5, 0x21, 0xc3,
-	0x69, 0x24, 0x99, 0x3d, 0x79, 0xf9, 0x2d, 0x84, 0x28, 0x2b, 0xc8, 0x2f, 0x99, 0x39, 0x56, 0x3f,
-	0xe5, 0x51, 0xed, 0xb9, 0xba, 0x70, 0x19, 0xaa, 0xaa, 0xfb, 0xca, 0x94, 0x15, 0xce, 0xa6, 0xe6,
-	0x90, 0x05, 0x9f, 0x5f, 0xf9, 0xc4, 0x97, 0x03, 0x2c, 0x40, 0xe1, 0x1e, 0xf6, 0x1e, 0x5c, 0xa6,
-	0xd1, 0x5f, 0x98, 0xb2, 0xa9, 0x0b, 0xe8, 0x35, 0x1d, 0x90, 0x8e, 0x86, 0x92, 0x64, 0x43, 0x02,
-	0x7c, 0x2b, 0x6a, 0xee, 0x2a, 0xd0, 0xee, 0x12, 0xd3, 0xed, 0xa1, 0xc2, 0x80, 0xa4, 0x83, 0xad,
-	0xc8, 0xa8, 0x91, 0x14, 0xf1, 0x6f, 0x47, 0x34, 0x5b, 0x27, 0x5e, 0xd8, 0x06, 0x71, 0x05, 0xba,
-	0x22, 0xde, 0x38, 0xdb, 0x41, 0xd7, 0x3a, 0x90, 0x31, 0x41, 0xa4, 0xfb, 0x08, 0xdd, 0x48, 0xcc,
-	0x2f, 0xe3, 0x76, 0x63, 0x3e, 0xfb, 0x67, 0x56, 0x6f, 0xbe, 0x44, 0xa0, 0xc3, 0x38, 0x3e, 0x4c,
-	0x12, 0x0e, 0x42, 0x3c, 0x3d, 0x6b, 0xde, 0xb2, 0xf1, 0xec, 0x4c, 0x30, 0x95, 0x20, 0xc2, 0x15,
-	0xb4, 0x1b, 0xa3, 0x12, 0xa6, 0x6c, 0x94, 0xaa, 0xc2, 0x56, 0xcf, 0xca, 0xed, 0x96, 0x5d, 0xa0,
-	0x44, 0x5d, 0x5e, 0x2a, 0x1f, 0x33, 0x92, 0x06, 0xef, 0xda, 0x17, 0xe5, 0xe0, 0x25, 0x38, 0xa8,
-	0x05, 0x22, 0xb4, 0xd0, 0xee, 0xd7, 0xa8, 0x48, 0xd2, 0x04, 0x26, 0x5e, 0x5e, 0xc7, 0x78, 0x67,
-	0xc3, 0xb5, 0x75, 0x3c, 0xca, 0xb2, 0xe1, 0x74, 0x51, 0xa4, 0xe6, 0xee, 0x08, 0xde, 0xb4, 0x11,
-	0xf7, 0x37, 0x59, 0x45, 0x68, 0x40, 0x1b, 0xbf, 0xec, 0xa0, 0x92, 0x39, 0xe9, 0x6e, 0x82, 0xae,
-	0x9b, 0xfb, 0x1d, 0xb6, 0x2f, 0xda, 0x12, 0xf9, 0x7f, 0xa3, 0x99, 0x49, 0xfa, 0x45, 0x9a, 0x6d,
-	0xb2, 0x2e, 0x35, 0xfb, 0xce, 0x41, 0x7b, 0x9b, 0x44, 0x7d, 0xc1, 0x8b, 0x1b, 0xa2, 0xe2, 0x7a,
-	0x53, 0xf0, 0x6a, 0x65, 0x6f, 0xa0, 0x34, 0x85, 0x4d, 0x1c, 0xff, 0x43, 0x0a, 0x0c, 0x21, 0x2d,
-	0x7a, 0x4f, 0xf7, 0x75, 0x18, 0x15, 0x55, 0xcb, 0xb6, 0x68, 0xb0, 0xb6, 0xba, 0xab, 0x06, 0x39,
-	0xe8, 0x9c, 0xff, 0xe9, 0xe7, 0xce, 0xe7, 0xbe, 0xf3, 0x64, 0xee, 0x3b, 0x7f, 0xcc, 0x7d, 0xe7,
-	0xc7, 0x0b, 0x3f, 0xf7, 0xe4, 0xc2, 0xcf, 0xfd, 0x7a, 0xe1, 0xe7, 0x1e, 0xae, 0xe7, 0xa2, 0x76,
-	0xbb, 0x39, 0xc4, 0x7d, 0xa1, 0xff, 0xda, 0x13, 0xd3, 0x8d, 0x6a, 0xc8, 0x7e, 0x49, 0xf7, 0x88,
-	0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xda, 0xa7, 0xd8, 0xa7, 0x0a, 0x00, 0x00,
+	// 976 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xc6, 0xb1, 0x9b, 0x3c, 0xdb, 0x69, 0x3d, 0x4d, 0xbe, 0xda, 0x56, 0x5f, 0xec, 0xc8,
+	0x42, 0x34, 0x17, 0xdb, 0x14, 0x04, 0x27, 0x2e, 0x59, 0x2c, 0x4a, 0x04, 0x16, 0xd6, 0x86, 0x22,
+	0xb5, 0x42, 0x5a, 0xc6, 0xbb, 0xd3, 0x64, 0xf1, 0xee, 0xce, 0x6a, 0x66, 0xec, 0xda, 0x37, 0xae,
+	0x5c, 0x10, 0x7f, 0x04, 0x12, 0x12, 0x37, 0xa4, 0xfc, 0x11, 0x39, 0x56, 0x3d, 0x21, 0x90, 0x0c,
+	0x38, 0x37, 0xce, 0x9c, 0x38, 0xa1, 0xf9, 0x11, 0x7b, 0x93, 0x3a, 0x52, 0xa3, 0x5a, 0xa8, 0x27,
+	0xef, 0xbc, 0xf7, 0xe6, 0xf3, 0x3e, 0xef, 0x33, 0x6f, 0x9e, 0x07, 0xfe, 0x3f, 0xc0, 0x23, 0xdc,
+	0x3e, 0xc6, 0x2c, 0x68, 0x8f, 0xee, 0xf7, 0x89, 0xc0, 0xf7, 0xd5, 0xa2, 0x95, 0x32, 0x2a, 0x28,
+	0xaa, 0x4a, 0x6f, 0x4b, 0x19, 0x8c, 0xf7, 0x6e, 0xcd, 0xa7, 0x3c, 0xa6, 0xbc, 0xdd, 0xc7, 0x9c,
+	0xcc, 0xb7, 0xf8, 0x34, 0x4c, 0xf4, 0x96, 0xbb, 0x77, 0xb4, 0xdf, 0x53, 0xab, 0xb6, 0x5e, 0x18,
+	0xd7, 0xf6, 0x11, 0x3d, 0xa2, 0xda, 0x2e, 0xbf, 0xb4, 0xb5, 0xf1, 0xb7, 0x05, 0xc5, 0x1e, 0x66,
+	0x38, 0xe6, 0xe8, 0x11, 0x54, 0x62, 0x9a, 0x90, 0x89, 0x17, 0x63, 0x36, 0x20, 0x82, 0xdb, 0xd6,
+	0x6e, 0x7e, 0xaf, 0xf4, 0x4e, 0xad, 0xf5, 0x02, 0x8d, 0x56, 0x57, 0xc6, 0x75, 0x55, 0x98, 0xb3,
+	0x7d, 0x3a, 0xad, 0xe7, 0x7e, 0xfa, 0xbd, 0x5e, 0xce, 0x18, 0xb9, 0x5b, 0x8e, 0x33, 0x2b, 0xf4,
+	0x9d, 0x05, 0x76, 0x1c, 0x26, 0x61, 0x3c, 0x8c, 0xbd, 0x3e, 0x65, 0x8c, 0x3e, 0xf5, 0x86, 0x3c,
+	0xf0, 0x46, 0x38, 0x1a, 0x12, 0x7b, 0x6d, 0xd7, 0xda, 0xdb, 0x74, 0x1e, 0x4a, 0x98, 0x5f, 0xa7,
+	0xf5, 0xb7, 0x8e, 0x42, 0x71, 0x3c, 0xec, 0xb7, 0x7c, 0x1a, 0x1b, 0xfe, 0xe6, 0xa7, 0xc9, 0x83,
+	0x41, 0x5b, 0x4c, 0x52, 0xc2, 0x5b, 0x1d, 0xe2, 0xcf, 0xa6, 0xf5, 0x9d, 0xae, 0x46, 0x74, 0x14,
+	0xe0, 0xc3, 0xc3, 0xce, 0x17, 0x12, 0xee, 0xf9, 0x49, 0x13, 0x4c, 0xdd, 0x1d, 0xe2, 0xbb, 0x3b,
+	0xf1, 0x85, 0x20, 0x1e, 0xa8, 0xa0, 0xc6, 0x6f, 0x05, 0x28, 0x65, 0xf8, 0xa2, 0x6d, 0x28, 0x04,
+	0x24, 0xa1, 0xb1, 0x6d, 0x49, 0x32, 0xae, 0x5e, 0xa0, 0x07, 0x50, 0x36, 0x6c, 0xa3, 0x30, 0x0e,
+	0x85, 0x62, 0xba, 0x5c, 0x10, 0x0d, 0xff, 0xa9, 0x8c, 0x72, 0xd6, 0x65, 0x25, 0x6e, 0xa9, 0xbf,
+	0x30, 0xa1, 0xf7, 0x61, 0x8b, 0xa7, 0x54, 0x18, 0x65, 0xbd, 0x30, 0xb0, 0xf3, 0xaa, 0xe8, 0x5b,
+	0xb3, 0x69, 0xbd, 0x7c, 0x98, 0x52, 0xa1, 0x69, 0x1c, 0x74, 0xdc, 0x32, 0x5f, 0xac, 0x02, 0x14,
+	0x42, 0xd5, 0xa7, 0xc9, 0x88, 0x30, 0x1e, 0xd2, 0xc4, 0x7b, 0x82, 0x7d, 0x41, 0x99, 0xbd, 0xae,
+	0xb6, 0x7e, 0x70, 0x0d, 0xbd, 0x0e, 0x12, 0x91, 0x91, 0xe5, 0x20, 0x11, 0xee, 0xad, 0x05, 0xec,
+	0x47, 0x0a, 0x15, 0x3d, 0x86, 0xdb, 0x61, 0x22, 0x08, 0x23, 0x5c, 0x78, 0x0c, 0x0b, 0xe2, 0xc5,
+	0x34, 0x20, 0x91, 0x5d, 0x50, 0x25, 0xbf, 0xb9, 0xa4, 0xe4, 0x03, 0x13, 0xed, 0x62, 0x41, 0xba,
+	0x32, 0xd6, 0x14, 0x5e, 0x0d, 0x2f, 0x3b, 0x90, 0x0f, 0x5b, 0x8c, 0x70, 0xc2, 0x46, 0xe4, 0xbc,
+	0x86, 0xe2, 0xb5, 0x6b, 0xe8, 0x10, 0xff, 0xd2, 0xd1, 0x56, 0x0c, 0xa6, 0x29, 0x60, 0x04, 0xf6,
+	0x80, 0x90, 0x94, 0x30, 0x8f, 0x91, 0xa7, 0x98, 0x05, 0x5e, 0x4a, 0x98, 0x4f, 0x12, 0x81, 0x8f,
+	0x88, 0x7d, 0x63, 0x05, 0xe9, 0xfe, 0xa7, 0xd1, 0x5d, 0x05, 0xde, 0x9b, 0x63, 0xa3, 0x7b, 0x70,
+	0xd3, 0xa7, 0x51, 0x84, 0x05, 0x61, 0x38, 0xf2, 0x68, 0x12, 0x4d, 0xec, 0x8d, 0x5d, 0x6b, 0x6f,
+	0xc3, 0xdd, 0x5a, 0x98, 0x3f, 0x4b, 0xa2, 0x09, 0xaa, 0x83, 0xe9, 0x09, 0x1d, 0xb4, 0xa9, 0x82,
+	0x40, 0x9b, 0x54, 0xc0, 0x03, 0x28, 0xf3, 0x61, 0x9a, 0x46, 0x13, 0xd3, 0x6e, 0x70, 0x65, 0xbb,
+	0x1d, 0xaa, 0xb0, 0x0b, 0xed, 0xc6, 0x17, 0xa6, 0xc6, 0xb7, 0x6b, 0x50, 0xca, 0x74, 0x24, 0x7a,
+	0x0f, 0x2a, 0xc7, 0x98, 0x7b, 0x31, 0x1e, 0x1b, 0x64, 0xd9, 0xe5, 0x1b, 0x4e, 0xf5, 0xaf, 0x69,
+	0xfd, 0xa2, 0xc3, 0x2d, 0x1d, 0x63, 0xde, 0xc5, 0x63, 0xbd, 0x0d, 0x43, 0x25, 0xc6, 0x63, 0x75,
+	0x69, 0x17, 0xfd, 0xff, 0xaa, 0x32, 0x96, 0x0d, 0xa4, 0x4e, 0xf1, 0x15, 0x54, 0x22, 0x8a, 0x13,
+	0x4f, 0x50, 0x33, 0x0c, 0xf2, 0x2b, 0x48, 0x51, 0x92, 0x90, 0x9f, 0x53, 0x7d, 0xd3, 0x7f, 0xb4,
+	0xa0, 0x94, 0x91, 0xeb, 0xf5, 0xd5, 0xa2, 0xf1, 0x43, 0x1e, 0xaa, 0x2f, 0x5c, 0x2a, 0x44, 0xa1,
+	0x22, 0x87, 0xbd, 0xbe, 0x93, 0x38, 0x9d, 0xe8, 0x09, 0xe5, 0x7c, 0x72, 0xed, 0x71, 0x59, 0x72,
+	0x30, 0x27, 0x12, 0x77, 0xbf, 0xf7, 0xe8, 0xb2, 0x60, 0xfd, 0x73, 0x57, 0x3a, 0x41, 0x04, 0x6e,
+	0xaa, 0x84, 0xf1, 0x30, 0x12, 0x61, 0x1a, 0x85, 0x84, 0xad, 0xa4, 0xd6, 0x2d, 0x09, 0xda, 0x9d,
+	0x63, 0xa2, 0x1e, 0xac, 0x0f, 0xc2, 0x64, 0xb0, 0x92, 0x03, 0x57, 0x48, 0x92, 0xf8, 0xd7, 0xc3,
+	0x38, 0xcd, 0x12, 0x5f, 0x5f, 0x05, 0x71, 0x09, 0xba, 0x20, 0xde, 0x38, 0x59, 0x83, 0x1b, 0x1d,
+	0x92, 0x52, 0x1e, 0x0a, 0xf4, 0x04, 0x36, 0x03, 0xfd, 0x49, 0x99, 0x39, 0x98, 0x8f, 0xff, 0x99,
+	0xd6, 0x9b, 0x2f, 0x91, 0x68, 0xdf, 0xf7, 0xf7, 0x83, 0x80, 0x11, 0xce, 0x9f, 0x9f, 0x34, 0x6f,
+	0x9b, 0x7c, 0xc6, 0xe2, 0x4c, 0x04, 0xe1, 0xee, 0x02, 0x1a, 0xf9, 0x50, 0xc4, 0x31, 0x1d, 0x26,
+	0xb2, 0xed, 0xe4, 0x7f, 0xf2, 0x9d, 0x96, 0xd9, 0x20, 0x45, 0x9d, 0x4f, 0x85, 0x0f, 0x69, 0x98,
+	0x38, 0x6f, 0x9b, 0xbf, 0xe3, 0xbd, 0x97, 0xe0, 0x20, 0x37, 0x70, 0xd7, 0x40, 0xa3, 0x2f, 0xa1,
+	0x10, 0x26, 0x01, 0x19, 0xdb, 0x79, 0x95, 0xe3, 0xde, 0x95, 0x73, 0xe7, 0xbc, 0x49, 0xf5, 0xe0,
+	0x75, 0xde, 0x30, 0x19, 0x77, 0x96, 0x79, 0xb9, 0xab, 0x41, 0x1b, 0x3f, 0xaf, 0x41, 0x51, 0xcf,
+	0x24, 0x14, 0xc0, 0x86, 0x9e, 0x7a, 0x64, 0xf5, 0xa2, 0xcd, 0x91, 0x5f, 0x1b, 0xcd, 0x74, 0xd1,
+	0x57, 0x69, 0xb6, 0xcc, 0x3b, 0xd7, 0xec, 0x1b, 0x0b, 0xb6, 0x97, 0x89, 0x7a, 0xc5, 0x73, 0xc5,
+	0x85, 0x42, 0xf6, 0x45, 0xf5, 0x6a, 0x6d, 0xaf, 0xa1, 0x14, 0x85, 0x65, 0x1c, 0xff, 0x43, 0x0a,
+	0x14, 0x40, 0x89, 0xde, 0x53, 0x8f, 0x62, 0x0c, 0x05, 0xf9, 0xde, 0x3d, 0x7f, 0x9d, 0xae, 0xf4,
+	0x54, 0x35, 0xb2, 0xd3, 0x39, 0xfd, 0xb3, 0x96, 0x3b, 0x9d, 0xd5, 0xac, 0x67, 0xb3, 0x9a, 0xf5,
+	0xc7, 0xac, 0x66, 0x7d, 0x7f, 0x56, 0xcb, 0x3d, 0x3b, 0xab, 0xe5, 0x7e, 0x39, 0xab, 0xe5, 0x1e,
+	0x67, 0x6b, 0x91, 0xa7, 0xdd, 0x8c, 0x70, 0x9f, 0xab, 0xaf, 0xf6, 0x58, 0x3f, 0xe5, 0x15, 0x64,
+	0xbf, 0xa8, 0x1e, 0xd8, 0xef, 0xfe, 0x3b, 0x00, 0xab, 0xcf, 0x4a, 0x5a, 0xe4, 0x0b, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -528,6 +577,36 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SupplyLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.BorrowOnly {
+		i--
+		if m.BorrowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CollateralOnly {
+		i--
+		if m.CollateralOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.KeeperRewardPercentage.Size()
 		i -= size
@@ -648,6 +727,49 @@ func (m *BorrowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplyLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaximumLimit.Size()
+		i -= size
+		if _, err := m.MaximumLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.HasMaxLimit {
+		i--
+		if m.HasMaxLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -996,6 +1118,14 @@ func (m *MoneyMarket) Size() (n int) {
 	n += 1 + l + sovHard(uint64(l))
 	l = m.KeeperRewardPercentage.Size()
 	n += 1 + l + sovHard(uint64(l))
+	if m.CollateralOnly {
+		n += 2
+	}
+	if m.BorrowOnly {
+		n += 2
+	}
+	l = m.SupplyLimit.Size()
+	n += 1 + l + sovHard(uint64(l))
 	return n
 }
 
@@ -1015,6 +1145,20 @@ func (m *BorrowLimit) Size() (n int) {
 	return n
 }
 
+func (m *SupplyLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasMaxLimit {
+		n += 2
+	}
+	l = m.MaximumLimit.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
 func (m *InterestRateModel) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1512,6 +1656,79 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CollateralOnly = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BorrowOnly = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHard(dAtA[iNdEx:])
@@ -1671,6 +1888,110 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMaxLimit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -60,6 +60,36 @@ func (bl BorrowLimit) Equal(blCompareTo BorrowLimit) bool {
 	return true
 }
 
+// NewSupplyLimit returns a new SupplyLimit
+func NewSupplyLimit(hasMaxLimit bool, maximumLimit sdk.Dec) SupplyLimit {
+	return SupplyLimit{
+		HasMaxLimit:  hasMaxLimit,
+		MaximumLimit: maximumLimit,
+	}
+}
+
+// Validate SupplyLimit
+func (sl SupplyLimit) Validate() error {
+	if !sl.HasMaxLimit {
+		return nil
+	}
+	if sl.MaximumLimit.IsNil() || sl.MaximumLimit.IsNegative() {
+		return fmt.Errorf("maximum supply limit cannot be nil or negative: %s", sl.MaximumLimit)
+	}
+	return nil
+}
+
+// Equal returns a boolean indicating if a SupplyLimit is equal to another SupplyLimit
+func (sl SupplyLimit) Equal(slCompareTo SupplyLimit) bool {
+	if sl.HasMaxLimit != slCompareTo.HasMaxLimit {
+		return false
+	}
+	if sl.HasMaxLimit && !sl.MaximumLimit.Equal(slCompareTo.MaximumLimit) {
+		return false
+	}
+	return true
+}
+
 // NewMoneyMarket returns a new MoneyMarket
 func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string, conversionFactor sdkmath.Int,
 	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec,
@@ -72,9 +102,24 @@ func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string,
 		InterestRateModel:      interestRateModel,
 		ReserveFactor:          reserveFactor,
 		KeeperRewardPercentage: keeperRewardPercentage,
+		SupplyLimit:            NewSupplyLimit(false, sdk.ZeroDec()),
 	}
 }
 
+// IsBorrowable returns true if coins from the money market can be borrowed
+func (mm MoneyMarket) IsBorrowable() bool {
+	return !mm.CollateralOnly
+}
+
+// CollateralLoanToValue returns the loan-to-value applied to deposits in the money market.
+// Borrow-only markets provide no collateral value.
+func (mm MoneyMarket) CollateralLoanToValue() sdk.Dec {
+	if mm.BorrowOnly {
+		return sdk.ZeroDec()
+	}
+	return mm.BorrowLimit.LoanToValue
+}
+
 // Validate MoneyMarket param
 func (mm MoneyMarket) Validate() error {
 	if err := sdk.ValidateDenom(mm.Denom); err != nil {
@@ -101,6 +146,14 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("keeper reward percentage must be between 0.0-1.0")
 	}
 
+	if mm.CollateralOnly && mm.BorrowOnly {
+		return fmt.Errorf("money market %s cannot be both collateral-only and borrow-only", mm.Denom)
+	}
+
+	if err := mm.SupplyLimit.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -127,6 +180,15 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if mm.CollateralOnly != mmCompareTo.CollateralOnly {
+		return false
+	}
+	if mm.BorrowOnly != mmCompareTo.BorrowOnly {
+		return false
+	}
+	if !mm.SupplyLimit.Equal(mmCompareTo.SupplyLimit) {
+		return false
+	}
 	return true
 }
 
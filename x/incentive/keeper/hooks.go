@@ -186,6 +186,10 @@ func (h Hooks) BeforePoolDepositModified(ctx sdk.Context, poolID string, deposit
 	h.k.SynchronizeSwapReward(ctx, poolID, depositor, sharesOwned)
 }
 
+// AfterPoolSwap runs after a swap pool is traded against. Swap rewards are based on shares, which swaps do not change.
+func (h Hooks) AfterPoolSwap(ctx sdk.Context, poolID string, requester sdk.AccAddress, swapInput sdk.Coin, swapOutput sdk.Coin) {
+}
+
 // ------------------- Savings Module Hooks -------------------
 
 // AfterSavingsDepositCreated function that runs after a deposit is created
@@ -231,6 +231,10 @@ func NewSwapGenesisState(cdc codec.JSONCodec) app.GenesisState {
 		),
 		swaptypes.DefaultPoolRecords,
 		swaptypes.DefaultShareRecords,
+		swaptypes.DefaultPriceObservations,
+		swaptypes.DefaultLimitOrders,
+		swaptypes.DefaultNextLimitOrderID,
+		swaptypes.DefaultDepositPositions,
 	)
 	return app.GenesisState{
 		swaptypes.ModuleName: cdc.MustMarshalJSON(&genesis),
@@ -37,7 +37,12 @@ func (k Keeper) AccumulateSwapRewards(ctx sdk.Context, rewardPeriod types.MultiR
 
 // getSwapTotalSourceShares fetches the sum of all source shares for a swap reward.
 // In the case of swap, these are the total (swap module) shares in a particular pool.
+// When swap rewards require curated pools, pools that are not curated have no source shares and accrue no rewards.
 func (k Keeper) getSwapTotalSourceShares(ctx sdk.Context, poolID string) sdk.Dec {
+	if k.GetParams(ctx).SwapRewardCuratedPoolsOnly && !k.swapKeeper.IsCuratedPool(ctx, poolID) {
+		return sdk.ZeroDec()
+	}
+
 	totalShares, found := k.swapKeeper.GetPoolShares(ctx, poolID)
 	if !found {
 		totalShares = sdk.ZeroInt()
@@ -176,6 +176,71 @@ func (suite *AccumulateSwapRewardsTests) TestNoAccumulationWhenSourceSharesAreZe
 	suite.storedIndexesEqual(pool, expected)
 }
 
+func (suite *AccumulateSwapRewardsTests) TestNoAccumulationWhenPoolIsNotCurated() {
+	pool := "btc:usdx"
+	curatedPool := "ukava:usdx"
+
+	swapKeeper := newFakeSwapKeeper().addPool(pool, i(1e6)).addCuratedPool(curatedPool, i(1e6))
+	subspace := &fakeParamSubspace{
+		params: types.Params{SwapRewardCuratedPoolsOnly: true},
+	}
+	suite.keeper = suite.NewKeeper(subspace, nil, nil, nil, nil, nil, swapKeeper, nil, nil, nil)
+
+	previousIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: pool,
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "swap",
+					RewardFactor:   d("0.02"),
+				},
+			},
+		},
+		{
+			CollateralType: curatedPool,
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "swap",
+					RewardFactor:   d("0.02"),
+				},
+			},
+		},
+	}
+	suite.storeGlobalSwapIndexes(previousIndexes)
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SetSwapRewardAccrualTime(suite.ctx, pool, previousAccrualTime)
+	suite.keeper.SetSwapRewardAccrualTime(suite.ctx, curatedPool, previousAccrualTime)
+
+	newAccrualTime := previousAccrualTime.Add(1 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(newAccrualTime)
+
+	for _, poolID := range []string{pool, curatedPool} {
+		period := types.NewMultiRewardPeriod(
+			true,
+			poolID,
+			time.Unix(0, 0), // ensure the test is within start and end times
+			distantFuture,
+			cs(c("swap", 2000)), // same denoms as in global indexes
+		)
+		suite.keeper.AccumulateSwapRewards(suite.ctx, period)
+	}
+
+	// the pool that is not curated does not accumulate rewards
+	suite.storedTimeEquals(pool, newAccrualTime)
+	expected, f := previousIndexes.Get(pool)
+	suite.True(f)
+	suite.storedIndexesEqual(pool, expected)
+
+	// the curated pool accumulates rewards
+	suite.storedTimeEquals(curatedPool, newAccrualTime)
+	suite.storedIndexesEqual(curatedPool, types.RewardIndexes{
+		{
+			CollateralType: "swap",
+			RewardFactor:   d("7.22"),
+		},
+	})
+}
+
 func (suite *AccumulateSwapRewardsTests) TestStateAddedWhenStateDoesNotExist() {
 	pool := "btc:usdx"
 
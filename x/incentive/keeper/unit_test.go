@@ -244,6 +244,10 @@ func (subspace *fakeParamSubspace) SetParamSet(_ sdk.Context, ps paramtypes.Para
 	subspace.params = *(ps.(*types.Params))
 }
 
+func (subspace *fakeParamSubspace) Set(sdk.Context, []byte, interface{}) {
+	// individual params are only set by store migrations, which are not run in keeper unit tests
+}
+
 func (subspace *fakeParamSubspace) HasKeyTable() bool {
 	// return true so the keeper does not try to call WithKeyTable, which does nothing
 	return true
@@ -259,6 +263,7 @@ func (subspace *fakeParamSubspace) WithKeyTable(paramtypes.KeyTable) paramtypes.
 type fakeSwapKeeper struct {
 	poolShares    map[string]sdkmath.Int
 	depositShares map[string](map[string]sdkmath.Int)
+	curatedPools  map[string]bool
 }
 
 var _ types.SwapKeeper = newFakeSwapKeeper()
@@ -267,6 +272,7 @@ func newFakeSwapKeeper() *fakeSwapKeeper {
 	return &fakeSwapKeeper{
 		poolShares:    map[string]sdkmath.Int{},
 		depositShares: map[string](map[string]sdkmath.Int){},
+		curatedPools:  map[string]bool{},
 	}
 }
 
@@ -275,6 +281,12 @@ func (k *fakeSwapKeeper) addPool(id string, shares sdkmath.Int) *fakeSwapKeeper
 	return k
 }
 
+func (k *fakeSwapKeeper) addCuratedPool(id string, shares sdkmath.Int) *fakeSwapKeeper {
+	k.poolShares[id] = shares
+	k.curatedPools[id] = true
+	return k
+}
+
 func (k *fakeSwapKeeper) addDeposit(poolID string, depositor sdk.AccAddress, shares sdkmath.Int) *fakeSwapKeeper {
 	if k.depositShares[poolID] == nil {
 		k.depositShares[poolID] = map[string]sdkmath.Int{}
@@ -288,6 +300,10 @@ func (k *fakeSwapKeeper) GetPoolShares(_ sdk.Context, poolID string) (sdkmath.In
 	return shares, ok
 }
 
+func (k *fakeSwapKeeper) IsCuratedPool(_ sdk.Context, poolID string) bool {
+	return k.curatedPools[poolID]
+}
+
 func (k *fakeSwapKeeper) GetDepositorSharesAmount(_ sdk.Context, depositor sdk.AccAddress, poolID string) (sdkmath.Int, bool) {
 	shares, found := k.depositShares[poolID][depositor.String()]
 	return shares, found
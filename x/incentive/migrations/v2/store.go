@@ -0,0 +1,22 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 adds the swap_reward_curated_pools_only param to parameters.
+func MigrateStore(ctx sdk.Context, paramstore types.ParamSubspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore ensures the param key table exists and has the swap_reward_curated_pools_only property
+func migrateParamsStore(ctx sdk.Context, paramstore types.ParamSubspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeySwapRewardCuratedPoolsOnly, types.DefaultSwapRewardCuratedPoolsOnly)
+}
@@ -0,0 +1,56 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2incentive "github.com/kava-labs/kava/x/incentive/migrations/v2"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func TestStoreMigrationAddsKeyTableIncludingNewParam(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tIncentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tIncentiveKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, incentiveKey, tIncentiveKey, types.ModuleName)
+
+	// Check param doesn't exist before
+	require.False(t, paramstore.Has(ctx, types.KeySwapRewardCuratedPoolsOnly))
+
+	// Run migrations.
+	err := v2incentive.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeySwapRewardCuratedPoolsOnly))
+}
+
+func TestStoreMigrationSetsNewParamOnExistingKeyTable(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tIncentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tIncentiveKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, incentiveKey, tIncentiveKey, types.ModuleName)
+	paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// expect it to have key table
+	require.True(t, paramstore.HasKeyTable())
+	// expect it to not have new param
+	require.False(t, paramstore.Has(ctx, types.KeySwapRewardCuratedPoolsOnly))
+
+	// Run migrations.
+	err := v2incentive.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set to their defaults.
+	var curatedPoolsOnly bool
+	paramstore.Get(ctx, types.KeySwapRewardCuratedPoolsOnly, &curatedPoolsOnly)
+	require.Equal(t, types.DefaultSwapRewardCuratedPoolsOnly, curatedPoolsOnly)
+}
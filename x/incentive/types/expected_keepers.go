@@ -18,6 +18,7 @@ import (
 type ParamSubspace interface {
 	GetParamSet(sdk.Context, paramtypes.ParamSet)
 	SetParamSet(sdk.Context, paramtypes.ParamSet)
+	Set(ctx sdk.Context, key []byte, value interface{})
 	WithKeyTable(paramtypes.KeyTable) paramtypes.Subspace
 	HasKeyTable() bool
 }
@@ -60,6 +61,7 @@ type HardKeeper interface {
 type SwapKeeper interface {
 	GetPoolShares(ctx sdk.Context, poolID string) (shares sdkmath.Int, found bool)
 	GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddress, poolID string) (shares sdkmath.Int, found bool)
+	IsCuratedPool(ctx sdk.Context, poolID string) bool
 }
 
 // SavingsKeeper defines the required methods needed by this module's keeper
@@ -15,21 +15,23 @@ import (
 
 // Parameter keys and default values
 var (
-	KeyUSDXMintingRewardPeriods = []byte("USDXMintingRewardPeriods")
-	KeyHardSupplyRewardPeriods  = []byte("HardSupplyRewardPeriods")
-	KeyHardBorrowRewardPeriods  = []byte("HardBorrowRewardPeriods")
-	KeyDelegatorRewardPeriods   = []byte("DelegatorRewardPeriods")
-	KeySwapRewardPeriods        = []byte("SwapRewardPeriods")
-	KeySavingsRewardPeriods     = []byte("SavingsRewardPeriods")
-	KeyEarnRewardPeriods        = []byte("EarnRewardPeriods")
-	KeyClaimEnd                 = []byte("ClaimEnd")
-	KeyMultipliers              = []byte("ClaimMultipliers")
-
-	DefaultActive             = false
-	DefaultRewardPeriods      = RewardPeriods{}
-	DefaultMultiRewardPeriods = MultiRewardPeriods{}
-	DefaultMultipliers        = MultipliersPerDenoms{}
-	DefaultClaimEnd           = tmtime.Canonical(time.Unix(1, 0))
+	KeyUSDXMintingRewardPeriods   = []byte("USDXMintingRewardPeriods")
+	KeyHardSupplyRewardPeriods    = []byte("HardSupplyRewardPeriods")
+	KeyHardBorrowRewardPeriods    = []byte("HardBorrowRewardPeriods")
+	KeyDelegatorRewardPeriods     = []byte("DelegatorRewardPeriods")
+	KeySwapRewardPeriods          = []byte("SwapRewardPeriods")
+	KeySavingsRewardPeriods       = []byte("SavingsRewardPeriods")
+	KeyEarnRewardPeriods          = []byte("EarnRewardPeriods")
+	KeyClaimEnd                   = []byte("ClaimEnd")
+	KeyMultipliers                = []byte("ClaimMultipliers")
+	KeySwapRewardCuratedPoolsOnly = []byte("SwapRewardCuratedPoolsOnly")
+
+	DefaultActive                     = false
+	DefaultSwapRewardCuratedPoolsOnly = false
+	DefaultRewardPeriods              = RewardPeriods{}
+	DefaultMultiRewardPeriods         = MultiRewardPeriods{}
+	DefaultMultipliers                = MultipliersPerDenoms{}
+	DefaultClaimEnd                   = tmtime.Canonical(time.Unix(1, 0))
 
 	BondDenom              = "ukava"
 	USDXMintingRewardDenom = "ukava"
@@ -89,6 +91,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEarnRewardPeriods, &p.EarnRewardPeriods, validateMultiRewardPeriodsParam),
 		paramtypes.NewParamSetPair(KeyMultipliers, &p.ClaimMultipliers, validateMultipliersPerDenomParam),
 		paramtypes.NewParamSetPair(KeyClaimEnd, &p.ClaimEnd, validateClaimEndParam),
+		paramtypes.NewParamSetPair(KeySwapRewardCuratedPoolsOnly, &p.SwapRewardCuratedPoolsOnly, validateSwapRewardCuratedPoolsOnlyParam),
 	}
 }
 
@@ -155,6 +158,13 @@ func validateMultipliersPerDenomParam(i interface{}) error {
 	return multipliers.Validate()
 }
 
+func validateSwapRewardCuratedPoolsOnlyParam(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func validateClaimEndParam(i interface{}) error {
 	endTime, ok := i.(time.Time)
 	if !ok {
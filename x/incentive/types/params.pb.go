@@ -203,6 +203,8 @@ type Params struct {
 	ClaimEnd                 time.Time            `protobuf:"bytes,7,opt,name=claim_end,json=claimEnd,proto3,stdtime" json:"claim_end"`
 	SavingsRewardPeriods     MultiRewardPeriods   `protobuf:"bytes,8,rep,name=savings_reward_periods,json=savingsRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"savings_reward_periods"`
 	EarnRewardPeriods        MultiRewardPeriods   `protobuf:"bytes,9,rep,name=earn_reward_periods,json=earnRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"earn_reward_periods"`
+	// swap_reward_curated_pools_only requires swap pools to be curated by the swap allowed pools to accrue rewards
+	SwapRewardCuratedPoolsOnly bool `protobuf:"varint,10,opt,name=swap_reward_curated_pools_only,json=swapRewardCuratedPoolsOnly,proto3" json:"swap_reward_curated_pools_only,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -251,56 +253,58 @@ func init() {
 }
 
 var fileDescriptor_bb8833f5d745eac9 = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0xfc, 0xbb, 0xc9, 0xb4, 0xbd, 0xb7, 0x9d, 0x46, 0xb9, 0xbe, 0xb9, 0xc8, 0xa9,
-	0x52, 0x04, 0x41, 0x55, 0x6d, 0x0a, 0x12, 0x0b, 0x76, 0x98, 0x82, 0x84, 0x44, 0xa5, 0xca, 0x2d,
-	0x12, 0xb0, 0x89, 0x26, 0xf6, 0xd4, 0xb5, 0x6a, 0x7b, 0xac, 0x99, 0x49, 0xda, 0x88, 0x05, 0x12,
-	0x0b, 0x76, 0x48, 0x15, 0x0b, 0x1e, 0xa2, 0xaf, 0xc1, 0xa6, 0xcb, 0x8a, 0x15, 0x62, 0xd1, 0x42,
-	0xfa, 0x22, 0x68, 0xc6, 0x6e, 0xe3, 0xa4, 0x69, 0xa1, 0x52, 0x36, 0xac, 0x32, 0x3e, 0x73, 0xce,
-	0xf9, 0x7d, 0xfe, 0xc6, 0x67, 0x14, 0xb0, 0xb8, 0x83, 0xba, 0xc8, 0xf0, 0x42, 0x1b, 0x87, 0xdc,
-	0xeb, 0x62, 0xa3, 0xbb, 0xd2, 0xc6, 0x1c, 0xad, 0x18, 0x11, 0xa2, 0x28, 0x60, 0x7a, 0x44, 0x09,
-	0x27, 0xb0, 0x2a, 0x92, 0xf4, 0xf3, 0x24, 0x3d, 0x49, 0xaa, 0x69, 0x36, 0x61, 0x01, 0x61, 0x46,
-	0x1b, 0xb1, 0x41, 0xa5, 0x4d, 0xbc, 0x30, 0xae, 0xab, 0x55, 0x5c, 0xe2, 0x12, 0xb9, 0x34, 0xc4,
-	0x2a, 0x89, 0xd6, 0x5d, 0x42, 0x5c, 0x1f, 0x1b, 0xf2, 0xa9, 0xdd, 0xd9, 0x32, 0xb8, 0x17, 0x60,
-	0xc6, 0x51, 0x10, 0xc5, 0x09, 0x8d, 0x8f, 0x59, 0x30, 0x6d, 0xe1, 0x5d, 0x44, 0x9d, 0x75, 0x4c,
-	0x3d, 0xe2, 0xc0, 0x2a, 0x28, 0x22, 0x5b, 0x90, 0x55, 0x65, 0x41, 0x69, 0x96, 0xac, 0xe4, 0x09,
-	0xde, 0x06, 0xff, 0xd8, 0xc4, 0xf7, 0x11, 0xc7, 0x14, 0xf9, 0x2d, 0xde, 0x8b, 0xb0, 0x9a, 0x5d,
-	0x50, 0x9a, 0x65, 0xeb, 0xef, 0x41, 0x78, 0xb3, 0x17, 0x61, 0xf8, 0x10, 0x14, 0x18, 0x47, 0x94,
-	0xab, 0xb9, 0x05, 0xa5, 0x39, 0x75, 0xaf, 0xa6, 0xc7, 0x12, 0xf4, 0x33, 0x09, 0xfa, 0xe6, 0x99,
-	0x04, 0xb3, 0x74, 0x78, 0x5c, 0xcf, 0xec, 0x9f, 0xd4, 0x15, 0x2b, 0x2e, 0x81, 0x0f, 0x40, 0x0e,
-	0x87, 0x8e, 0x9a, 0xbf, 0x46, 0xa5, 0x28, 0x80, 0x6b, 0x00, 0x52, 0xf9, 0x12, 0xac, 0x15, 0x61,
-	0xda, 0x62, 0xd8, 0x26, 0xa1, 0xa3, 0x16, 0x64, 0x9b, 0xff, 0xf4, 0xd8, 0x39, 0x5d, 0x38, 0x77,
-	0x66, 0xa7, 0xfe, 0x98, 0x78, 0xa1, 0x99, 0x17, 0x5d, 0xac, 0xd9, 0xa4, 0x74, 0x1d, 0xd3, 0x0d,
-	0x59, 0xd8, 0xf8, 0x9c, 0x05, 0x73, 0x6b, 0x1d, 0x9f, 0x7b, 0x7f, 0xbe, 0x33, 0xbd, 0x4b, 0x9c,
-	0xc9, 0x5d, 0xed, 0xcc, 0x5d, 0xd1, 0xe5, 0xe0, 0xa4, 0xde, 0x74, 0x3d, 0xbe, 0xdd, 0x69, 0xeb,
-	0x36, 0x09, 0x8c, 0xe4, 0x03, 0x8c, 0x7f, 0x96, 0x99, 0xb3, 0x63, 0x88, 0x77, 0x65, 0xb2, 0x80,
-	0x8d, 0x71, 0xf1, 0x83, 0x02, 0x80, 0x74, 0x31, 0xf2, 0x3d, 0x4c, 0x21, 0x04, 0xf9, 0x10, 0x05,
-	0xb1, 0x79, 0x65, 0x4b, 0xae, 0xe1, 0x22, 0x98, 0x09, 0x48, 0xc8, 0xb7, 0x59, 0xcb, 0x27, 0xf6,
-	0x4e, 0x27, 0x92, 0xc6, 0xe5, 0xac, 0xe9, 0x38, 0xf8, 0x5c, 0xc6, 0xe0, 0x53, 0x50, 0xdc, 0x42,
-	0x36, 0x27, 0x54, 0xfa, 0x36, 0x6d, 0xea, 0x42, 0xdb, 0xb7, 0xe3, 0xfa, 0xad, 0xdf, 0xd0, 0xb6,
-	0x8a, 0x6d, 0x2b, 0xa9, 0x6e, 0xbc, 0x57, 0xc0, 0xfc, 0x40, 0x8f, 0x10, 0xba, 0x8a, 0x43, 0x12,
-	0xc0, 0x0a, 0x28, 0x38, 0x62, 0x91, 0x28, 0x8b, 0x1f, 0xe0, 0x2b, 0x30, 0x15, 0x0c, 0x92, 0xd5,
-	0xac, 0x74, 0xac, 0xa1, 0x8f, 0x9f, 0x4e, 0x7d, 0xd0, 0xd7, 0x9c, 0x4f, 0xac, 0x9b, 0x4a, 0xb1,
-	0xac, 0x74, 0xaf, 0xc6, 0x97, 0x12, 0x28, 0xae, 0xcb, 0x99, 0x87, 0x9f, 0x14, 0xf0, 0x7f, 0x87,
-	0x39, 0x7b, 0xad, 0xc0, 0x0b, 0xb9, 0x17, 0xba, 0xad, 0xd8, 0x45, 0x71, 0x56, 0x1e, 0x71, 0x98,
-	0xaa, 0x48, 0xec, 0xcd, 0xcb, 0xb0, 0xe9, 0xef, 0xd3, 0x5c, 0x11, 0xe0, 0xfe, 0x71, 0x5d, 0x7d,
-	0xb1, 0xb1, 0xfa, 0x72, 0x2d, 0xee, 0x97, 0x4e, 0x60, 0x07, 0x27, 0xf5, 0x99, 0xa1, 0x80, 0xa5,
-	0x0a, 0xf6, 0xb8, 0x54, 0xf8, 0x4e, 0x01, 0xb5, 0x6d, 0xa1, 0x84, 0x75, 0xa2, 0xc8, 0xef, 0x8d,
-	0xea, 0x8a, 0xed, 0xb8, 0x73, 0xa5, 0x1d, 0x43, 0xe2, 0x6a, 0x89, 0x2b, 0xf0, 0xc2, 0x16, 0xb3,
-	0xfe, 0x15, 0xa0, 0x0d, 0xc9, 0xb9, 0x44, 0x44, 0x9b, 0x50, 0x4a, 0x76, 0x47, 0x45, 0xe4, 0x26,
-	0x2e, 0xc2, 0x94, 0x9c, 0x61, 0x11, 0x6f, 0x81, 0xea, 0x60, 0x1f, 0xbb, 0x88, 0x13, 0x3a, 0xaa,
-	0x20, 0x3f, 0x49, 0x05, 0xd5, 0x73, 0xcc, 0xb0, 0x80, 0x0e, 0x98, 0x67, 0xbb, 0x28, 0x1a, 0x65,
-	0x17, 0x26, 0xc9, 0x9e, 0x13, 0x84, 0x61, 0x6c, 0x17, 0xcc, 0xd9, 0x3e, 0xf2, 0x82, 0x56, 0x7a,
-	0x0c, 0x8a, 0x12, 0xba, 0xf4, 0xeb, 0x31, 0x38, 0x1f, 0x2f, 0xf3, 0x46, 0x82, 0xad, 0x8c, 0xd9,
-	0x64, 0xd6, 0xac, 0x64, 0xa4, 0xb6, 0xe0, 0x23, 0x50, 0x8e, 0xb9, 0xe2, 0xbe, 0xfb, 0xeb, 0x1a,
-	0xf7, 0x5d, 0x49, 0x96, 0x3d, 0x09, 0x1d, 0xf8, 0x06, 0x54, 0x19, 0xea, 0x7a, 0xa1, 0xcb, 0x46,
-	0x4d, 0x2b, 0x4d, 0xd2, 0xb4, 0x4a, 0x02, 0xb9, 0x70, 0x5c, 0x18, 0xd1, 0x70, 0x94, 0x5c, 0x9e,
-	0xe8, 0x71, 0x09, 0xc2, 0x50, 0xc8, 0x7c, 0x76, 0xf8, 0x43, 0xcb, 0x1c, 0xf6, 0x35, 0xe5, 0xa8,
-	0xaf, 0x29, 0xdf, 0xfb, 0x9a, 0xb2, 0x7f, 0xaa, 0x65, 0x8e, 0x4e, 0xb5, 0xcc, 0xd7, 0x53, 0x2d,
-	0xf3, 0x7a, 0x29, 0x75, 0x57, 0x0a, 0x05, 0xcb, 0x3e, 0x6a, 0x33, 0xb9, 0x32, 0xf6, 0x52, 0xff,
-	0x48, 0xe4, 0xa5, 0xd9, 0x2e, 0x4a, 0x9b, 0xef, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xca, 0x77,
-	0xf8, 0x09, 0xb0, 0x08, 0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcb, 0x6e, 0xeb, 0x44,
+	0x18, 0xc7, 0xe3, 0xdc, 0x48, 0xa6, 0x3d, 0x70, 0x3a, 0x8d, 0x82, 0x09, 0xc8, 0xa9, 0x72, 0x10,
+	0x04, 0x55, 0xb5, 0x29, 0x48, 0x2c, 0xd8, 0xe1, 0x16, 0x24, 0x24, 0x2a, 0x22, 0xb7, 0x48, 0xc0,
+	0xc6, 0x9a, 0xd8, 0x53, 0xd7, 0xaa, 0x3d, 0x63, 0xcd, 0x8c, 0xd3, 0x46, 0x2c, 0x90, 0x58, 0xb0,
+	0x43, 0xaa, 0x58, 0xf4, 0x21, 0xfa, 0x1a, 0x6c, 0xba, 0xec, 0x12, 0xb1, 0x68, 0x21, 0x7d, 0x11,
+	0x34, 0x63, 0xb7, 0x71, 0xd2, 0xb4, 0x50, 0x29, 0x9b, 0xb3, 0xca, 0x5c, 0xbe, 0xef, 0xfb, 0xfd,
+	0xe7, 0x3f, 0x97, 0x18, 0xbc, 0x3a, 0x46, 0x23, 0x64, 0x85, 0xc4, 0xc3, 0x44, 0x84, 0x23, 0x6c,
+	0x8d, 0xb6, 0x87, 0x58, 0xa0, 0x6d, 0x2b, 0x41, 0x0c, 0xc5, 0xdc, 0x4c, 0x18, 0x15, 0x14, 0xb6,
+	0x65, 0x90, 0x79, 0x1f, 0x64, 0xe6, 0x41, 0x1d, 0xc3, 0xa3, 0x3c, 0xa6, 0xdc, 0x1a, 0x22, 0x3e,
+	0xcd, 0xf4, 0x68, 0x48, 0xb2, 0xbc, 0x4e, 0x2b, 0xa0, 0x01, 0x55, 0x4d, 0x4b, 0xb6, 0xf2, 0xd1,
+	0x6e, 0x40, 0x69, 0x10, 0x61, 0x4b, 0xf5, 0x86, 0xe9, 0xa1, 0x25, 0xc2, 0x18, 0x73, 0x81, 0xe2,
+	0x24, 0x0b, 0xe8, 0xfd, 0x5e, 0x06, 0xab, 0x0e, 0x3e, 0x41, 0xcc, 0x1f, 0x60, 0x16, 0x52, 0x1f,
+	0xb6, 0x41, 0x1d, 0x79, 0x92, 0xac, 0x6b, 0x1b, 0x5a, 0xbf, 0xe1, 0xe4, 0x3d, 0xf8, 0x21, 0x78,
+	0xcb, 0xa3, 0x51, 0x84, 0x04, 0x66, 0x28, 0x72, 0xc5, 0x38, 0xc1, 0x7a, 0x79, 0x43, 0xeb, 0x37,
+	0x9d, 0x37, 0xa7, 0xc3, 0x07, 0xe3, 0x04, 0xc3, 0xcf, 0x41, 0x8d, 0x0b, 0xc4, 0x84, 0x5e, 0xd9,
+	0xd0, 0xfa, 0x2b, 0x9f, 0x74, 0xcc, 0x4c, 0x82, 0x79, 0x27, 0xc1, 0x3c, 0xb8, 0x93, 0x60, 0x37,
+	0x2e, 0xaf, 0xbb, 0xa5, 0xb3, 0x9b, 0xae, 0xe6, 0x64, 0x29, 0xf0, 0x33, 0x50, 0xc1, 0xc4, 0xd7,
+	0xab, 0xcf, 0xc8, 0x94, 0x09, 0x70, 0x0f, 0x40, 0xa6, 0x16, 0xc1, 0xdd, 0x04, 0x33, 0x97, 0x63,
+	0x8f, 0x12, 0x5f, 0xaf, 0xa9, 0x32, 0xef, 0x98, 0x99, 0x73, 0xa6, 0x74, 0xee, 0xce, 0x4e, 0x73,
+	0x87, 0x86, 0xc4, 0xae, 0xca, 0x2a, 0xce, 0xcb, 0x3c, 0x75, 0x80, 0xd9, 0xbe, 0x4a, 0xec, 0xfd,
+	0x51, 0x06, 0x6b, 0x7b, 0x69, 0x24, 0xc2, 0xd7, 0xdf, 0x99, 0xf1, 0x23, 0xce, 0x54, 0x9e, 0x76,
+	0xe6, 0x63, 0x59, 0xe5, 0xe2, 0xa6, 0xdb, 0x0f, 0x42, 0x71, 0x94, 0x0e, 0x4d, 0x8f, 0xc6, 0x56,
+	0x7e, 0x00, 0xb3, 0x9f, 0x2d, 0xee, 0x1f, 0x5b, 0x72, 0xad, 0x5c, 0x25, 0xf0, 0x05, 0x2e, 0xfe,
+	0xa6, 0x01, 0xa0, 0x5c, 0x4c, 0xa2, 0x10, 0x33, 0x08, 0x41, 0x95, 0xa0, 0x38, 0x33, 0xaf, 0xe9,
+	0xa8, 0x36, 0x7c, 0x05, 0x5e, 0xc4, 0x94, 0x88, 0x23, 0xee, 0x46, 0xd4, 0x3b, 0x4e, 0x13, 0x65,
+	0x5c, 0xc5, 0x59, 0xcd, 0x06, 0xbf, 0x51, 0x63, 0xf0, 0x2b, 0x50, 0x3f, 0x44, 0x9e, 0xa0, 0x4c,
+	0xf9, 0xb6, 0x6a, 0x9b, 0x52, 0xdb, 0x5f, 0xd7, 0xdd, 0x0f, 0xfe, 0x87, 0xb6, 0x5d, 0xec, 0x39,
+	0x79, 0x76, 0xef, 0x57, 0x0d, 0xac, 0x4f, 0xf5, 0x48, 0xa1, 0xbb, 0x98, 0xd0, 0x18, 0xb6, 0x40,
+	0xcd, 0x97, 0x8d, 0x5c, 0x59, 0xd6, 0x81, 0x3f, 0x80, 0x95, 0x78, 0x1a, 0xac, 0x97, 0x95, 0x63,
+	0x3d, 0x73, 0xf1, 0xed, 0x34, 0xa7, 0x75, 0xed, 0xf5, 0xdc, 0xba, 0x95, 0x02, 0xcb, 0x29, 0xd6,
+	0xea, 0x9d, 0x37, 0x41, 0x7d, 0xa0, 0xee, 0x3c, 0x3c, 0xd7, 0xc0, 0xbb, 0x29, 0xf7, 0x4f, 0xdd,
+	0x38, 0x24, 0x22, 0x24, 0x81, 0x9b, 0xb9, 0x28, 0xf7, 0x2a, 0xa4, 0x3e, 0xd7, 0x35, 0x85, 0x7d,
+	0xff, 0x31, 0x6c, 0xf1, 0x7c, 0xda, 0xdb, 0x12, 0x3c, 0xb9, 0xee, 0xea, 0xdf, 0xed, 0xef, 0x7e,
+	0xbf, 0x97, 0xd5, 0x2b, 0x06, 0xf0, 0x8b, 0x9b, 0xee, 0x8b, 0x99, 0x01, 0x47, 0x97, 0xec, 0x45,
+	0xa1, 0xf0, 0x17, 0x0d, 0x74, 0x8e, 0xa4, 0x12, 0x9e, 0x26, 0x49, 0x34, 0x9e, 0xd7, 0x95, 0xd9,
+	0xf1, 0xd1, 0x93, 0x76, 0xcc, 0x88, 0xeb, 0xe4, 0xae, 0xc0, 0x07, 0x53, 0xdc, 0x79, 0x5b, 0x82,
+	0xf6, 0x15, 0xe7, 0x11, 0x11, 0x43, 0xca, 0x18, 0x3d, 0x99, 0x17, 0x51, 0x59, 0xba, 0x08, 0x5b,
+	0x71, 0x66, 0x45, 0xfc, 0x0c, 0x74, 0x1f, 0x47, 0x38, 0x40, 0x82, 0xb2, 0x79, 0x05, 0xd5, 0x65,
+	0x2a, 0x68, 0xdf, 0x63, 0x66, 0x05, 0xa4, 0x60, 0x9d, 0x9f, 0xa0, 0x64, 0x9e, 0x5d, 0x5b, 0x26,
+	0x7b, 0x4d, 0x12, 0x66, 0xb1, 0x23, 0xb0, 0xe6, 0x45, 0x28, 0x8c, 0xdd, 0xe2, 0x35, 0xa8, 0x2b,
+	0xe8, 0xe6, 0x7f, 0x5f, 0x83, 0xfb, 0xeb, 0x65, 0xbf, 0x97, 0x63, 0x5b, 0x0b, 0x26, 0xb9, 0xf3,
+	0x52, 0x31, 0x0a, 0x53, 0xf0, 0x0b, 0xd0, 0xcc, 0xb8, 0xf2, 0xbd, 0x7b, 0xe3, 0x19, 0xef, 0x5d,
+	0x43, 0xa5, 0x7d, 0x49, 0x7c, 0xf8, 0x13, 0x68, 0x73, 0x34, 0x0a, 0x49, 0xc0, 0xe7, 0x4d, 0x6b,
+	0x2c, 0xd3, 0xb4, 0x56, 0x0e, 0x79, 0xb0, 0x5d, 0x18, 0x31, 0x32, 0x4f, 0x6e, 0x2e, 0x75, 0xbb,
+	0x24, 0x61, 0x16, 0x6b, 0x03, 0xa3, 0x78, 0x4a, 0xbc, 0x94, 0x21, 0x81, 0x7d, 0x37, 0xa1, 0x34,
+	0xe2, 0x2e, 0x25, 0xd1, 0x58, 0x07, 0xea, 0x5f, 0xab, 0x33, 0xdd, 0xe9, 0x9d, 0x2c, 0x66, 0x20,
+	0x43, 0xbe, 0x25, 0xd1, 0xd8, 0xfe, 0xfa, 0xf2, 0x1f, 0xa3, 0x74, 0x39, 0x31, 0xb4, 0xab, 0x89,
+	0xa1, 0xfd, 0x3d, 0x31, 0xb4, 0xb3, 0x5b, 0xa3, 0x74, 0x75, 0x6b, 0x94, 0xfe, 0xbc, 0x35, 0x4a,
+	0x3f, 0x6e, 0x16, 0xde, 0x5b, 0xb9, 0x8a, 0xad, 0x08, 0x0d, 0xb9, 0x6a, 0x59, 0xa7, 0x85, 0xaf,
+	0x1a, 0xf5, 0xf0, 0x0e, 0xeb, 0x6a, 0xab, 0x3e, 0xfd, 0x77, 0x00, 0xbd, 0x94, 0xe7, 0x14, 0xf4,
+	0x08, 0x00, 0x00,
 }
 
 func (m *RewardPeriod) Marshal() (dAtA []byte, err error) {
@@ -548,6 +552,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SwapRewardCuratedPoolsOnly {
+		i--
+		if m.SwapRewardCuratedPoolsOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.EarnRewardPeriods) > 0 {
 		for iNdEx := len(m.EarnRewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -823,6 +837,9 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.SwapRewardCuratedPoolsOnly {
+		n += 2
+	}
 	return n
 }
 
@@ -1819,6 +1836,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRewardCuratedPoolsOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapRewardCuratedPoolsOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
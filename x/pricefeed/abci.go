@@ -14,8 +14,21 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	// Update the current price of each asset.
-	for _, market := range k.GetMarkets(ctx) {
+	// Post the prices revealed in commit-reveal rounds that end in this block, counting missed reveals, before
+	// prices are updated from the revealed prices.
+	k.EndPriceCommitRounds(ctx)
+
+	// Count missed update windows of oracles, suspending oracles that missed too many before prices are updated.
+	k.UpdateOracleWindows(ctx)
+
+	// Update the current price of each asset, add it to the price history and record the deviation of each oracle
+	// from it. Markets priced from other markets are updated after the markets they depend on, so that they use
+	// the latest prices and history.
+	markets, err := k.GetMarkets(ctx).DependencyOrder()
+	if err != nil {
+		panic(err)
+	}
+	for _, market := range markets {
 		if !market.Active {
 			continue
 		}
@@ -24,5 +37,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
 			panic(err)
 		}
+		k.RecordCurrentPrice(ctx, market.MarketID)
+		k.UpdateOracleDeviations(ctx, market.MarketID)
 	}
 }
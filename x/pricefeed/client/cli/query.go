@@ -2,6 +2,9 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +31,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdRawPrices(),
 		GetCmdOracles(),
 		GetCmdMarkets(),
+		GetCmdMissedReveals(),
+		GetCmdPriceHistory(),
+		GetCmdTWAP(),
+		GetCmdOracleStats(),
+		GetCmdFeederDelegations(),
 		GetCmdQueryParams(),
 	}
 
@@ -70,6 +78,171 @@ func GetCmdOracles() *cobra.Command {
 	}
 }
 
+// GetCmdMissedReveals queries the number of unrevealed price commits of each oracle of a market
+func GetCmdMissedReveals() *cobra.Command {
+	return &cobra.Command{
+		Use:   "missed-reveals [marketID]",
+		Short: "get the number of price commits each oracle did not reveal in time for a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryMissedRevealsRequest{
+				MarketId: args[0],
+			}
+
+			res, err := queryClient.MissedReveals(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdOracleStats queries the performance statistics of the oracles of a market
+func GetCmdOracleStats() *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-stats [marketID]",
+		Short: "get the missed update windows, price deviation and last post time of each oracle of a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryOracleStatsRequest{
+				MarketId: args[0],
+			}
+
+			res, err := queryClient.OracleStats(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdFeederDelegations queries the feeder delegations of oracles
+func GetCmdFeederDelegations() *cobra.Command {
+	return &cobra.Command{
+		Use:   "feeder-delegations [oracle-address]",
+		Short: "get the feeder addresses authorized to post prices for oracles, or for a single oracle",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.FeederDelegation(context.Background(), &types.QueryFeederDelegationRequest{
+					OracleAddress: args[0],
+				})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.FeederDelegations(context.Background(), &types.QueryFeederDelegationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdPriceHistory queries the stored price history of a market
+func GetCmdPriceHistory() *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-history [marketID]",
+		Short: "get the stored price history for a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryPriceHistoryRequest{
+				MarketId: args[0],
+			}
+
+			res, err := queryClient.PriceHistory(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdTWAP queries the time-weighted average price of a market
+func GetCmdTWAP() *cobra.Command {
+	return &cobra.Command{
+		Use:   "twap [marketID] [start-time] [end-time]",
+		Short: "get the time-weighted average price of a market",
+		Long: strings.TrimSpace(`get the time-weighted average price of a market between two RFC3339 times.
+		The end time defaults to the latest block time if not provided:
+		Example:
+		$ kvcli q pricefeed twap bnb:usd 2022-01-01T00:00:00Z
+		$ kvcli q pricefeed twap bnb:usd 2022-01-01T00:00:00Z 2022-01-01T01:00:00Z`,
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+
+			var endTime time.Time
+			if len(args) == 3 {
+				endTime, err = time.Parse(time.RFC3339, args[2])
+				if err != nil {
+					return fmt.Errorf("invalid end time: %w", err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+				MarketId:  args[0],
+				StartTime: startTime,
+				EndTime:   endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // GetCmdPrice queries the current price of an asset
 func GetCmdPrice() *cobra.Command {
 	return &cobra.Command{
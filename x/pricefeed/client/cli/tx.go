@@ -18,6 +18,8 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
+const flagOracle = "oracle"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	pricefeedTxCmd := &cobra.Command{
@@ -30,6 +32,11 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPostPrice(),
+		GetCmdPostPrices(),
+		GetCmdCommitPrice(),
+		GetCmdRevealPrice(),
+		GetCmdDelegateFeeder(),
+		GetCmdRevokeFeeder(),
 	}
 
 	for _, cmd := range cmds {
@@ -55,24 +62,126 @@ func GetCmdPostPrice() *cobra.Command {
 				return err
 			}
 
-			price, err := sdk.NewDecFromStr(args[1])
+			price, expiry, err := parsePriceAndExpiry(args[1], args[2])
 			if err != nil {
 				return err
 			}
 
-			expiryInt, err := strconv.ParseInt(args[2], 10, 64)
+			from := clientCtx.GetFromAddress()
+			msg := types.NewMsgPostPrice(from.String(), args[0], price, expiry)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// GetCmdPostPrices cli command for posting prices to several markets at once.
+func GetCmdPostPrices() *cobra.Command {
+	return &cobra.Command{
+		Use:   "postprices [marketID] [price] [expiry] [[marketID] [price] [expiry]...]",
+		Short: "post the latest prices for several markets in one message, each with an expiry as a UNIX time",
+		Example: fmt.Sprintf("%s tx %s postprices bnb:usd 25 9999999999 btc:usd 30000 9999999999 --from validator",
+			version.AppName, types.ModuleName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 || len(args)%3 != 0 {
+				return fmt.Errorf("accepts a market id, price and expiry for each market, received %d args", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
-				return fmt.Errorf("invalid expiry %s: %w", args[2], err)
+				return err
 			}
 
-			if expiryInt > types.MaxExpiry {
-				return fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+			var prices types.PriceEntries
+			for i := 0; i < len(args); i += 3 {
+				price, expiry, err := parsePriceAndExpiry(args[i+1], args[i+2])
+				if err != nil {
+					return err
+				}
+				prices = append(prices, types.NewPriceEntry(args[i], price, expiry))
 			}
 
-			expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+			from := clientCtx.GetFromAddress()
+			msg := types.NewMsgPostPrices(from.String(), prices)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// GetCmdCommitPrice cli command for committing to prices in commit-reveal markets.
+func GetCmdCommitPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commitprice [marketID] [price] [expiry] [salt]",
+		Short: "commit to the hash of a price that is revealed in a later block with revealprice",
+		Long: `Commit to a price for a market that uses commit-reveal. Only the hash of the price, expiry and salt is
+submitted. The same price, expiry and salt must be revealed with revealprice in the reveal phase of the round of the commit.`,
+		Example: fmt.Sprintf("%s tx %s commitprice bnb:usd 25 9999999999 4f2a9c --from validator",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, expiry, err := parsePriceAndExpiry(args[1], args[2])
+			if err != nil {
+				return err
+			}
 
 			from := clientCtx.GetFromAddress()
-			msg := types.NewMsgPostPrice(from.String(), args[0], price, expiry)
+			// feeders commit to the price of the oracle they post for
+			oracle := from
+			if oracleArg, _ := cmd.Flags().GetString(flagOracle); oracleArg != "" {
+				oracle, err = sdk.AccAddressFromBech32(oracleArg)
+				if err != nil {
+					return err
+				}
+			}
+			hash := types.PriceCommitHash(args[0], oracle, price, expiry, args[3])
+			msg := types.NewMsgCommitPrice(from.String(), args[0], hash)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagOracle, "", "address of the oracle to commit for when sending from a feeder (default sender)")
+
+	return cmd
+}
+
+// GetCmdRevealPrice cli command for revealing committed prices in commit-reveal markets.
+func GetCmdRevealPrice() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revealprice [marketID] [price] [expiry] [salt]",
+		Short: "reveal a price previously committed to with commitprice",
+		Example: fmt.Sprintf("%s tx %s revealprice bnb:usd 25 9999999999 4f2a9c --from validator",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, expiry, err := parsePriceAndExpiry(args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg := types.NewMsgRevealPrice(from.String(), args[0], price, expiry, args[3])
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -81,3 +190,72 @@ func GetCmdPostPrice() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdDelegateFeeder cli command for authorizing a feeder address to post prices for an oracle.
+func GetCmdDelegateFeeder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-feeder [feeder]",
+		Short: "authorize a feeder address to post prices on behalf of the sending oracle",
+		Long: `Authorize a feeder address to post, commit and reveal prices on behalf of the sending oracle, in every
+market the sender is an oracle of. Any previous feeder of the oracle is replaced.`,
+		Example: fmt.Sprintf("%s tx %s delegate-feeder kava1... --from validator",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateFeeder(clientCtx.GetFromAddress().String(), args[0])
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// GetCmdRevokeFeeder cli command for revoking the feeder address of an oracle.
+func GetCmdRevokeFeeder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-feeder",
+		Short: "revoke the authorization of the feeder address of the sending oracle",
+		Example: fmt.Sprintf("%s tx %s revoke-feeder --from validator",
+			version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeder(clientCtx.GetFromAddress().String())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// parsePriceAndExpiry parses a price and an expiry given as a UNIX time
+func parsePriceAndExpiry(priceArg, expiryArg string) (sdk.Dec, time.Time, error) {
+	price, err := sdk.NewDecFromStr(priceArg)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, err
+	}
+
+	expiryInt, err := strconv.ParseInt(expiryArg, 10, 64)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, fmt.Errorf("invalid expiry %s: %w", expiryArg, err)
+	}
+
+	if expiryInt > types.MaxExpiry {
+		return sdk.Dec{}, time.Time{}, fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+	}
+
+	return price, tmtime.Canonical(time.Unix(expiryInt, 0)), nil
+}
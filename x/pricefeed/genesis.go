@@ -21,6 +21,25 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 			}
 		}
 	}
+	for _, commit := range gs.PriceCommits {
+		k.SetPriceCommit(ctx, commit)
+	}
+	for _, missed := range gs.MissedRevealCounts {
+		k.SetMissedRevealCount(ctx, missed)
+	}
+	for _, record := range gs.PriceHistory {
+		k.AppendPriceRecord(ctx, record)
+	}
+	for _, stats := range gs.OracleStats {
+		k.SetOracleStats(ctx, stats)
+	}
+	for _, state := range gs.CircuitBreakerStates {
+		k.SetCircuitBreakerState(ctx, state)
+	}
+	for _, delegation := range gs.FeederDelegations {
+		k.SetFeederDelegation(ctx, delegation)
+	}
+
 	params := k.GetParams(ctx)
 
 	// Set the current price (if any) based on what's now in the store
@@ -46,10 +65,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 
 	var postedPrices []types.PostedPrice
+	var missedRevealCounts []types.MissedRevealCount
+	var priceHistory []types.PriceRecord
+	var oracleStats []types.OracleStats
 	for _, market := range k.GetMarkets(ctx) {
 		pp := k.GetRawPrices(ctx, market.MarketID)
 		postedPrices = append(postedPrices, pp...)
+		missedRevealCounts = append(missedRevealCounts, k.GetMissedRevealCounts(ctx, market.MarketID)...)
+		priceHistory = append(priceHistory, k.GetPriceHistory(ctx, market.MarketID)...)
+		oracleStats = append(oracleStats, k.GetOracleStatsByMarket(ctx, market.MarketID)...)
 	}
 
-	return types.NewGenesisState(params, postedPrices)
+	return types.NewGenesisState(params, postedPrices, k.GetAllPriceCommits(ctx), missedRevealCounts, priceHistory, oracleStats, k.GetCircuitBreakerStates(ctx), k.GetFeederDelegations(ctx))
 }
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// IsMarketHalted returns true if the market is holding its previous price after its price moved past the max
+// change of its circuit breaker
+func (k Keeper) IsMarketHalted(ctx sdk.Context, marketID string) bool {
+	state, found := k.GetCircuitBreakerState(ctx, marketID)
+	return found && state.Halted
+}
+
+// applyCircuitBreaker returns the price to set as the current price of a market. A price that moves past the max
+// block change from the previous price, or past the max window change from the price at the start of the window,
+// halts the market and the previous price is held instead. The market resumes when the price returns within the
+// limits, or when the window has passed since the halt, in which case the price is accepted as confirmed and a
+// new window starts from it. A market without a previous price, such as after a block without a quorum, keeps its
+// window and halt: the price is checked against the window price, which is held in place of the previous price,
+// and a halted market only resumes once the window has passed since the halt.
+func (k Keeper) applyCircuitBreaker(ctx sdk.Context, market types.Market, prevPrice types.CurrentPrice, validPrevPrice bool, price sdk.Dec) sdk.Dec {
+	breaker := market.CircuitBreaker
+	state, found := k.GetCircuitBreakerState(ctx, market.MarketID)
+	if !breaker.IsEnabled() {
+		if found {
+			k.deleteCircuitBreakerState(ctx, market.MarketID)
+			k.resumeMarket(ctx, state, price)
+		}
+		return price
+	}
+
+	if !found {
+		if !validPrevPrice {
+			// a market that has never had a price has nothing to check the price against, so it starts a window
+			k.SetCircuitBreakerState(ctx, types.NewCircuitBreakerState(market.MarketID, ctx.BlockTime(), price))
+			return price
+		}
+		state = types.NewCircuitBreakerState(market.MarketID, ctx.BlockTime(), prevPrice.Price)
+	}
+
+	// once the window has passed since a halt the price is confirmed, so the price is accepted and starts a new
+	// window
+	if state.Halted && !ctx.BlockTime().Before(state.HaltTime.Add(breaker.Window)) {
+		k.resumeMarket(ctx, state, price)
+		state.Halted = false
+		state.WindowStart = ctx.BlockTime()
+		state.WindowPrice = price
+		k.SetCircuitBreakerState(ctx, state)
+		return price
+	}
+
+	heldPrice := prevPrice.Price
+	if !validPrevPrice {
+		if state.Halted {
+			return state.WindowPrice
+		}
+		heldPrice = state.WindowPrice
+	} else if !ctx.BlockTime().Before(state.WindowStart.Add(breaker.Window)) {
+		state.WindowStart = ctx.BlockTime()
+		state.WindowPrice = prevPrice.Price
+	}
+
+	if breaker.ExceedsBlockChange(heldPrice, price) || breaker.ExceedsWindowChange(state.WindowPrice, price) {
+		if !state.Halted {
+			state.Halted = true
+			state.HaltTime = ctx.BlockTime()
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMarketHalted,
+					sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+					sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+					sdk.NewAttribute(types.AttributeHeldPrice, heldPrice.String()),
+				),
+			)
+		}
+		k.SetCircuitBreakerState(ctx, state)
+		return heldPrice
+	}
+
+	k.resumeMarket(ctx, state, price)
+	state.Halted = false
+	k.SetCircuitBreakerState(ctx, state)
+	return price
+}
+
+// resumeMarket emits an event for a halted market that is no longer holding its previous price
+func (k Keeper) resumeMarket(ctx sdk.Context, state types.CircuitBreakerState, price sdk.Dec) {
+	if !state.Halted {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketResumed,
+			sdk.NewAttribute(types.AttributeMarketID, state.MarketID),
+			sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+		),
+	)
+}
+
+// GetCircuitBreakerState returns the circuit breaker state of a market
+func (k Keeper) GetCircuitBreakerState(ctx sdk.Context, marketID string) (types.CircuitBreakerState, bool) {
+	bz := ctx.KVStore(k.key).Get(types.CircuitBreakerStateKey(marketID))
+	if bz == nil {
+		return types.CircuitBreakerState{}, false
+	}
+	var state types.CircuitBreakerState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+// SetCircuitBreakerState saves a circuit breaker state to the store
+func (k Keeper) SetCircuitBreakerState(ctx sdk.Context, state types.CircuitBreakerState) {
+	ctx.KVStore(k.key).Set(types.CircuitBreakerStateKey(state.MarketID), k.cdc.MustMarshal(&state))
+}
+
+func (k Keeper) deleteCircuitBreakerState(ctx sdk.Context, marketID string) {
+	ctx.KVStore(k.key).Delete(types.CircuitBreakerStateKey(marketID))
+}
+
+// IterateCircuitBreakerStates iterates over all circuit breaker states and performs a callback function
+func (k Keeper) IterateCircuitBreakerStates(ctx sdk.Context, cb func(state types.CircuitBreakerState) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.CircuitBreakerStatePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.CircuitBreakerState
+		k.cdc.MustUnmarshal(iterator.Value(), &state)
+		if cb(state) {
+			break
+		}
+	}
+}
+
+// GetCircuitBreakerStates returns the circuit breaker states of all markets
+func (k Keeper) GetCircuitBreakerStates(ctx sdk.Context) types.CircuitBreakerStates {
+	var states types.CircuitBreakerStates
+	k.IterateCircuitBreakerStates(ctx, func(state types.CircuitBreakerState) (stop bool) {
+		states = append(states, state)
+		return false
+	})
+	return states
+}
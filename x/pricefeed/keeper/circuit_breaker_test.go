@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestKeeper_CircuitBreaker(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	k := tApp.GetPriceFeedKeeper()
+
+	maxBlockChange := sdk.MustNewDecFromStr("0.2")
+	maxWindowChange := sdk.MustNewDecFromStr("0.3")
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.CircuitBreaker = types.NewCircuitBreaker(&maxBlockChange, &maxWindowChange, time.Hour)
+	k.SetParams(ctx, types.NewParams([]types.Market{market}))
+
+	expiry := start.Add(24 * time.Hour)
+	setPrice := func(price string) sdk.Dec {
+		_, err := k.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), expiry)
+		require.NoError(t, err)
+		require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+		currentPrice, err := k.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		return currentPrice.Price
+	}
+
+	require.Equal(t, sdk.MustNewDecFromStr("10"), setPrice("10"))
+	require.False(t, k.IsMarketHalted(ctx, "tstusd"))
+
+	// a price moving past the max block change halts the market and holds the previous price
+	ctx = ctx.WithBlockTime(start.Add(time.Minute))
+	require.Equal(t, sdk.MustNewDecFromStr("10"), setPrice("1"))
+	require.True(t, k.IsMarketHalted(ctx, "tstusd"))
+
+	// the market resumes once the price returns within the limits
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Minute))
+	require.Equal(t, sdk.MustNewDecFromStr("11"), setPrice("11"))
+	require.False(t, k.IsMarketHalted(ctx, "tstusd"))
+
+	// prices within the max block change that move past the max window change also halt the market
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Minute))
+	require.Equal(t, sdk.MustNewDecFromStr("12.5"), setPrice("12.5"))
+	require.False(t, k.IsMarketHalted(ctx, "tstusd"))
+	ctx = ctx.WithBlockTime(start.Add(4 * time.Minute))
+	require.Equal(t, sdk.MustNewDecFromStr("12.5"), setPrice("14"))
+	require.True(t, k.IsMarketHalted(ctx, "tstusd"))
+
+	state, found := k.GetCircuitBreakerState(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, types.CircuitBreakerState{
+		MarketID:    "tstusd",
+		WindowStart: start,
+		WindowPrice: sdk.MustNewDecFromStr("10"),
+		Halted:      true,
+		HaltTime:    start.Add(4 * time.Minute),
+	}, state)
+
+	// the price is accepted as confirmed once the window has passed since the halt
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Minute))
+	require.Equal(t, sdk.MustNewDecFromStr("12.5"), setPrice("16"))
+	require.True(t, k.IsMarketHalted(ctx, "tstusd"))
+	ctx = ctx.WithBlockTime(start.Add(4*time.Minute + time.Hour))
+	require.Equal(t, sdk.MustNewDecFromStr("16"), setPrice("16"))
+	require.False(t, k.IsMarketHalted(ctx, "tstusd"))
+
+	state, found = k.GetCircuitBreakerState(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, types.CircuitBreakerState{
+		MarketID:    "tstusd",
+		WindowStart: ctx.BlockTime(),
+		WindowPrice: sdk.MustNewDecFromStr("16"),
+		HaltTime:    start.Add(4 * time.Minute),
+	}, state)
+
+	// removing the circuit breaker deletes its state
+	market.CircuitBreaker = types.CircuitBreaker{}
+	k.SetParams(ctx, types.NewParams([]types.Market{market}))
+	require.Equal(t, sdk.MustNewDecFromStr("1"), setPrice("1"))
+	_, found = k.GetCircuitBreakerState(ctx, "tstusd")
+	require.False(t, found)
+}
+
+func TestKeeper_CircuitBreaker_MissingPrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	k := tApp.GetPriceFeedKeeper()
+
+	maxBlockChange := sdk.MustNewDecFromStr("0.2")
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.CircuitBreaker = types.NewCircuitBreaker(&maxBlockChange, nil, time.Hour)
+	k.SetParams(ctx, types.NewParams([]types.Market{market}))
+
+	setPrice := func(price string) sdk.Dec {
+		_, err := k.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+		currentPrice, err := k.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		return currentPrice.Price
+	}
+
+	require.Equal(t, sdk.MustNewDecFromStr("10"), setPrice("10"))
+
+	// the price expires for a block, leaving the market without a current price
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Minute))
+	require.ErrorIs(t, k.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice)
+	_, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.Error(t, err)
+
+	// a price 90% off is checked against the window price, which is held while the market is halted
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Minute))
+	require.Equal(t, sdk.MustNewDecFromStr("10"), setPrice("1"))
+	require.True(t, k.IsMarketHalted(ctx, "tstusd"))
+
+	// skipping another block does not lift the halt, even with a price within the limits of the window price
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Minute))
+	require.ErrorIs(t, k.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice)
+	ctx = ctx.WithBlockTime(start.Add(6 * time.Minute))
+	require.Equal(t, sdk.MustNewDecFromStr("10"), setPrice("10.5"))
+	require.True(t, k.IsMarketHalted(ctx, "tstusd"))
+
+	state, found := k.GetCircuitBreakerState(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, types.CircuitBreakerState{
+		MarketID:    "tstusd",
+		WindowStart: start,
+		WindowPrice: sdk.MustNewDecFromStr("10"),
+		Halted:      true,
+		HaltTime:    start.Add(3 * time.Minute),
+	}, state)
+
+	// the price is accepted as confirmed once the window has passed since the halt
+	ctx = ctx.WithBlockTime(start.Add(3*time.Minute + time.Hour))
+	require.Equal(t, sdk.MustNewDecFromStr("1"), setPrice("1"))
+	require.False(t, k.IsMarketHalted(ctx, "tstusd"))
+}
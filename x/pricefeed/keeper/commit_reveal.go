@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// CommitPrice stores the hash of a price an oracle will reveal in the current round of a commit-reveal market.
+// Prices can only be committed in the commit phase of a round, once per oracle.
+func (k Keeper) CommitPrice(ctx sdk.Context, oracle sdk.AccAddress, marketID string, hash []byte) error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if !market.CommitReveal.IsEnabled() {
+		return errorsmod.Wrap(types.ErrCommitRevealDisabled, marketID)
+	}
+	if !market.CommitReveal.IsCommitPhase(ctx.BlockHeight()) {
+		return errorsmod.Wrapf(types.ErrCommitPhase, "block %d is in the reveal phase of market %s", ctx.BlockHeight(), marketID)
+	}
+
+	round := market.CommitReveal.Round(ctx.BlockHeight())
+	if commit, found := k.GetPriceCommit(ctx, marketID, oracle); found && commit.Round == round {
+		return errorsmod.Wrapf(types.ErrPriceCommitExists, "market %s, oracle %s and round %d", marketID, oracle, round)
+	}
+
+	k.SetPriceCommit(ctx, types.NewPriceCommit(marketID, oracle, hash, round))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleCommitPrice,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeCommitHash, hex.EncodeToString(hash)),
+		),
+	)
+	return nil
+}
+
+// RevealPrice reveals a price committed to by an oracle. The price must be revealed in the reveal phase of the
+// round it was committed in, and is posted at the end of the round, so that prices revealed in a round can not
+// be copied into commits of the same round.
+func (k Keeper) RevealPrice(
+	ctx sdk.Context,
+	oracle sdk.AccAddress,
+	marketID string,
+	price sdk.Dec,
+	expiry time.Time,
+	salt string,
+) error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if !market.CommitReveal.IsEnabled() {
+		return errorsmod.Wrap(types.ErrCommitRevealDisabled, marketID)
+	}
+
+	commit, found := k.GetPriceCommit(ctx, marketID, oracle)
+	if !found || commit.Revealed {
+		return errorsmod.Wrapf(types.ErrPriceCommitNotFound, "market %s and oracle %s", marketID, oracle)
+	}
+	if market.CommitReveal.IsCommitPhase(ctx.BlockHeight()) || commit.Round != market.CommitReveal.Round(ctx.BlockHeight()) {
+		return errorsmod.Wrapf(types.ErrRevealWindow, "price committed in round %d must be revealed in its reveal phase", commit.Round)
+	}
+	if !bytes.Equal(commit.Hash, types.PriceCommitHash(marketID, oracle, price, expiry, salt)) {
+		return errorsmod.Wrapf(types.ErrInvalidPriceReveal, "market %s and oracle %s", marketID, oracle)
+	}
+	if !expiry.After(ctx.BlockTime()) {
+		return types.ErrExpired
+	}
+
+	k.SetPriceCommit(ctx, commit.Reveal(price, expiry))
+	return nil
+}
+
+// EndPriceCommitRounds posts the prices revealed in the rounds of commit-reveal markets that end at the current
+// block, and deletes their commits, counting a missed reveal for each commit that was not revealed. Commits for
+// markets that no longer use commit-reveal are deleted without counting a missed reveal.
+func (k Keeper) EndPriceCommitRounds(ctx sdk.Context) {
+	for _, commit := range k.GetAllPriceCommits(ctx) {
+		market, found := k.GetMarket(ctx, commit.MarketID)
+		if !found || !market.CommitReveal.IsEnabled() {
+			k.DeletePriceCommit(ctx, commit.MarketID, commit.OracleAddress)
+			continue
+		}
+
+		// commits of earlier rounds are left by changes to the phase blocks of a market
+		round := market.CommitReveal.Round(ctx.BlockHeight())
+		if commit.Round == round && !market.CommitReveal.IsRoundEnd(ctx.BlockHeight()) {
+			continue
+		}
+		k.DeletePriceCommit(ctx, commit.MarketID, commit.OracleAddress)
+
+		if commit.Revealed {
+			// prices that expired during the reveal phase are not posted
+			if _, err := k.SetPrice(ctx, commit.OracleAddress, commit.MarketID, commit.Price, commit.Expiry); err == nil {
+				k.RecordOraclePost(ctx, commit.MarketID, commit.OracleAddress)
+			}
+			continue
+		}
+
+		missed := k.GetMissedRevealCount(ctx, commit.MarketID, commit.OracleAddress) + 1
+		k.SetMissedRevealCount(ctx, types.NewMissedRevealCount(commit.MarketID, commit.OracleAddress, missed))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOracleMissedReveal,
+				sdk.NewAttribute(types.AttributeMarketID, commit.MarketID),
+				sdk.NewAttribute(types.AttributeOracle, commit.OracleAddress.String()),
+				sdk.NewAttribute(types.AttributeMissedReveals, fmt.Sprintf("%d", missed)),
+			),
+		)
+	}
+}
+
+// GetPriceCommit returns the unrevealed price commit of an oracle for a market
+func (k Keeper) GetPriceCommit(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.PriceCommit, bool) {
+	bz := ctx.KVStore(k.key).Get(types.PriceCommitKey(marketID, oracle))
+	if bz == nil {
+		return types.PriceCommit{}, false
+	}
+	var commit types.PriceCommit
+	k.cdc.MustUnmarshal(bz, &commit)
+	return commit, true
+}
+
+// SetPriceCommit saves a price commit to the store
+func (k Keeper) SetPriceCommit(ctx sdk.Context, commit types.PriceCommit) {
+	ctx.KVStore(k.key).Set(types.PriceCommitKey(commit.MarketID, commit.OracleAddress), k.cdc.MustMarshal(&commit))
+}
+
+// DeletePriceCommit deletes a price commit from the store
+func (k Keeper) DeletePriceCommit(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	ctx.KVStore(k.key).Delete(types.PriceCommitKey(marketID, oracle))
+}
+
+// IteratePriceCommits iterates over all price commits in the store and performs a callback function
+func (k Keeper) IteratePriceCommits(ctx sdk.Context, cb func(commit types.PriceCommit) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceCommitPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var commit types.PriceCommit
+		k.cdc.MustUnmarshal(iterator.Value(), &commit)
+		if cb(commit) {
+			break
+		}
+	}
+}
+
+// GetAllPriceCommits returns all price commits from the store
+func (k Keeper) GetAllPriceCommits(ctx sdk.Context) types.PriceCommits {
+	var commits types.PriceCommits
+	k.IteratePriceCommits(ctx, func(commit types.PriceCommit) (stop bool) {
+		commits = append(commits, commit)
+		return false
+	})
+	return commits
+}
+
+// GetMissedRevealCount returns the number of price commits an oracle did not reveal in time for a market
+func (k Keeper) GetMissedRevealCount(ctx sdk.Context, marketID string, oracle sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.MissedRevealCountKey(marketID, oracle))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetMissedRevealCount saves a missed reveal count to the store
+func (k Keeper) SetMissedRevealCount(ctx sdk.Context, missed types.MissedRevealCount) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, missed.Count)
+	ctx.KVStore(k.key).Set(types.MissedRevealCountKey(missed.MarketID, missed.OracleAddress), bz)
+}
+
+// IterateMissedRevealCounts iterates over the missed reveal counts of a market and performs a callback function
+func (k Keeper) IterateMissedRevealCounts(ctx sdk.Context, marketID string, cb func(missed types.MissedRevealCount) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MissedRevealCountIteratorKey(marketID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// keys are the oracle address prefixed by its length
+		oracle := sdk.AccAddress(iterator.Key()[1:])
+		missed := types.NewMissedRevealCount(marketID, oracle, binary.BigEndian.Uint64(iterator.Value()))
+		if cb(missed) {
+			break
+		}
+	}
+}
+
+// GetMissedRevealCounts returns the missed reveal counts of a market
+func (k Keeper) GetMissedRevealCounts(ctx sdk.Context, marketID string) types.MissedRevealCounts {
+	var counts types.MissedRevealCounts
+	k.IterateMissedRevealCounts(ctx, marketID, func(missed types.MissedRevealCount) (stop bool) {
+		counts = append(counts, missed)
+		return false
+	})
+	return counts
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestKeeper_CommitRevealPrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start).
+		WithBlockHeight(20)
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	// rounds of 20 blocks, commit phase at heights 20-29 and reveal phase at heights 30-39 of round 1
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.CommitReveal = types.NewCommitReveal(10)
+	k.SetParams(ctx, types.NewParams([]types.Market{market}))
+
+	price := sdk.MustNewDecFromStr("0.5")
+	expiry := start.Add(time.Hour)
+	oracle := addrs[0]
+
+	// prices can not be posted directly
+	_, err := msgSrv.PostPrice(sdk.WrapSDKContext(ctx), types.NewMsgPostPrice(oracle.String(), "tstusd", price, expiry))
+	require.ErrorIs(t, err, types.ErrCommitRevealRequired)
+
+	// prices can not be revealed without a commit
+	_, err = msgSrv.RevealPrice(sdk.WrapSDKContext(ctx), types.NewMsgRevealPrice(oracle.String(), "tstusd", price, expiry, "salt"))
+	require.ErrorIs(t, err, types.ErrPriceCommitNotFound)
+
+	hash := types.PriceCommitHash("tstusd", oracle, price, expiry, "salt")
+	_, err = msgSrv.CommitPrice(sdk.WrapSDKContext(ctx), types.NewMsgCommitPrice(oracle.String(), "tstusd", hash))
+	require.NoError(t, err)
+	commit, found := k.GetPriceCommit(ctx, "tstusd", oracle)
+	require.True(t, found)
+	require.Equal(t, uint64(1), commit.Round)
+
+	// a price can only be committed once per round
+	ctx = ctx.WithBlockHeight(29)
+	_, err = msgSrv.CommitPrice(sdk.WrapSDKContext(ctx), types.NewMsgCommitPrice(oracle.String(), "tstusd", hash))
+	require.ErrorIs(t, err, types.ErrPriceCommitExists)
+
+	// the price can not be revealed in the commit phase
+	_, err = msgSrv.RevealPrice(sdk.WrapSDKContext(ctx), types.NewMsgRevealPrice(oracle.String(), "tstusd", price, expiry, "salt"))
+	require.ErrorIs(t, err, types.ErrRevealWindow)
+
+	// another oracle copying the commit can not reveal it
+	_, err = msgSrv.CommitPrice(sdk.WrapSDKContext(ctx), types.NewMsgCommitPrice(addrs[1].String(), "tstusd", hash))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(30)
+
+	// prices can not be committed in the reveal phase, when revealed prices can be copied
+	_, err = msgSrv.CommitPrice(sdk.WrapSDKContext(ctx), types.NewMsgCommitPrice(oracle.String(), "tstusd", hash))
+	require.ErrorIs(t, err, types.ErrCommitPhase)
+
+	// the revealed price must match the commit
+	_, err = msgSrv.RevealPrice(sdk.WrapSDKContext(ctx), types.NewMsgRevealPrice(oracle.String(), "tstusd", price, expiry, "other salt"))
+	require.ErrorIs(t, err, types.ErrInvalidPriceReveal)
+	_, err = msgSrv.RevealPrice(sdk.WrapSDKContext(ctx), types.NewMsgRevealPrice(addrs[1].String(), "tstusd", price, expiry, "salt"))
+	require.ErrorIs(t, err, types.ErrInvalidPriceReveal)
+
+	_, err = msgSrv.RevealPrice(sdk.WrapSDKContext(ctx), types.NewMsgRevealPrice(oracle.String(), "tstusd", price, expiry, "salt"))
+	require.NoError(t, err)
+
+	// a price can only be revealed once
+	_, err = msgSrv.RevealPrice(sdk.WrapSDKContext(ctx), types.NewMsgRevealPrice(oracle.String(), "tstusd", price, expiry, "salt"))
+	require.ErrorIs(t, err, types.ErrPriceCommitNotFound)
+
+	// revealed prices are not posted before the end of the round
+	k.EndPriceCommitRounds(ctx)
+	require.Empty(t, k.GetRawPrices(ctx, "tstusd"))
+	commit, found = k.GetPriceCommit(ctx, "tstusd", oracle)
+	require.True(t, found)
+	require.True(t, commit.Revealed)
+
+	// revealed prices of the round feed the current price at the end of the round
+	ctx = ctx.WithBlockHeight(39)
+	k.EndPriceCommitRounds(ctx)
+	require.Equal(t, types.PostedPrices{types.NewPostedPrice("tstusd", oracle, price, expiry)}, k.GetRawPrices(ctx, "tstusd"))
+	require.Empty(t, k.GetAllPriceCommits(ctx))
+
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	currentPrice, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, price, currentPrice.Price)
+
+	// prices are committed again in the next round
+	ctx = ctx.WithBlockHeight(40)
+	_, err = msgSrv.CommitPrice(sdk.WrapSDKContext(ctx), types.NewMsgCommitPrice(oracle.String(), "tstusd", hash))
+	require.NoError(t, err)
+}
+
+func TestKeeper_EndPriceCommitRounds(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start).
+		WithBlockHeight(20)
+	k := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.CommitReveal = types.NewCommitReveal(10)
+	k.SetParams(ctx, types.NewParams([]types.Market{market}))
+
+	price := sdk.MustNewDecFromStr("0.5")
+	expiry := start.Add(time.Hour)
+	for _, oracle := range addrs {
+		require.NoError(t, k.CommitPrice(ctx, oracle, "tstusd", types.PriceCommitHash("tstusd", oracle, price, expiry, "salt")))
+	}
+
+	// commits are kept until the end of the round
+	ctx = ctx.WithBlockHeight(30)
+	require.NoError(t, k.RevealPrice(ctx, addrs[0], "tstusd", price, expiry, "salt"))
+	k.EndPriceCommitRounds(ctx)
+	require.Len(t, k.GetAllPriceCommits(ctx), 2)
+
+	// unrevealed commits are deleted and counted as missed reveals at the end of the round
+	ctx = ctx.WithBlockHeight(39)
+	k.EndPriceCommitRounds(ctx)
+	require.Empty(t, k.GetAllPriceCommits(ctx))
+
+	ctx = ctx.WithBlockHeight(40)
+	err := k.RevealPrice(ctx, addrs[1], "tstusd", price, expiry, "salt")
+	require.ErrorIs(t, err, types.ErrPriceCommitNotFound)
+
+	require.Equal(t, uint64(0), k.GetMissedRevealCount(ctx, "tstusd", addrs[0]))
+	require.Equal(t, uint64(1), k.GetMissedRevealCount(ctx, "tstusd", addrs[1]))
+	require.Equal(t, types.MissedRevealCounts{types.NewMissedRevealCount("tstusd", addrs[1], 1)}, k.GetMissedRevealCounts(ctx, "tstusd"))
+
+	queryServer := keeper.NewQueryServerImpl(k)
+	res, err := queryServer.MissedReveals(sdk.WrapSDKContext(ctx), &types.QueryMissedRevealsRequest{MarketId: "tstusd"})
+	require.NoError(t, err)
+	require.Equal(t, types.MissedRevealCountResponses{{MarketID: "tstusd", OracleAddress: addrs[1].String(), Count: 1}}, res.MissedRevealCounts)
+
+	// commits of earlier rounds can not be revealed and are deleted with the next round end
+	require.NoError(t, k.CommitPrice(ctx, addrs[1], "tstusd", types.PriceCommitHash("tstusd", addrs[1], price, expiry, "salt")))
+	ctx = ctx.WithBlockHeight(70)
+	err = k.RevealPrice(ctx, addrs[1], "tstusd", price, expiry, "salt")
+	require.ErrorIs(t, err, types.ErrRevealWindow)
+	k.EndPriceCommitRounds(ctx)
+	require.Empty(t, k.GetAllPriceCommits(ctx))
+	require.Equal(t, uint64(2), k.GetMissedRevealCount(ctx, "tstusd", addrs[1]))
+}
+
+func TestKeeper_CommitPrice_Disabled(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	k := tApp.GetPriceFeedKeeper()
+
+	k.SetParams(ctx, types.NewParams([]types.Market{types.NewMarket("tstusd", "tst", "usd", addrs, true)}))
+
+	hash := types.PriceCommitHash("tstusd", addrs[0], sdk.OneDec(), ctx.BlockTime().Add(time.Hour), "salt")
+	err := k.CommitPrice(ctx, addrs[0], "tstusd", hash)
+	require.ErrorIs(t, err, types.ErrCommitRevealDisabled)
+
+	err = k.CommitPrice(ctx, addrs[0], "invalid", hash)
+	require.ErrorIs(t, err, types.ErrInvalidMarket)
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// DelegateFeeder authorizes a feeder address to post prices on behalf of an oracle, replacing any previous feeder
+// of the oracle. The oracle must be an oracle of at least one market, and the feeder can not be an oracle of any
+// market or the feeder of another oracle.
+func (k Keeper) DelegateFeeder(ctx sdk.Context, oracle, feeder sdk.AccAddress) error {
+	if oracle.Equals(feeder) {
+		return errorsmod.Wrap(types.ErrInvalidFeeder, "oracle cannot be its own feeder")
+	}
+	if !k.isOracleOfAnyMarket(ctx, oracle) {
+		return errorsmod.Wrap(types.ErrInvalidOracle, oracle.String())
+	}
+	if k.isOracleOfAnyMarket(ctx, feeder) {
+		return errorsmod.Wrapf(types.ErrInvalidFeeder, "%s is an oracle", feeder)
+	}
+	if feederOracle, found := k.GetFeederOracle(ctx, feeder); found && !feederOracle.Equals(oracle) {
+		return errorsmod.Wrapf(types.ErrInvalidFeeder, "%s is the feeder of oracle %s", feeder, feederOracle)
+	}
+
+	if delegation, found := k.GetFeederDelegation(ctx, oracle); found {
+		k.deleteFeederDelegation(ctx, delegation)
+	}
+	k.SetFeederDelegation(ctx, types.NewFeederDelegation(oracle, feeder))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeederDelegated,
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeFeeder, feeder.String()),
+		),
+	)
+	return nil
+}
+
+// RevokeFeeder removes the authorization of the feeder address of an oracle
+func (k Keeper) RevokeFeeder(ctx sdk.Context, oracle sdk.AccAddress) error {
+	delegation, found := k.GetFeederDelegation(ctx, oracle)
+	if !found {
+		return errorsmod.Wrap(types.ErrFeederDelegationNotFound, oracle.String())
+	}
+	k.deleteFeederDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeederRevoked,
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeFeeder, delegation.FeederAddress.String()),
+		),
+	)
+	return nil
+}
+
+// ResolveOracle returns the oracle of a market that an address posts prices for, which is the address itself for
+// oracles of the market, or the oracle that delegated to the address for feeders
+func (k Keeper) ResolveOracle(ctx sdk.Context, marketID string, address sdk.AccAddress) (sdk.AccAddress, error) {
+	return k.GetOracle(ctx, marketID, k.resolveFeeder(ctx, marketID, address))
+}
+
+// resolveFeeder returns the oracle that delegated to an address if the oracle is an oracle of the market and the
+// address is not, otherwise it returns the address
+func (k Keeper) resolveFeeder(ctx sdk.Context, marketID string, address sdk.AccAddress) sdk.AccAddress {
+	oracle, found := k.GetFeederOracle(ctx, address)
+	if !found {
+		return address
+	}
+	if _, err := k.GetOracle(ctx, marketID, address); err == nil {
+		return address
+	}
+	if _, err := k.GetOracle(ctx, marketID, oracle); err != nil {
+		return address
+	}
+	return oracle
+}
+
+// isOracleOfAnyMarket returns true if the address is an oracle of at least one market
+func (k Keeper) isOracleOfAnyMarket(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, market := range k.GetMarkets(ctx) {
+		for _, oracle := range market.Oracles {
+			if oracle.Equals(address) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetFeederDelegation returns the feeder delegation of an oracle
+func (k Keeper) GetFeederDelegation(ctx sdk.Context, oracle sdk.AccAddress) (types.FeederDelegation, bool) {
+	bz := ctx.KVStore(k.key).Get(types.FeederDelegationKey(oracle))
+	if bz == nil {
+		return types.FeederDelegation{}, false
+	}
+	var delegation types.FeederDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+	return delegation, true
+}
+
+// GetFeederOracle returns the oracle that delegated to a feeder
+func (k Keeper) GetFeederOracle(ctx sdk.Context, feeder sdk.AccAddress) (sdk.AccAddress, bool) {
+	bz := ctx.KVStore(k.key).Get(types.FeederOracleKey(feeder))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetFeederDelegation saves a feeder delegation to the store
+func (k Keeper) SetFeederDelegation(ctx sdk.Context, delegation types.FeederDelegation) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.FeederDelegationKey(delegation.OracleAddress), k.cdc.MustMarshal(&delegation))
+	store.Set(types.FeederOracleKey(delegation.FeederAddress), delegation.OracleAddress)
+}
+
+func (k Keeper) deleteFeederDelegation(ctx sdk.Context, delegation types.FeederDelegation) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.FeederDelegationKey(delegation.OracleAddress))
+	store.Delete(types.FeederOracleKey(delegation.FeederAddress))
+}
+
+// IterateFeederDelegations iterates over all feeder delegations and performs a callback function
+func (k Keeper) IterateFeederDelegations(ctx sdk.Context, cb func(delegation types.FeederDelegation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.FeederDelegationPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.FeederDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// GetFeederDelegations returns all feeder delegations
+func (k Keeper) GetFeederDelegations(ctx sdk.Context) types.FeederDelegations {
+	var delegations types.FeederDelegations
+	k.IterateFeederDelegations(ctx, func(delegation types.FeederDelegation) (stop bool) {
+		delegations = append(delegations, delegation)
+		return false
+	})
+	return delegations
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestKeeper_DelegateFeeder(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	oracle, otherOracle, feeder, newFeeder := addrs[0], addrs[1], addrs[2], addrs[3]
+	k.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{oracle}, true),
+		types.NewMarket("xrpusd", "xrp", "usd", []sdk.AccAddress{otherOracle}, true),
+	}))
+
+	tests := []struct {
+		giveMsg    string
+		giveOracle sdk.AccAddress
+		giveFeeder sdk.AccAddress
+		wantErr    error
+	}{
+		{"not an oracle", feeder, newFeeder, types.ErrInvalidOracle},
+		{"feeder is an oracle", oracle, otherOracle, types.ErrInvalidFeeder},
+		{"feeder is the oracle", oracle, oracle, types.ErrInvalidFeeder},
+	}
+	for _, tt := range tests {
+		t.Run(tt.giveMsg, func(t *testing.T) {
+			_, err := msgSrv.DelegateFeeder(sdk.WrapSDKContext(ctx), types.NewMsgDelegateFeeder(tt.giveOracle.String(), tt.giveFeeder.String()))
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := msgSrv.DelegateFeeder(sdk.WrapSDKContext(ctx), types.NewMsgDelegateFeeder(oracle.String(), feeder.String()))
+	require.NoError(t, err)
+	delegation, found := k.GetFeederDelegation(ctx, oracle)
+	require.True(t, found)
+	require.Equal(t, types.NewFeederDelegation(oracle, feeder), delegation)
+
+	// a feeder can only post for a single oracle
+	_, err = msgSrv.DelegateFeeder(sdk.WrapSDKContext(ctx), types.NewMsgDelegateFeeder(otherOracle.String(), feeder.String()))
+	require.ErrorIs(t, err, types.ErrInvalidFeeder)
+
+	// feeders post prices for their oracle in the markets of the oracle
+	expiry := ctx.BlockTime().Add(time.Hour)
+	_, err = msgSrv.PostPrice(sdk.WrapSDKContext(ctx), types.NewMsgPostPrice(feeder.String(), "tstusd", sdk.OneDec(), expiry))
+	require.NoError(t, err)
+	require.Equal(t, types.PostedPrices{types.NewPostedPrice("tstusd", oracle, sdk.OneDec(), expiry)}, k.GetRawPrices(ctx, "tstusd"))
+	stats, found := k.GetOracleStats(ctx, "tstusd", oracle)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), stats.LastPostTime)
+
+	_, err = msgSrv.PostPrice(sdk.WrapSDKContext(ctx), types.NewMsgPostPrice(feeder.String(), "xrpusd", sdk.OneDec(), expiry))
+	require.ErrorIs(t, err, types.ErrInvalidOracle)
+
+	// delegating to a new feeder replaces the previous feeder
+	_, err = msgSrv.DelegateFeeder(sdk.WrapSDKContext(ctx), types.NewMsgDelegateFeeder(oracle.String(), newFeeder.String()))
+	require.NoError(t, err)
+	_, found = k.GetFeederOracle(ctx, feeder)
+	require.False(t, found)
+	_, err = k.ResolveOracle(ctx, "tstusd", feeder)
+	require.ErrorIs(t, err, types.ErrInvalidOracle)
+	resolved, err := k.ResolveOracle(ctx, "tstusd", newFeeder)
+	require.NoError(t, err)
+	require.Equal(t, oracle, resolved)
+
+	// revoked feeders can no longer post prices
+	_, err = msgSrv.RevokeFeeder(sdk.WrapSDKContext(ctx), types.NewMsgRevokeFeeder(oracle.String()))
+	require.NoError(t, err)
+	_, found = k.GetFeederDelegation(ctx, oracle)
+	require.False(t, found)
+	_, err = msgSrv.PostPrice(sdk.WrapSDKContext(ctx), types.NewMsgPostPrice(newFeeder.String(), "tstusd", sdk.OneDec(), expiry))
+	require.ErrorIs(t, err, types.ErrInvalidOracle)
+
+	_, err = msgSrv.RevokeFeeder(sdk.WrapSDKContext(ctx), types.NewMsgRevokeFeeder(oracle.String()))
+	require.ErrorIs(t, err, types.ErrFeederDelegationNotFound)
+}
+
+func TestKeeper_DelegateFeeder_CommitReveal(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	oracle, feeder := addrs[0], addrs[1]
+	market := types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{oracle}, true)
+	market.CommitReveal = types.NewCommitReveal(10)
+	k.SetParams(ctx, types.NewParams([]types.Market{market}))
+	require.NoError(t, k.DelegateFeeder(ctx, oracle, feeder))
+
+	// feeders commit to and reveal the prices of their oracle
+	price, expiry := sdk.OneDec(), start.Add(time.Hour)
+	hash := types.PriceCommitHash("tstusd", oracle, price, expiry, "salt")
+	_, err := msgSrv.CommitPrice(sdk.WrapSDKContext(ctx), types.NewMsgCommitPrice(feeder.String(), "tstusd", hash))
+	require.NoError(t, err)
+	_, found := k.GetPriceCommit(ctx, "tstusd", oracle)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(19)
+	_, err = msgSrv.RevealPrice(sdk.WrapSDKContext(ctx), types.NewMsgRevealPrice(feeder.String(), "tstusd", price, expiry, "salt"))
+	require.NoError(t, err)
+	k.EndPriceCommitRounds(ctx)
+	require.Equal(t, types.PostedPrices{types.NewPostedPrice("tstusd", oracle, price, expiry)}, k.GetRawPrices(ctx, "tstusd"))
+}
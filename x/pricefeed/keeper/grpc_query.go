@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -120,3 +121,120 @@ func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest)
 		Markets: markets,
 	}, nil
 }
+
+func (s queryServer) MissedReveals(c context.Context, req *types.QueryMissedRevealsRequest) (*types.QueryMissedRevealsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	var counts types.MissedRevealCountResponses
+	for _, missed := range s.keeper.GetMissedRevealCounts(ctx, req.MarketId) {
+		counts = append(counts, types.MissedRevealCountResponse{
+			MarketID:      missed.MarketID,
+			OracleAddress: missed.OracleAddress.String(),
+			Count:         missed.Count,
+		})
+	}
+
+	return &types.QueryMissedRevealsResponse{
+		MissedRevealCounts: counts,
+	}, nil
+}
+
+func (s queryServer) PriceHistory(c context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		PriceHistory: s.keeper.GetPriceHistory(ctx, req.MarketId),
+	}, nil
+}
+
+func (s queryServer) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	endTime := req.EndTime
+	if endTime.IsZero() {
+		endTime = ctx.BlockTime()
+	}
+
+	price, err := s.keeper.GetTWAP(ctx, req.MarketId, req.StartTime, endTime)
+	if err != nil {
+		if errors.Is(err, types.ErrPriceHistoryNotFound) || errors.Is(err, types.ErrNoValidPrice) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTWAPResponse{
+		MarketID:  req.MarketId,
+		Price:     price,
+		StartTime: req.StartTime,
+		EndTime:   endTime,
+	}, nil
+}
+
+func (s queryServer) OracleStats(c context.Context, req *types.QueryOracleStatsRequest) (*types.QueryOracleStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	var statsResponses types.OracleStatsResponses
+	for _, stats := range s.keeper.GetOracleStatsByMarket(ctx, req.MarketId) {
+		statsResponses = append(statsResponses, stats.ToResponse())
+	}
+
+	return &types.QueryOracleStatsResponse{
+		OracleStats: statsResponses,
+	}, nil
+}
+
+func (s queryServer) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	oracle, err := sdk.AccAddressFromBech32(req.OracleAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid oracle address")
+	}
+
+	delegation, found := s.keeper.GetFeederDelegation(ctx, oracle)
+	if !found {
+		return nil, status.Error(codes.NotFound, "feeder delegation not found")
+	}
+
+	return &types.QueryFeederDelegationResponse{
+		FeederDelegation: delegation.ToResponse(),
+	}, nil
+}
+
+func (s queryServer) FeederDelegations(c context.Context, req *types.QueryFeederDelegationsRequest) (*types.QueryFeederDelegationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var delegationResponses types.FeederDelegationResponses
+	for _, delegation := range s.keeper.GetFeederDelegations(ctx) {
+		delegationResponses = append(delegationResponses, delegation.ToResponse())
+	}
+
+	return &types.QueryFeederDelegationsResponse{
+		FeederDelegations: delegationResponses,
+	}, nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed/types"
 	"github.com/stretchr/testify/suite"
 	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type grpcQueryTestSuite struct {
@@ -242,6 +244,85 @@ func (suite *grpcQueryTestSuite) TestGrpcMarkets() {
 	suite.NoError(res.Markets[1].VerboseEqual(params.Markets[1].ToMarketResponse()))
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcPriceHistory() {
+	suite.setTestParams()
+	suite.setTstPrice()
+	suite.keeper.RecordCurrentPrice(suite.ctx, "tstusd")
+
+	res, err := suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{MarketId: "tstusd"})
+	suite.NoError(err)
+	suite.Equal(types.PriceRecords{
+		types.NewPriceRecord("tstusd", suite.ctx.BlockTime(), sdk.MustNewDecFromStr("0.34"), sdk.ZeroDec()),
+	}, res.PriceHistory)
+
+	_, err = suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{MarketId: "invalid"})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcTWAP() {
+	suite.setTestParams()
+	suite.setTstPrice()
+	suite.keeper.RecordCurrentPrice(suite.ctx, "tstusd")
+
+	start := suite.ctx.BlockTime()
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(time.Minute))
+
+	// the end time defaults to the block time
+	res, err := suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "tstusd", StartTime: start})
+	suite.NoError(err)
+	suite.Equal(&types.QueryTWAPResponse{
+		MarketID:  "tstusd",
+		Price:     sdk.MustNewDecFromStr("0.34"),
+		StartTime: start,
+		EndTime:   suite.ctx.BlockTime(),
+	}, res)
+
+	_, err = suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "tstusd", StartTime: start.Add(-time.Second)})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	_, err = suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "tstusd", StartTime: start.Add(time.Hour)})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "invalid", StartTime: start})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcOracleStats() {
+	suite.setTestParams()
+	suite.keeper.RecordOraclePost(suite.ctx, "tstusd", suite.addrs[0])
+
+	expectedStats := types.NewOracleStats("tstusd", suite.addrs[0], suite.ctx.BlockTime())
+	expectedStats.LastPostTime = suite.ctx.BlockTime()
+
+	res, err := suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{MarketId: "tstusd"})
+	suite.NoError(err)
+	suite.Equal(types.OracleStatsResponses{expectedStats.ToResponse()}, res.OracleStats)
+
+	_, err = suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{MarketId: "invalid"})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcFeederDelegation() {
+	feeder := sdk.AccAddress("feeder--------------")
+	suite.keeper.SetFeederDelegation(suite.ctx, types.NewFeederDelegation(suite.addrs[0], feeder))
+
+	expected := types.NewFeederDelegation(suite.addrs[0], feeder).ToResponse()
+
+	res, err := suite.queryServer.FeederDelegation(sdk.WrapSDKContext(suite.ctx), &types.QueryFeederDelegationRequest{OracleAddress: suite.addrs[0].String()})
+	suite.NoError(err)
+	suite.Equal(expected, res.FeederDelegation)
+
+	allRes, err := suite.queryServer.FeederDelegations(sdk.WrapSDKContext(suite.ctx), &types.QueryFeederDelegationsRequest{})
+	suite.NoError(err)
+	suite.Equal(types.FeederDelegationResponses{expected}, allRes.FeederDelegations)
+
+	_, err = suite.queryServer.FeederDelegation(sdk.WrapSDKContext(suite.ctx), &types.QueryFeederDelegationRequest{OracleAddress: suite.addrs[1].String()})
+	suite.Equal("rpc error: code = NotFound desc = feeder delegation not found", err.Error())
+
+	_, err = suite.queryServer.FeederDelegation(sdk.WrapSDKContext(suite.ctx), &types.QueryFeederDelegationRequest{OracleAddress: "invalid"})
+	suite.Equal("rpc error: code = InvalidArgument desc = invalid oracle address", err.Error())
+}
+
 func (suite *grpcQueryTestSuite) setTstPrice() {
 	_, err := suite.keeper.SetPrice(
 		suite.ctx, suite.addrs[0], "tstusd",
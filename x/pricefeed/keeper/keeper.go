@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -24,6 +23,8 @@ type Keeper struct {
 	cdc codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace paramtypes.Subspace
+	// The source of time-weighted average prices for markets priced by a pool
+	poolTWAPSource types.PoolTWAPSource
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
@@ -41,12 +42,22 @@ func NewKeeper(
 	}
 }
 
+// SetPoolTWAPSource sets the source of time-weighted average prices for markets priced by a pool.
+func (k *Keeper) SetPoolTWAPSource(source types.PoolTWAPSource) *Keeper {
+	if k.poolTWAPSource != nil {
+		panic("cannot set pool twap source twice")
+	}
+	k.poolTWAPSource = source
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// SetPrice updates the posted price for a specific oracle
+// SetPrice updates the posted price for a specific oracle. Prices posted by feeders must be set for the oracle
+// returned by ResolveOracle.
 func (k Keeper) SetPrice(
 	ctx sdk.Context,
 	oracle sdk.AccAddress,
@@ -58,9 +69,6 @@ func (k Keeper) SetPrice(
 	if !expiry.After(ctx.BlockTime()) {
 		return types.PostedPrice{}, types.ErrExpired
 	}
-
-	store := ctx.KVStore(k.key)
-
 	newRawPrice := types.NewPostedPrice(marketID, oracle, price, expiry)
 
 	// Emit an event containing the oracle's new price
@@ -74,14 +82,35 @@ func (k Keeper) SetPrice(
 		),
 	)
 
-	// Sets the raw price for a single oracle instead of an array of all oracle's raw prices
-	store.Set(types.RawPriceKey(marketID, oracle), k.cdc.MustMarshal(&newRawPrice))
+	k.setRawPrice(ctx, newRawPrice)
 	return newRawPrice, nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetPrices updates the posted prices of an oracle for several markets, emitting an event for each price
+func (k Keeper) SetPrices(ctx sdk.Context, oracle sdk.AccAddress, prices types.PriceEntries) (types.PostedPrices, error) {
+	newRawPrices := make(types.PostedPrices, 0, len(prices))
+	for _, price := range prices {
+		newRawPrice, err := k.SetPrice(ctx, oracle, price.MarketID, price.Price, price.Expiry)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, price.MarketID)
+		}
+		newRawPrices = append(newRawPrices, newRawPrice)
+	}
+	return newRawPrices, nil
+}
+
+// setRawPrice sets the raw price for a single oracle instead of an array of all oracle's raw prices
+func (k Keeper) setRawPrice(ctx sdk.Context, rawPrice types.PostedPrice) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.RawPriceKey(rawPrice.MarketID, rawPrice.OracleAddress), k.cdc.MustMarshal(&rawPrice))
+}
+
+// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs, to the
+// time-weighted average price of a pool or another market for markets priced by a twap, or to the product of
+// the current prices of other markets for derived markets. Derived and twap markets must be updated after the
+// markets they depend on. Markets with a circuit breaker hold their previous price while halted.
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
 	}
@@ -92,75 +121,114 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 		validPrevPrice = false
 	}
 
-	prices := k.GetRawPrices(ctx, marketID)
+	var price sdk.Dec
+	if market.PoolPriceSource.IsEnabled() {
+		price, err = k.getPoolPrice(ctx, market.PoolPriceSource)
+		if err != nil {
+			// zero out the current price in the same way as expired oracle prices
+			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+			return errorsmod.Wrap(types.ErrNoValidPrice, err.Error())
+		}
+	} else if market.DerivedPriceSource.IsEnabled() {
+		price, err = k.getDerivedPrice(ctx, market.DerivedPriceSource)
+		if err != nil {
+			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+			return errorsmod.Wrap(types.ErrNoValidPrice, err.Error())
+		}
+	} else if market.TWAPPriceSource.IsEnabled() {
+		source := market.TWAPPriceSource
+		price, err = k.GetTWAP(ctx, source.MarketID, ctx.BlockTime().Add(-source.Window), ctx.BlockTime())
+		if err != nil {
+			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+			return errorsmod.Wrap(types.ErrNoValidPrice, err.Error())
+		}
+	} else {
+		prices := k.GetRawPrices(ctx, marketID)
 
-	var notExpiredPrices []types.CurrentPrice
-	// filter out expired prices
-	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+		var notExpiredPrices types.PostedPrices
+		// filter out expired prices
+		for _, v := range prices {
+			if v.Expiry.After(ctx.BlockTime()) {
+				notExpiredPrices = append(notExpiredPrices, v)
+			}
+		}
+
+		if len(notExpiredPrices) == 0 {
+			// NOTE: The current price stored will continue storing the most recent (expired)
+			// price if this is not set.
+			// This zero's out the current price stored value for that market and ensures
+			// that CDP methods that GetCurrentPrice will return error.
+			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+			return types.ErrNoValidPrice
 		}
-	}
 
-	if len(notExpiredPrices) == 0 {
-		// NOTE: The current price stored will continue storing the most recent (expired)
-		// price if this is not set.
-		// This zero's out the current price stored value for that market and ensures
-		// that CDP methods that GetCurrentPrice will return error.
-		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
-		return types.ErrNoValidPrice
+		price, err = market.Aggregation.Aggregate(notExpiredPrices)
+		if err != nil {
+			// markets without a quorum of valid prices are treated as inactive until enough oracles post
+			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+			return errorsmod.Wrap(types.ErrNoValidPrice, err.Error())
+		}
 	}
 
-	medianPrice := k.CalculateMedianPrice(notExpiredPrices)
+	price = k.applyCircuitBreaker(ctx, market, prevPrice, validPrevPrice, price)
 
 	// check case that market price was not set in genesis
-	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
+	if validPrevPrice && !price.Equal(prevPrice.Price) {
 		// only emit event if price has changed
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeMarketPriceUpdated,
 				sdk.NewAttribute(types.AttributeMarketID, marketID),
-				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+				sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
 			),
 		)
 	}
 
-	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
+	currentPrice := types.NewCurrentPrice(marketID, price)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
 
 	return nil
 }
 
-func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
-	store := ctx.KVStore(k.key)
-	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshal(&currentPrice))
-}
-
-// CalculateMedianPrice calculates the median prices for the input prices.
-func (k Keeper) CalculateMedianPrice(prices []types.CurrentPrice) sdk.Dec {
-	l := len(prices)
+// getPoolPrice returns the time-weighted average price of a pool price source, converted from base units to
+// whole tokens
+func (k Keeper) getPoolPrice(ctx sdk.Context, source types.PoolPriceSource) (sdk.Dec, error) {
+	if k.poolTWAPSource == nil {
+		return sdk.Dec{}, fmt.Errorf("no pool twap source set for pool %s", source.PoolID)
+	}
 
-	if l == 1 {
-		// Return immediately if there's only one price
-		return prices[0].Price
+	price, err := k.poolTWAPSource.GetPoolTWAP(ctx, source.PoolID, source.BaseDenom, source.Window)
+	if err != nil {
+		return sdk.Dec{}, err
 	}
-	// sort the prices
-	sort.Slice(prices, func(i, j int) bool {
-		return prices[i].Price.LT(prices[j].Price)
-	})
-	// for even numbers of prices, the median is calculated as the mean of the two middle prices
-	if l%2 == 0 {
-		median := k.calculateMeanPrice(prices[l/2-1], prices[l/2])
-		return median
+	if !price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("pool %s price %s must be positive", source.PoolID, price)
 	}
-	// for odd numbers of prices, return the middle element
-	return prices[l/2].Price
+
+	return source.ConvertPrice(price), nil
 }
 
-func (k Keeper) calculateMeanPrice(priceA, priceB types.CurrentPrice) sdk.Dec {
-	sum := priceA.Price.Add(priceB.Price)
-	mean := sum.Quo(sdk.NewDec(2))
-	return mean
+// getDerivedPrice returns the product of the current prices of the markets of a derived price source, where
+// every market must be active with a valid current price
+func (k Keeper) getDerivedPrice(ctx sdk.Context, source types.DerivedPriceSource) (sdk.Dec, error) {
+	prices := make([]sdk.Dec, 0, len(source.Terms))
+	for _, term := range source.Terms {
+		market, found := k.GetMarket(ctx, term.MarketID)
+		if !found || !market.Active {
+			return sdk.Dec{}, fmt.Errorf("market %s is not active", term.MarketID)
+		}
+		currentPrice, err := k.GetCurrentPrice(ctx, term.MarketID)
+		if err != nil {
+			return sdk.Dec{}, fmt.Errorf("market %s has no valid price", term.MarketID)
+		}
+		prices = append(prices, currentPrice.Price)
+	}
+	return source.Price(prices)
+}
+
+func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshal(&currentPrice))
 }
 
 // GetCurrentPrice fetches the current median price of all oracles for a specific market
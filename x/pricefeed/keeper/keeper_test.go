@@ -12,6 +12,7 @@ import (
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 // TestKeeper_SetGetMarket tests adding markets to the pricefeed, getting markets from the store
@@ -236,3 +237,190 @@ func TestKeeper_ExpiredSetCurrentPrices(t *testing.T) {
 	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
 	require.ErrorIs(t, types.ErrNoValidPrice, err, "current prices should be invalid")
 }
+
+func TestKeeper_SetCurrentPrices_Aggregation(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	maxDeviation := sdk.MustNewDecFromStr("0.1")
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.Aggregation = types.NewPriceAggregation(3, &maxDeviation, nil)
+	keeper.SetParams(ctx, types.NewParams([]types.Market{market}))
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.00"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("5.00"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	// the market is inactive while fewer than the minimum oracles post a price
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	_, err = keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("1.02"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	// the outlier is dropped, leaving fewer than the minimum oracles
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	_, err = keeper.SetPrice(ctx, addrs[3], "tstusd", sdk.MustNewDecFromStr("0.98"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	// the median of the remaining prices is used once a quorum is reached without the outlier
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.00"), price.Price)
+}
+
+func TestKeeper_SetCurrentPrices_PoolPriceSource(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	keeper := tApp.GetPriceFeedKeeper()
+	swapKeeper := tApp.GetSwapKeeper()
+
+	reserves := sdk.NewCoins(sdk.NewInt64Coin("ukava", 10e6), sdk.NewInt64Coin("usdx", 50e6))
+	require.NoError(t, tApp.FundAccount(ctx, addrs[0], reserves))
+	swapKeeper.SetParams(ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ukava", "usdx")),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+	err := swapKeeper.Deposit(ctx, addrs[0], reserves[0], reserves[1], sdk.MustNewDecFromStr("0.01"))
+	require.NoError(t, err)
+
+	mp := types.Params{
+		Markets: []types.Market{
+			{
+				MarketID:        "kava:usd",
+				BaseAsset:       "kava",
+				QuoteAsset:      "usd",
+				Oracles:         []sdk.AccAddress{},
+				Active:          true,
+				PoolPriceSource: types.NewPoolPriceSource("ukava:usdx", "ukava", time.Hour, 6, 6),
+			},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	// the pool does not have price history covering the window
+	err = keeper.SetCurrentPrices(ctx, "kava:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "kava:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Hour))
+
+	err = keeper.SetCurrentPrices(ctx, "kava:usd")
+	require.NoError(t, err)
+	price, err := keeper.GetCurrentPrice(ctx, "kava:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(5), price.Price)
+}
+
+func TestKeeper_SetCurrentPrices_PoolPriceSource_ConversionFactors(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	keeper := tApp.GetPriceFeedKeeper()
+	swapKeeper := tApp.GetSwapKeeper()
+
+	// 2 btc with 8 decimals and 60,000 usdx with 6 decimals, a price of 30,000 usdx per btc
+	reserves := sdk.NewCoins(sdk.NewInt64Coin("btcb", 2e8), sdk.NewInt64Coin("usdx", 60_000e6))
+	require.NoError(t, tApp.FundAccount(ctx, addrs[0], reserves))
+	swapKeeper.SetParams(ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("btcb", "usdx")),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+	err := swapKeeper.Deposit(ctx, addrs[0], reserves[0], reserves[1], sdk.MustNewDecFromStr("0.01"))
+	require.NoError(t, err)
+
+	keeper.SetParams(ctx, types.NewParams([]types.Market{
+		{
+			MarketID:        "btc:usd",
+			BaseAsset:       "btc",
+			QuoteAsset:      "usd",
+			Oracles:         []sdk.AccAddress{},
+			Active:          true,
+			PoolPriceSource: types.NewPoolPriceSource("btcb:usdx", "btcb", time.Hour, 8, 6),
+		},
+		{
+			MarketID:        "usd:btc",
+			BaseAsset:       "usd",
+			QuoteAsset:      "btc",
+			Oracles:         []sdk.AccAddress{},
+			Active:          true,
+			PoolPriceSource: types.NewPoolPriceSource("btcb:usdx", "usdx", time.Hour, 6, 8),
+		},
+	}))
+
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Hour))
+
+	// the pool price of 300 base units of usdx per base unit of btcb is converted to whole tokens
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "btc:usd"))
+	price, err := keeper.GetCurrentPrice(ctx, "btc:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(30_000), price.Price)
+
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "usd:btc"))
+	price, err = keeper.GetCurrentPrice(ctx, "usd:btc")
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec().QuoInt64(30_000), price.Price)
+}
+
+func TestKeeper_SetCurrentPrices_DerivedPriceSource(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	crossMarket := types.NewMarket("atom:usd", "atom", "usd", nil, true)
+	crossMarket.DerivedPriceSource = types.NewDerivedPriceSource(
+		types.NewDerivedPriceTerm("atom:kava", false),
+		types.NewDerivedPriceTerm("usd:kava", true),
+	)
+	keeper.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("atom:kava", "atom", "kava", addrs, true),
+		types.NewMarket("usd:kava", "usd", "kava", addrs, true),
+		crossMarket,
+	}))
+
+	expiry := start.Add(time.Hour)
+	_, err := keeper.SetPrice(ctx, addrs[0], "atom:kava", sdk.NewDec(10), expiry)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "atom:kava"))
+
+	// the derived price requires a valid price for every market
+	err = keeper.SetCurrentPrices(ctx, "atom:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "atom:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	_, err = keeper.SetPrice(ctx, addrs[0], "usd:kava", sdk.MustNewDecFromStr("0.5"), expiry)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "usd:kava"))
+
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "atom:usd"))
+	price, err := keeper.GetCurrentPrice(ctx, "atom:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20), price.Price)
+
+	// the derived price becomes invalid once a market price expires
+	ctx = ctx.WithBlockTime(expiry)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "usd:kava"), types.ErrNoValidPrice)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "atom:usd"), types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "atom:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+}
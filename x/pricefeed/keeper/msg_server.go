@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
@@ -28,15 +29,22 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 		return nil, err
 	}
 
-	_, err = k.keeper.GetOracle(ctx, msg.MarketID, from)
+	oracle, err := k.keeper.ResolveOracle(ctx, msg.MarketID, from)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = k.keeper.SetPrice(ctx, from, msg.MarketID, msg.Price, msg.Expiry)
+	// markets using commit-reveal only accept revealed prices
+	market, _ := k.keeper.GetMarket(ctx, msg.MarketID)
+	if market.CommitReveal.IsEnabled() {
+		return nil, errorsmod.Wrap(types.ErrCommitRevealRequired, msg.MarketID)
+	}
+
+	_, err = k.keeper.SetPrice(ctx, oracle, msg.MarketID, msg.Price, msg.Expiry)
 	if err != nil {
 		return nil, err
 	}
+	k.keeper.RecordOraclePost(ctx, msg.MarketID, oracle)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -48,3 +56,159 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 
 	return &types.MsgPostPriceResponse{}, nil
 }
+
+func (k msgServer) PostPrices(goCtx context.Context, msg *types.MsgPostPrices) (*types.MsgPostPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	var oracle sdk.AccAddress
+	for _, price := range msg.Prices {
+		priceOracle, err := k.keeper.ResolveOracle(ctx, price.MarketID, from)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, price.MarketID)
+		}
+		// prices are posted for a single oracle, which a feeder that is also an oracle could otherwise mix
+		if oracle != nil && !oracle.Equals(priceOracle) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidOracle, "prices must be posted for a single oracle, got %s and %s", oracle, priceOracle)
+		}
+		oracle = priceOracle
+
+		// markets using commit-reveal only accept revealed prices
+		market, _ := k.keeper.GetMarket(ctx, price.MarketID)
+		if market.CommitReveal.IsEnabled() {
+			return nil, errorsmod.Wrap(types.ErrCommitRevealRequired, price.MarketID)
+		}
+	}
+
+	_, err = k.keeper.SetPrices(ctx, oracle, msg.Prices)
+	if err != nil {
+		return nil, err
+	}
+	for _, price := range msg.Prices {
+		k.keeper.RecordOraclePost(ctx, price.MarketID, oracle)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	)
+
+	return &types.MsgPostPricesResponse{}, nil
+}
+
+func (k msgServer) CommitPrice(goCtx context.Context, msg *types.MsgCommitPrice) (*types.MsgCommitPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	oracle, err := k.keeper.ResolveOracle(ctx, msg.MarketID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.CommitPrice(ctx, oracle, msg.MarketID, msg.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	)
+
+	return &types.MsgCommitPriceResponse{}, nil
+}
+
+func (k msgServer) RevealPrice(goCtx context.Context, msg *types.MsgRevealPrice) (*types.MsgRevealPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	oracle, err := k.keeper.ResolveOracle(ctx, msg.MarketID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RevealPrice(ctx, oracle, msg.MarketID, msg.Price, msg.Expiry, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	)
+
+	return &types.MsgRevealPriceResponse{}, nil
+}
+
+func (k msgServer) DelegateFeeder(goCtx context.Context, msg *types.MsgDelegateFeeder) (*types.MsgDelegateFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.DelegateFeeder(ctx, from, feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	)
+
+	return &types.MsgDelegateFeederResponse{}, nil
+}
+
+func (k msgServer) RevokeFeeder(goCtx context.Context, msg *types.MsgRevokeFeeder) (*types.MsgRevokeFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RevokeFeeder(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	)
+
+	return &types.MsgRevokeFeederResponse{}, nil
+}
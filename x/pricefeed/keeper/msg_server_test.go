@@ -61,3 +61,69 @@ func TestKeeper_PostPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_PostPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	oracle := addrs[0]
+	commitRevealMarket := types.NewMarket("crusd", "crt", "usd", []sdk.AccAddress{oracle}, true)
+	commitRevealMarket.CommitReveal = types.NewCommitReveal(10)
+	k.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{oracle}, true),
+		types.NewMarket("xrpusd", "xrp", "usd", []sdk.AccAddress{oracle}, true),
+		types.NewMarket("bnbusd", "bnb", "usd", []sdk.AccAddress{addrs[1]}, true),
+		commitRevealMarket,
+	}))
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	tests := []struct {
+		giveMsg   string
+		givePrice types.PriceEntries
+		wantErr   error
+	}{
+		{"unauthorized market", types.PriceEntries{types.NewPriceEntry("tstusd", sdk.OneDec(), expiry), types.NewPriceEntry("bnbusd", sdk.OneDec(), expiry)}, types.ErrInvalidOracle},
+		{"invalid market", types.PriceEntries{types.NewPriceEntry("tstusd", sdk.OneDec(), expiry), types.NewPriceEntry("invalid", sdk.OneDec(), expiry)}, types.ErrInvalidMarket},
+		{"commit reveal market", types.PriceEntries{types.NewPriceEntry("crusd", sdk.OneDec(), expiry)}, types.ErrCommitRevealRequired},
+		{"expired", types.PriceEntries{types.NewPriceEntry("tstusd", sdk.OneDec(), expiry), types.NewPriceEntry("xrpusd", sdk.OneDec(), ctx.BlockTime())}, types.ErrExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.giveMsg, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			_, err := msgSrv.PostPrices(sdk.WrapSDKContext(cacheCtx), types.NewMsgPostPrices(oracle.String(), tt.givePrice))
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgSrv.PostPrices(sdk.WrapSDKContext(ctx), types.NewMsgPostPrices(oracle.String(), types.PriceEntries{
+		types.NewPriceEntry("tstusd", sdk.MustNewDecFromStr("0.5"), expiry),
+		types.NewPriceEntry("xrpusd", sdk.MustNewDecFromStr("0.25"), expiry),
+	}))
+	require.NoError(t, err)
+
+	require.Equal(t, types.PostedPrices{types.NewPostedPrice("tstusd", oracle, sdk.MustNewDecFromStr("0.5"), expiry)}, k.GetRawPrices(ctx, "tstusd"))
+	require.Equal(t, types.PostedPrices{types.NewPostedPrice("xrpusd", oracle, sdk.MustNewDecFromStr("0.25"), expiry)}, k.GetRawPrices(ctx, "xrpusd"))
+
+	// an event is emitted for each price, as for single prices
+	var priceEvents []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeOracleUpdatedPrice {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeMarketID {
+				priceEvents = append(priceEvents, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{"tstusd", "xrpusd"}, priceEvents)
+
+	stats, found := k.GetOracleStats(ctx, "xrpusd", oracle)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), stats.LastPostTime)
+}
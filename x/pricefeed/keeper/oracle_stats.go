@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// RecordOraclePost sets the last post time of an oracle in a market to the block time
+func (k Keeper) RecordOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	stats, found := k.GetOracleStats(ctx, marketID, oracle)
+	if !found {
+		stats = types.NewOracleStats(marketID, oracle, ctx.BlockTime())
+	}
+	stats.LastPostTime = ctx.BlockTime()
+	k.SetOracleStats(ctx, stats)
+}
+
+// UpdateOracleDeviations sets the deviation of each valid posted price of a market from the current price of
+// the market. Markets without a valid current price or not priced by oracles are skipped, and stats are only
+// written when the deviation of the oracle changes.
+func (k Keeper) UpdateOracleDeviations(ctx sdk.Context, marketID string) {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found || market.PoolPriceSource.IsEnabled() || market.TWAPPriceSource.IsEnabled() || market.DerivedPriceSource.IsEnabled() {
+		return
+	}
+	currentPrice, err := k.GetCurrentPrice(ctx, marketID)
+	if err != nil || !currentPrice.Price.IsPositive() {
+		return
+	}
+
+	for _, pp := range k.GetRawPrices(ctx, marketID) {
+		if !pp.Expiry.After(ctx.BlockTime()) {
+			continue
+		}
+		deviation := pp.Price.Sub(currentPrice.Price).Abs().Quo(currentPrice.Price)
+		stats, found := k.GetOracleStats(ctx, marketID, pp.OracleAddress)
+		if found && stats.Deviation.Equal(deviation) {
+			continue
+		}
+		if !found {
+			stats = types.NewOracleStats(marketID, pp.OracleAddress, ctx.BlockTime())
+		}
+		stats.Deviation = deviation
+		k.SetOracleStats(ctx, stats)
+	}
+}
+
+// UpdateOracleWindows counts the update windows that ended without a price from each oracle of the markets with
+// an oracle monitor. Oracles that miss the max number of windows in a row are suspended from the oracles of the
+// market, along with their weight, as long as enough oracles remain to reach the minimum of the market. Their
+// posted prices and price commits are deleted. Suspended oracles that are added back to the oracles of a
+// market, such as by a committee or governance params change, are reinstated with a new update window.
+func (k Keeper) UpdateOracleWindows(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	paramsChanged := false
+
+	for i, market := range params.Markets {
+		if !market.Active || !market.OracleMonitor.IsEnabled() {
+			continue
+		}
+
+		var oracles []sdk.AccAddress
+		for j, oracle := range market.Oracles {
+			stats := k.updateOracleWindow(ctx, market, oracle)
+
+			remaining := len(oracles) + len(market.Oracles) - j - 1
+			if !market.OracleMonitor.SuspensionEnabled() ||
+				stats.ConsecutiveMissedWindows < uint64(market.OracleMonitor.MaxMissedWindows) ||
+				remaining < int(market.Aggregation.Quorum()) {
+				oracles = append(oracles, oracle)
+				continue
+			}
+
+			k.suspendOracle(ctx, stats)
+		}
+
+		if len(oracles) == len(market.Oracles) {
+			continue
+		}
+		var weights []types.OracleWeight
+		for _, weight := range market.Aggregation.OracleWeights {
+			for _, oracle := range oracles {
+				if weight.Oracle.Equals(oracle) {
+					weights = append(weights, weight)
+					break
+				}
+			}
+		}
+		params.Markets[i].Oracles = oracles
+		params.Markets[i].Aggregation.OracleWeights = weights
+		paramsChanged = true
+	}
+
+	if paramsChanged {
+		k.SetParams(ctx, params)
+	}
+}
+
+// updateOracleWindow ends the update window of an oracle once it has passed, counting a missed window if the
+// oracle did not post a price within it, and returns the updated stats of the oracle
+func (k Keeper) updateOracleWindow(ctx sdk.Context, market types.Market, oracle sdk.AccAddress) types.OracleStats {
+	stats, found := k.GetOracleStats(ctx, market.MarketID, oracle)
+	if !found {
+		stats = types.NewOracleStats(market.MarketID, oracle, ctx.BlockTime())
+		k.SetOracleStats(ctx, stats)
+		return stats
+	}
+
+	if stats.Suspended {
+		stats.Suspended = false
+		stats.ConsecutiveMissedWindows = 0
+		stats.WindowStart = ctx.BlockTime()
+		k.SetOracleStats(ctx, stats)
+		return stats
+	}
+
+	if ctx.BlockTime().Before(stats.WindowStart.Add(market.OracleMonitor.UpdateWindow)) {
+		return stats
+	}
+	if stats.LastPostTime.Before(stats.WindowStart) {
+		stats.MissedWindows++
+		stats.ConsecutiveMissedWindows++
+	} else {
+		stats.ConsecutiveMissedWindows = 0
+	}
+	stats.WindowStart = ctx.BlockTime()
+	k.SetOracleStats(ctx, stats)
+	return stats
+}
+
+// suspendOracle marks an oracle as suspended and deletes its posted price and price commit for the market
+func (k Keeper) suspendOracle(ctx sdk.Context, stats types.OracleStats) {
+	stats.Suspended = true
+	k.SetOracleStats(ctx, stats)
+
+	ctx.KVStore(k.key).Delete(types.RawPriceKey(stats.MarketID, stats.OracleAddress))
+	k.DeletePriceCommit(ctx, stats.MarketID, stats.OracleAddress)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleSuspended,
+			sdk.NewAttribute(types.AttributeMarketID, stats.MarketID),
+			sdk.NewAttribute(types.AttributeOracle, stats.OracleAddress.String()),
+			sdk.NewAttribute(types.AttributeMissedWindows, fmt.Sprintf("%d", stats.ConsecutiveMissedWindows)),
+		),
+	)
+}
+
+// GetOracleStats returns the stats of an oracle in a market
+func (k Keeper) GetOracleStats(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleStats, bool) {
+	bz := ctx.KVStore(k.key).Get(types.OracleStatsKey(marketID, oracle))
+	if bz == nil {
+		return types.OracleStats{}, false
+	}
+	var stats types.OracleStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetOracleStats saves oracle stats to the store
+func (k Keeper) SetOracleStats(ctx sdk.Context, stats types.OracleStats) {
+	ctx.KVStore(k.key).Set(types.OracleStatsKey(stats.MarketID, stats.OracleAddress), k.cdc.MustMarshal(&stats))
+}
+
+// IterateOracleStats iterates over the oracle stats of a market and performs a callback function
+func (k Keeper) IterateOracleStats(ctx sdk.Context, marketID string, cb func(stats types.OracleStats) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OracleStatsIteratorKey(marketID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetOracleStatsByMarket returns the oracle stats of a market
+func (k Keeper) GetOracleStatsByMarket(ctx sdk.Context, marketID string) types.OracleStatsList {
+	var statsList types.OracleStatsList
+	k.IterateOracleStats(ctx, marketID, func(stats types.OracleStats) (stop bool) {
+		statsList = append(statsList, stats)
+		return false
+	})
+	return statsList
+}
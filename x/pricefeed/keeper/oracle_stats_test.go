@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestKeeper_UpdateOracleWindows(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.Aggregation = types.NewPriceAggregation(0, nil, []types.OracleWeight{types.NewOracleWeight(addrs[2], 2)})
+	market.OracleMonitor = types.NewOracleMonitor(time.Minute, 2)
+	k.SetParams(ctx, types.NewParams([]types.Market{market}))
+
+	expiry := start.Add(time.Hour)
+	postPrices := func(oracles ...sdk.AccAddress) {
+		for _, oracle := range oracles {
+			_, err := msgSrv.PostPrice(sdk.WrapSDKContext(ctx), types.NewMsgPostPrice(oracle.String(), "tstusd", sdk.OneDec(), expiry))
+			require.NoError(t, err)
+		}
+	}
+	requireConsecutiveMissedWindows := func(expected ...uint64) {
+		for i, oracle := range addrs {
+			stats, found := k.GetOracleStats(ctx, "tstusd", oracle)
+			require.True(t, found)
+			require.Equal(t, expected[i], stats.ConsecutiveMissedWindows, "oracle %d", i)
+		}
+	}
+
+	k.UpdateOracleWindows(ctx)
+	postPrices(addrs...)
+	stats, found := k.GetOracleStats(ctx, "tstusd", addrs[0])
+	require.True(t, found)
+	require.Equal(t, start, stats.LastPostTime)
+
+	// windows are not ended before the update window has passed
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Second))
+	k.UpdateOracleWindows(ctx)
+	requireConsecutiveMissedWindows(0, 0, 0)
+
+	ctx = ctx.WithBlockTime(start.Add(time.Minute))
+	k.UpdateOracleWindows(ctx)
+	requireConsecutiveMissedWindows(0, 0, 0)
+	postPrices(addrs[0])
+
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Minute))
+	k.UpdateOracleWindows(ctx)
+	requireConsecutiveMissedWindows(0, 1, 1)
+	postPrices(addrs[0], addrs[1])
+
+	// oracles missing the max windows in a row are suspended along with their weight and posted price
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Minute))
+	k.UpdateOracleWindows(ctx)
+	requireConsecutiveMissedWindows(0, 0, 2)
+
+	market, found = k.GetMarket(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, []sdk.AccAddress{addrs[0], addrs[1]}, market.Oracles)
+	require.Empty(t, market.Aggregation.OracleWeights)
+	require.Len(t, k.GetRawPrices(ctx, "tstusd"), 2)
+
+	stats, found = k.GetOracleStats(ctx, "tstusd", addrs[2])
+	require.True(t, found)
+	require.True(t, stats.Suspended)
+	require.Equal(t, uint64(2), stats.MissedWindows)
+
+	_, err := msgSrv.PostPrice(sdk.WrapSDKContext(ctx), types.NewMsgPostPrice(addrs[2].String(), "tstusd", sdk.OneDec(), expiry))
+	require.ErrorIs(t, err, types.ErrInvalidOracle)
+
+	// oracles are not suspended when too few oracles would remain to reach the minimum of the market
+	market.Aggregation = types.NewPriceAggregation(2, nil, nil)
+	k.SetParams(ctx, types.NewParams([]types.Market{market}))
+	for i := 4; i <= 6; i++ {
+		postPrices(addrs[0])
+		ctx = ctx.WithBlockTime(start.Add(time.Duration(i) * time.Minute))
+		k.UpdateOracleWindows(ctx)
+	}
+	stats, found = k.GetOracleStats(ctx, "tstusd", addrs[1])
+	require.True(t, found)
+	require.Equal(t, uint64(3), stats.ConsecutiveMissedWindows)
+	require.False(t, stats.Suspended)
+	market, _ = k.GetMarket(ctx, "tstusd")
+	require.Len(t, market.Oracles, 2)
+
+	// suspended oracles added back to the market are reinstated
+	market.Oracles = append(market.Oracles, addrs[2])
+	k.SetParams(ctx, types.NewParams([]types.Market{market}))
+	k.UpdateOracleWindows(ctx)
+
+	stats, found = k.GetOracleStats(ctx, "tstusd", addrs[2])
+	require.True(t, found)
+	require.False(t, stats.Suspended)
+	require.Equal(t, uint64(0), stats.ConsecutiveMissedWindows)
+	require.Equal(t, uint64(2), stats.MissedWindows)
+	require.Equal(t, ctx.BlockTime(), stats.WindowStart)
+}
+
+func TestKeeper_UpdateOracleDeviations(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	k := tApp.GetPriceFeedKeeper()
+
+	k.SetParams(ctx, types.NewParams([]types.Market{types.NewMarket("tstusd", "tst", "usd", addrs, true)}))
+
+	prices := []sdk.Dec{sdk.MustNewDecFromStr("1.0"), sdk.MustNewDecFromStr("1.1"), sdk.MustNewDecFromStr("0.8")}
+	for i, price := range prices {
+		_, err := k.SetPrice(ctx, addrs[i], "tstusd", price, start.Add(time.Hour))
+		require.NoError(t, err)
+	}
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	k.UpdateOracleDeviations(ctx, "tstusd")
+
+	expected := []sdk.Dec{sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.2")}
+	for i, oracle := range addrs {
+		stats, found := k.GetOracleStats(ctx, "tstusd", oracle)
+		require.True(t, found)
+		require.Equal(t, expected[i], stats.Deviation, "oracle %d", i)
+	}
+
+	// stats are only written when a deviation changes
+	updateGas := func() sdk.Gas {
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		k.UpdateOracleDeviations(gasCtx, "tstusd")
+		return gasCtx.GasMeter().GasConsumed()
+	}
+	unchangedGas := updateGas()
+	_, err := k.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("0.9"), start.Add(time.Hour))
+	require.NoError(t, err)
+	changedGas := updateGas()
+	require.GreaterOrEqual(t, changedGas-unchangedGas, storetypes.KVGasConfig().WriteCostFlat)
+
+	stats, found := k.GetOracleStats(ctx, "tstusd", addrs[2])
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), stats.Deviation)
+}
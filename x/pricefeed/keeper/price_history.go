@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetTWAP returns the time-weighted average price of a market between the start and end time. The window must
+// be within the stored price history of the market, and the market must have a valid current price.
+func (k Keeper) GetTWAP(ctx sdk.Context, marketID string, start, end time.Time) (sdk.Dec, error) {
+	if !start.Before(end) {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidWindow, "start time %s must be before end time %s", start.UTC(), end.UTC())
+	}
+	if end.After(ctx.BlockTime()) {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidWindow, "end time %s is after block time %s", end.UTC(), ctx.BlockTime().UTC())
+	}
+	if _, err := k.GetCurrentPrice(ctx, marketID); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	startRecord, found := k.GetPriceRecordAt(ctx, marketID, start)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrPriceHistoryNotFound, "no price history for market %s at %s", marketID, start.UTC())
+	}
+	endRecord, found := k.GetPriceRecordAt(ctx, marketID, end)
+	if !found {
+		panic("price history must exist after start record")
+	}
+
+	elapsed := sdk.NewDecWithPrec(end.Sub(start).Nanoseconds(), 9)
+	return endRecord.CumulativePriceAt(end).Sub(startRecord.CumulativePriceAt(start)).Quo(elapsed), nil
+}
+
+// GetPriceRecordAt returns the most recent price record of a market at or before a time
+func (k Keeper) GetPriceRecordAt(ctx sdk.Context, marketID string, t time.Time) (types.PriceRecord, bool) {
+	count := k.getPriceHistoryCount(ctx, marketID)
+	oldest, length := priceHistoryRange(count)
+
+	// find the first record after the time, the record before it is the most recent at or before the time
+	i := sort.Search(int(length), func(i int) bool {
+		record, _ := k.getPriceRecord(ctx, marketID, oldest+uint64(i))
+		return record.Timestamp.After(t)
+	})
+	if i == 0 {
+		return types.PriceRecord{}, false
+	}
+	return k.getPriceRecord(ctx, marketID, oldest+uint64(i-1))
+}
+
+// GetPriceHistory returns the stored price records of a market in time order
+func (k Keeper) GetPriceHistory(ctx sdk.Context, marketID string) types.PriceRecords {
+	count := k.getPriceHistoryCount(ctx, marketID)
+	oldest, length := priceHistoryRange(count)
+
+	records := make(types.PriceRecords, 0, length)
+	for seq := oldest; seq < count; seq++ {
+		record, _ := k.getPriceRecord(ctx, marketID, seq)
+		records = append(records, record)
+	}
+	return records
+}
+
+// AppendPriceRecord adds a price record to the price history of a market, overwriting the oldest record once
+// the history is full. The record must be later than the latest record of the market.
+func (k Keeper) AppendPriceRecord(ctx sdk.Context, record types.PriceRecord) {
+	count := k.getPriceHistoryCount(ctx, record.MarketID)
+	k.setPriceRecord(ctx, count, record)
+	k.setPriceHistoryCount(ctx, record.MarketID, count+1)
+}
+
+// RecordCurrentPrice adds the current price of a market to its price history, accumulating the previous price
+// for the time it was held. Markets without a valid current price are not recorded. A market has at most one
+// record in each record interval, so recording in the interval of the latest record moves it to the current
+// block, keeping its cumulative price exact.
+func (k Keeper) RecordCurrentPrice(ctx sdk.Context, marketID string) {
+	currentPrice, err := k.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return
+	}
+	price := currentPrice.Price
+
+	count := k.getPriceHistoryCount(ctx, marketID)
+	if count == 0 {
+		k.AppendPriceRecord(ctx, types.NewPriceRecord(marketID, ctx.BlockTime(), price, sdk.ZeroDec()))
+		return
+	}
+
+	latest, _ := k.getPriceRecord(ctx, marketID, count-1)
+	if latest.InSameInterval(ctx.BlockTime()) {
+		k.setPriceRecord(ctx, count-1, types.NewPriceRecord(marketID, ctx.BlockTime(), price, latest.CumulativePriceAt(ctx.BlockTime())))
+		return
+	}
+	k.AppendPriceRecord(ctx, types.NewPriceRecord(marketID, ctx.BlockTime(), price, latest.CumulativePriceAt(ctx.BlockTime())))
+}
+
+// priceHistoryRange returns the sequence number of the oldest stored record and the number of stored records
+// of a market with count records written
+func priceHistoryRange(count uint64) (oldest uint64, length uint64) {
+	if count > types.PriceHistoryLength {
+		return count - types.PriceHistoryLength, types.PriceHistoryLength
+	}
+	return 0, count
+}
+
+func (k Keeper) getPriceRecord(ctx sdk.Context, marketID string, seq uint64) (types.PriceRecord, bool) {
+	bz := ctx.KVStore(k.key).Get(types.PriceRecordKey(marketID, seq%types.PriceHistoryLength))
+	if bz == nil {
+		return types.PriceRecord{}, false
+	}
+	var record types.PriceRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+func (k Keeper) setPriceRecord(ctx sdk.Context, seq uint64, record types.PriceRecord) {
+	ctx.KVStore(k.key).Set(types.PriceRecordKey(record.MarketID, seq%types.PriceHistoryLength), k.cdc.MustMarshal(&record))
+}
+
+func (k Keeper) getPriceHistoryCount(ctx sdk.Context, marketID string) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.PriceHistoryCountKey(marketID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setPriceHistoryCount(ctx sdk.Context, marketID string, count uint64) {
+	ctx.KVStore(k.key).Set(types.PriceHistoryCountKey(marketID), sdk.Uint64ToBigEndian(count))
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// setAndRecordPrice updates the current price of a market and adds it to the price history, as in the end blocker
+func setAndRecordPrice(t *testing.T, ctx sdk.Context, k keeper.Keeper, marketID string) {
+	require.NoError(t, k.SetCurrentPrices(ctx, marketID))
+	k.RecordCurrentPrice(ctx, marketID)
+}
+
+func TestKeeper_PriceHistory(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	k := tApp.GetPriceFeedKeeper()
+
+	twapMarket := types.NewMarket("tstusd:1m", "tst", "usd", nil, true)
+	twapMarket.TWAPPriceSource = types.NewTWAPPriceSource("tstusd", time.Minute)
+	k.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", addrs, true),
+		twapMarket,
+	}))
+
+	expiry := start.Add(time.Hour)
+	_, err := k.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.0"), expiry)
+	require.NoError(t, err)
+	setAndRecordPrice(t, ctx, k, "tstusd")
+
+	// the twap is not available until the window is covered by the price history
+	_, err = k.GetTWAP(ctx, "tstusd", start.Add(-time.Second), start)
+	require.ErrorIs(t, err, types.ErrPriceHistoryNotFound)
+	require.ErrorIs(t, k.SetCurrentPrices(ctx, "tstusd:1m"), types.ErrNoValidPrice)
+
+	ctx = ctx.WithBlockTime(start.Add(time.Minute))
+	_, err = k.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("3.0"), expiry)
+	require.NoError(t, err)
+	setAndRecordPrice(t, ctx, k, "tstusd")
+
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Minute))
+	setAndRecordPrice(t, ctx, k, "tstusd")
+
+	// recording again in the same block overwrites the price of the block
+	_, err = k.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("5.0"), expiry)
+	require.NoError(t, err)
+	setAndRecordPrice(t, ctx, k, "tstusd")
+
+	require.Equal(t, types.PriceRecords{
+		types.NewPriceRecord("tstusd", start, sdk.MustNewDecFromStr("1.0"), sdk.ZeroDec()),
+		types.NewPriceRecord("tstusd", start.Add(time.Minute), sdk.MustNewDecFromStr("3.0"), sdk.NewDec(60)),
+		types.NewPriceRecord("tstusd", start.Add(2*time.Minute), sdk.MustNewDecFromStr("5.0"), sdk.NewDec(240)),
+	}, k.GetPriceHistory(ctx, "tstusd"))
+
+	twap, err := k.GetTWAP(ctx, "tstusd", start, start.Add(2*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), twap)
+
+	twap, err = k.GetTWAP(ctx, "tstusd", start.Add(30*time.Second), start.Add(90*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), twap)
+
+	_, err = k.GetTWAP(ctx, "tstusd", start.Add(time.Minute), start.Add(time.Minute))
+	require.ErrorIs(t, err, types.ErrInvalidWindow)
+	_, err = k.GetTWAP(ctx, "tstusd", start, start.Add(3*time.Minute))
+	require.ErrorIs(t, err, types.ErrInvalidWindow)
+
+	// twap markets are priced by the twap of their source market over the window
+	setAndRecordPrice(t, ctx, k, "tstusd:1m")
+	price, err := k.GetCurrentPrice(ctx, "tstusd:1m")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3), price.Price)
+
+	// the twap is not available without a valid current price
+	ctx = ctx.WithBlockTime(expiry)
+	require.ErrorIs(t, k.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice)
+	_, err = k.GetTWAP(ctx, "tstusd", start, expiry)
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	require.ErrorIs(t, k.SetCurrentPrices(ctx, "tstusd:1m"), types.ErrNoValidPrice)
+}
+
+func TestKeeper_PriceHistoryLength(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	k := tApp.GetPriceFeedKeeper()
+
+	k.SetParams(ctx, types.NewParams([]types.Market{types.NewMarket("tstusd", "tst", "usd", addrs, true)}))
+	_, err := k.SetPrice(ctx, addrs[0], "tstusd", sdk.OneDec(), start.Add(24*time.Hour))
+	require.NoError(t, err)
+
+	extra := 10
+	for i := 0; i < types.PriceHistoryLength+extra; i++ {
+		ctx = ctx.WithBlockTime(start.Add(time.Duration(i) * types.PriceRecordInterval))
+		setAndRecordPrice(t, ctx, k, "tstusd")
+	}
+
+	// the oldest records are overwritten once the history is full
+	history := k.GetPriceHistory(ctx, "tstusd")
+	require.Len(t, history, types.PriceHistoryLength)
+	require.Equal(t, start.Add(time.Duration(extra)*types.PriceRecordInterval), history[0].Timestamp)
+	require.Equal(t, ctx.BlockTime(), history[len(history)-1].Timestamp)
+
+	_, found := k.GetPriceRecordAt(ctx, "tstusd", start.Add(time.Duration(extra-1)*types.PriceRecordInterval))
+	require.False(t, found)
+	record, found := k.GetPriceRecordAt(ctx, "tstusd", start.Add(time.Duration(extra)*types.PriceRecordInterval+time.Millisecond))
+	require.True(t, found)
+	require.Equal(t, history[0], record)
+
+	twap, err := k.GetTWAP(ctx, "tstusd", history[0].Timestamp, ctx.BlockTime())
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), twap)
+}
+
+func TestKeeper_PriceHistory_RecordInterval(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	// start at the beginning of a record interval
+	start := time.Now().UTC().Truncate(types.PriceRecordInterval)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(start)
+	k := tApp.GetPriceFeedKeeper()
+
+	twapMarket := types.NewMarket("tstusd:twap", "tst", "usd", nil, true)
+	twapMarket.TWAPPriceSource = types.NewTWAPPriceSource("tstusd", types.MaxTWAPWindow)
+	k.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", addrs, true),
+		twapMarket,
+	}))
+	expiry := start.Add(24 * time.Hour)
+
+	// blocks faster than the record interval move the latest record, keeping its cumulative price exact
+	_, err := k.SetPrice(ctx, addrs[0], "tstusd", sdk.NewDec(1), expiry)
+	require.NoError(t, err)
+	setAndRecordPrice(t, ctx, k, "tstusd")
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Second))
+	_, err = k.SetPrice(ctx, addrs[0], "tstusd", sdk.NewDec(4), expiry)
+	require.NoError(t, err)
+	setAndRecordPrice(t, ctx, k, "tstusd")
+	require.Equal(t, types.PriceRecords{
+		types.NewPriceRecord("tstusd", start.Add(2*time.Second), sdk.NewDec(4), sdk.NewDec(2)),
+	}, k.GetPriceHistory(ctx, "tstusd"))
+
+	ctx = ctx.WithBlockTime(start.Add(types.PriceRecordInterval))
+	setAndRecordPrice(t, ctx, k, "tstusd")
+	require.Len(t, k.GetPriceHistory(ctx, "tstusd"), 2)
+
+	// with one second blocks the history still covers the longest twap window
+	for ctx.BlockTime().Before(start.Add(types.MaxTWAPWindow + time.Hour)) {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+		setAndRecordPrice(t, ctx, k, "tstusd")
+	}
+	history := k.GetPriceHistory(ctx, "tstusd")
+	require.Len(t, history, types.PriceHistoryLength)
+	require.False(t, history[0].Timestamp.After(ctx.BlockTime().Add(-types.MaxTWAPWindow)))
+
+	setAndRecordPrice(t, ctx, k, "tstusd:twap")
+	price, err := k.GetCurrentPrice(ctx, "tstusd:twap")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(4), price.Price)
+
+	// windows longer than the history are not covered
+	_, err = k.GetTWAP(ctx, "tstusd", ctx.BlockTime().Add(-types.PriceHistoryLength*types.PriceRecordInterval), ctx.BlockTime())
+	require.ErrorIs(t, err, types.ErrPriceHistoryNotFound)
+}
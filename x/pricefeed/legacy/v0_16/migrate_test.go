@@ -87,7 +87,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "bnb:usd:30",
@@ -96,7 +127,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "atom:usd",
@@ -105,7 +167,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "atom:usd:30",
@@ -114,7 +207,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "akt:usd",
@@ -123,7 +247,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "akt:usd:30",
@@ -132,7 +287,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "luna:usd",
@@ -141,7 +327,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "luna:usd:30",
@@ -150,7 +367,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "osmo:usd",
@@ -159,7 +407,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "osmo:usd:30",
@@ -168,7 +447,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "ust:usd",
@@ -177,7 +487,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				},
 				{
 					"market_id": "ust:usd:30",
@@ -186,7 +527,38 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"pool_price_source": {
+						"pool_id": "",
+						"base_denom": "",
+						"window": "0s",
+						"base_conversion_factor": 0,
+						"quote_conversion_factor": 0
+					},
+					"aggregation": {
+						"min_oracles": 0,
+						"max_deviation": null,
+						"oracle_weights": []
+					},
+					"commit_reveal": {
+						"phase_blocks": "0"
+					},
+					"twap_price_source": {
+						"market_id": "",
+						"window": "0s"
+					},
+					"oracle_monitor": {
+						"update_window": "0s",
+						"max_missed_windows": 0
+					},
+					"derived_price_source": {
+						"terms": []
+					},
+					"circuit_breaker": {
+						"max_block_change": null,
+						"max_window_change": null,
+						"window": "0s"
+					}
 				}
 			]
 		},
@@ -203,7 +575,13 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 				"price": "217.962650000000001782",
 				"expiry": "2022-07-20T00:00:00Z"
 			}
-		]
+		],
+		"price_commits": [],
+		"missed_reveal_counts": [],
+		"price_history": [],
+		"oracle_stats": [],
+		"circuit_breaker_states": [],
+		"feeder_delegations": []
 	}`
 
 	err := s.legacyCdc.UnmarshalJSON([]byte(v15Params), &s.v15genstate)
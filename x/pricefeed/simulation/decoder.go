@@ -1,32 +1,34 @@
 package simulation
 
-// import (
-// 	"bytes"
-// 	"fmt"
+import (
+	"bytes"
+	"fmt"
 
-// 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 
-// 	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
 
-// 	"github.com/kava-labs/kava/x/pricefeed/types"
-// )
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding pricefeed type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.CurrentPricePrefix):
+			var priceA, priceB types.CurrentPrice
+			cdc.MustUnmarshal(kvA.Value, &priceA)
+			cdc.MustUnmarshal(kvB.Value, &priceB)
+			return fmt.Sprintf("%s\n%s", priceA, priceB)
 
-// // DecodeStore unmarshals the KVPair's Value to the corresponding pricefeed type
-// func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
-// 	switch {
-// 	case bytes.Contains(kvA.Key, []byte(types.CurrentPricePrefix)):
-// 		var priceA, priceB types.CurrentPrice
-// 		cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
-// 		cdc.MustUnmarshalBinaryBare(kvB.Value, &priceB)
-// 		return fmt.Sprintf("%s\n%s", priceA, priceB)
+		case bytes.Equal(kvA.Key[:1], types.RawPriceFeedPrefix):
+			var postedPriceA, postedPriceB types.PostedPrice
+			cdc.MustUnmarshal(kvA.Value, &postedPriceA)
+			cdc.MustUnmarshal(kvB.Value, &postedPriceB)
+			return fmt.Sprintf("%s\n%s", postedPriceA, postedPriceB)
 
-// 	case bytes.Contains(kvA.Key, []byte(types.RawPriceFeedPrefix)):
-// 		var postedPriceA, postedPriceB []types.PostedPrice
-// 		cdc.MustUnmarshalBinaryBare(kvA.Value, &postedPriceA)
-// 		cdc.MustUnmarshalBinaryBare(kvB.Value, &postedPriceB)
-// 		return fmt.Sprintf("%s\n%s", postedPriceA, postedPriceB)
-
-// 	default:
-// 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
-// 	}
-// }
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
@@ -1,73 +1,74 @@
 package simulation
 
-// import (
-// 	"fmt"
-// 	"time"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
 
-// 	"github.com/cosmos/cosmos-sdk/codec"
-// 	sdk "github.com/cosmos/cosmos-sdk/types"
-// 	"github.com/cosmos/cosmos-sdk/types/module"
-// 	"github.com/cosmos/cosmos-sdk/x/simulation"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
-// 	"github.com/kava-labs/kava/x/pricefeed/types"
-// 	pricefeed "github.com/kava-labs/kava/x/pricefeed/types"
-// )
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
 
-// var (
-// 	// BaseAssets is a list of collateral asset denoms
-// 	BaseAssets = [3]string{"bnb", "xrp", "btc"}
-// 	QuoteAsset = "usd"
-// )
+var (
+	// BaseAssets is a list of collateral asset denoms
+	BaseAssets = [3]string{"bnb", "xrp", "btc"}
+	QuoteAsset = "usd"
+)
 
-// // RandomizedGenState generates a random GenesisState for pricefeed
-// func RandomizedGenState(simState *module.SimulationState) {
-// 	pricefeedGenesis := loadPricefeedGenState(simState)
-// 	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, pricefeedGenesis))
-// 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(pricefeedGenesis)
-// }
+// RandomizedGenState generates a random GenesisState for pricefeed
+func RandomizedGenState(simState *module.SimulationState) {
+	pricefeedGenesis := loadPricefeedGenState(simState)
+	if err := pricefeedGenesis.Validate(); err != nil {
+		panic(err)
+	}
 
-// // loadPricefeedGenState loads a valid pricefeed gen state
-// func loadPricefeedGenState(simState *module.SimulationState) pricefeed.GenesisState {
-// 	var markets []pricefeed.Market
-// 	var postedPrices []pricefeed.PostedPrice
-// 	for _, denom := range BaseAssets {
-// 		// Select an account to be the oracle
-// 		oracle, _ := simulation.RandomAcc(simState.Rand, simState.Accounts)
+	bz, err := json.MarshalIndent(&pricefeedGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&pricefeedGenesis)
+}
 
-// 		marketID := fmt.Sprintf("%s:%s", denom, QuoteAsset)
-// 		// Construct market for asset
-// 		market := pricefeed.Market{
-// 			MarketID:   marketID,
-// 			BaseAsset:  denom,
-// 			QuoteAsset: QuoteAsset,
-// 			Oracles:    []sdk.AccAddress{oracle.Address},
-// 			Active:     true,
-// 		}
+// loadPricefeedGenState loads a valid pricefeed gen state
+func loadPricefeedGenState(simState *module.SimulationState) types.GenesisState {
+	var markets []types.Market
+	var postedPrices []types.PostedPrice
+	for _, denom := range BaseAssets {
+		// Select an account to be the oracle
+		oracle, _ := simtypes.RandomAcc(simState.Rand, simState.Accounts)
 
-// 		// Construct posted price for asset
-// 		postedPrice := pricefeed.PostedPrice{
-// 			MarketID:      market.MarketId,
-// 			OracleAddress: oracle.Address,
-// 			Price:         getInitialPrice(marketID),
-// 			Expiry:        simState.GenTimestamp.Add(time.Hour * 24),
-// 		}
-// 		markets = append(markets, market)
-// 		postedPrices = append(postedPrices, postedPrice)
-// 	}
-// 	params := pricefeed.NewParams(markets)
-// 	return pricefeed.NewGenesisState(params, postedPrices)
-// }
+		marketID := fmt.Sprintf("%s:%s", denom, QuoteAsset)
+		// Construct market for asset
+		market := types.NewMarket(marketID, denom, QuoteAsset, []sdk.AccAddress{oracle.Address}, true)
 
-// // getInitialPrice gets the starting price for each of the base assets
-// func getInitialPrice(marketID string) (price sdk.Dec) {
-// 	switch marketID {
-// 	case "btc:usd":
-// 		return sdk.MustNewDecFromStr("7000")
-// 	case "bnb:usd":
-// 		return sdk.MustNewDecFromStr("14")
-// 	case "xrp:usd":
-// 		return sdk.MustNewDecFromStr("0.2")
-// 	default:
-// 		return sdk.MustNewDecFromStr("20") // Catch future additional assets
-// 	}
-// }
+		// Construct posted price for asset
+		postedPrice := types.NewPostedPrice(
+			market.MarketID,
+			oracle.Address,
+			getInitialPrice(marketID),
+			simState.GenTimestamp.Add(time.Hour*24),
+		)
+		markets = append(markets, market)
+		postedPrices = append(postedPrices, postedPrice)
+	}
+	params := types.NewParams(markets)
+	return types.NewGenesisState(params, postedPrices, []types.PriceCommit{}, []types.MissedRevealCount{}, []types.PriceRecord{}, []types.OracleStats{}, []types.CircuitBreakerState{}, []types.FeederDelegation{})
+}
+
+// getInitialPrice gets the starting price for each of the base assets
+func getInitialPrice(marketID string) (price sdk.Dec) {
+	switch marketID {
+	case "btc:usd":
+		return sdk.MustNewDecFromStr("7000")
+	case "bnb:usd":
+		return sdk.MustNewDecFromStr("15")
+	case "xrp:usd":
+		return sdk.MustNewDecFromStr("0.25")
+	default:
+		return sdk.MustNewDecFromStr("20") // Catch future additional assets
+	}
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCircuitBreaker returns a new CircuitBreaker
+func NewCircuitBreaker(maxBlockChange, maxWindowChange *sdk.Dec, window time.Duration) CircuitBreaker {
+	return CircuitBreaker{
+		MaxBlockChange:  maxBlockChange,
+		MaxWindowChange: maxWindowChange,
+		Window:          window,
+	}
+}
+
+// IsEnabled returns true if the market is halted when its price moves too far
+func (cb CircuitBreaker) IsEnabled() bool {
+	return cb.HasMaxBlockChange() || cb.HasMaxWindowChange()
+}
+
+// HasMaxBlockChange returns true if the change of the price in a single update is limited
+func (cb CircuitBreaker) HasMaxBlockChange() bool {
+	return cb.MaxBlockChange != nil && cb.MaxBlockChange.IsPositive()
+}
+
+// HasMaxWindowChange returns true if the change of the price over the window is limited
+func (cb CircuitBreaker) HasMaxWindowChange() bool {
+	return cb.MaxWindowChange != nil && cb.MaxWindowChange.IsPositive()
+}
+
+// Validate performs a basic validation of the circuit breaker
+func (cb CircuitBreaker) Validate() error {
+	if err := validateMaxChange("max block change", cb.MaxBlockChange); err != nil {
+		return err
+	}
+	if err := validateMaxChange("max window change", cb.MaxWindowChange); err != nil {
+		return err
+	}
+	if cb.Window < 0 {
+		return fmt.Errorf("window cannot be negative: %s", cb.Window)
+	}
+	if cb.IsEnabled() && cb.Window == 0 {
+		return errors.New("window must be positive when a max change is set")
+	}
+	return nil
+}
+
+// ExceedsBlockChange returns true if the change from the previous price to a price is past the max block change
+func (cb CircuitBreaker) ExceedsBlockChange(prevPrice, price sdk.Dec) bool {
+	return cb.HasMaxBlockChange() && exceedsChange(prevPrice, price, *cb.MaxBlockChange)
+}
+
+// ExceedsWindowChange returns true if the change from the price at the start of the window to a price is past the
+// max window change
+func (cb CircuitBreaker) ExceedsWindowChange(windowPrice, price sdk.Dec) bool {
+	return cb.HasMaxWindowChange() && exceedsChange(windowPrice, price, *cb.MaxWindowChange)
+}
+
+func validateMaxChange(name string, maxChange *sdk.Dec) error {
+	if maxChange == nil {
+		return nil
+	}
+	if maxChange.IsNil() {
+		return fmt.Errorf("%s cannot be nil", name)
+	}
+	if maxChange.IsNegative() {
+		return fmt.Errorf("%s cannot be negative %s", name, maxChange)
+	}
+	return nil
+}
+
+// exceedsChange returns true if the fractional change from a positive reference price is greater than the max change
+func exceedsChange(reference, price, maxChange sdk.Dec) bool {
+	return reference.IsPositive() && price.Sub(reference).Abs().Quo(reference).GT(maxChange)
+}
+
+// NewCircuitBreakerState returns a new CircuitBreakerState of a market that is not halted, with a window starting
+// at the given time and price
+func NewCircuitBreakerState(marketID string, windowStart time.Time, windowPrice sdk.Dec) CircuitBreakerState {
+	return CircuitBreakerState{
+		MarketID:    marketID,
+		WindowStart: windowStart,
+		WindowPrice: windowPrice,
+	}
+}
+
+// Validate performs a basic validation of a circuit breaker state
+func (s CircuitBreakerState) Validate() error {
+	if strings.TrimSpace(s.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if s.WindowPrice.IsNil() || !s.WindowPrice.IsPositive() {
+		return fmt.Errorf("circuit breaker state for market id %s has invalid window price: %s", s.MarketID, s.WindowPrice)
+	}
+	return nil
+}
+
+// CircuitBreakerStates is a slice of CircuitBreakerState
+type CircuitBreakerStates []CircuitBreakerState
+
+// Validate checks if all the circuit breaker states are valid and there are no duplicated entries.
+func (ss CircuitBreakerStates) Validate() error {
+	seenStates := make(map[string]bool)
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if seenStates[s.MarketID] {
+			return fmt.Errorf("duplicated circuit breaker state for market id %s", s.MarketID)
+		}
+		seenStates[s.MarketID] = true
+	}
+	return nil
+}
@@ -13,11 +13,21 @@ import (
 // governance module.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPostPrice{}, "pricefeed/MsgPostPrice", nil)
+	cdc.RegisterConcrete(&MsgPostPrices{}, "pricefeed/MsgPostPrices", nil)
+	cdc.RegisterConcrete(&MsgCommitPrice{}, "pricefeed/MsgCommitPrice", nil)
+	cdc.RegisterConcrete(&MsgRevealPrice{}, "pricefeed/MsgRevealPrice", nil)
+	cdc.RegisterConcrete(&MsgDelegateFeeder{}, "pricefeed/MsgDelegateFeeder", nil)
+	cdc.RegisterConcrete(&MsgRevokeFeeder{}, "pricefeed/MsgRevokeFeeder", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPostPrice{},
+		&MsgPostPrices{},
+		&MsgCommitPrice{},
+		&MsgRevealPrice{},
+		&MsgDelegateFeeder{},
+		&MsgRevokeFeeder{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxCommitRevealPhaseBlocks is the maximum number of blocks of a commit or reveal phase
+const MaxCommitRevealPhaseBlocks = 100_000
+
+// NewCommitReveal returns a new CommitReveal
+func NewCommitReveal(phaseBlocks uint64) CommitReveal {
+	return CommitReveal{
+		PhaseBlocks: phaseBlocks,
+	}
+}
+
+// IsEnabled returns true if oracles must commit to and reveal prices instead of posting them directly
+func (c CommitReveal) IsEnabled() bool {
+	return c.PhaseBlocks != 0
+}
+
+// Validate performs a basic validation of the commit-reveal mode
+func (c CommitReveal) Validate() error {
+	if c.PhaseBlocks > MaxCommitRevealPhaseBlocks {
+		return fmt.Errorf("phase blocks cannot be greater than %d: %d", MaxCommitRevealPhaseBlocks, c.PhaseBlocks)
+	}
+	return nil
+}
+
+// roundBlocks returns the number of blocks of a round, made of a commit phase followed by a reveal phase
+func (c CommitReveal) roundBlocks() uint64 {
+	return 2 * c.PhaseBlocks
+}
+
+// Round returns the round of a block height. It panics if commit-reveal is not enabled.
+func (c CommitReveal) Round(height int64) uint64 {
+	return uint64(height) / c.roundBlocks()
+}
+
+// IsCommitPhase returns true if a block height is in the commit phase of its round, and false if it is in the
+// reveal phase. It panics if commit-reveal is not enabled.
+func (c CommitReveal) IsCommitPhase(height int64) bool {
+	return uint64(height)%c.roundBlocks() < c.PhaseBlocks
+}
+
+// IsRoundEnd returns true if a block height is the last block of its round. It panics if commit-reveal is not
+// enabled.
+func (c CommitReveal) IsRoundEnd(height int64) bool {
+	return (uint64(height)+1)%c.roundBlocks() == 0
+}
+
+// PriceCommitHash returns the hash an oracle commits to before revealing a price. The market and oracle are
+// included so that a commit can not be copied by another oracle.
+func PriceCommitHash(marketID string, oracle sdk.AccAddress, price sdk.Dec, expiry time.Time, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%s:%s:%d:%s", marketID, oracle, price, expiry.Unix(), salt)))
+	return hash[:]
+}
+
+// NewPriceCommit returns a new unrevealed PriceCommit
+func NewPriceCommit(marketID string, oracle sdk.AccAddress, hash []byte, round uint64) PriceCommit {
+	return PriceCommit{
+		MarketID:      marketID,
+		OracleAddress: oracle,
+		Hash:          hash,
+		Round:         round,
+		Price:         sdk.ZeroDec(),
+	}
+}
+
+// Reveal returns the commit with its price revealed
+func (pc PriceCommit) Reveal(price sdk.Dec, expiry time.Time) PriceCommit {
+	pc.Revealed = true
+	pc.Price = price
+	pc.Expiry = expiry
+	return pc
+}
+
+// Validate performs a basic validation of a price commit
+func (pc PriceCommit) Validate() error {
+	if strings.TrimSpace(pc.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(pc.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if len(pc.Hash) != sha256.Size {
+		return fmt.Errorf("hash must be %d bytes, got %d", sha256.Size, len(pc.Hash))
+	}
+	if pc.Revealed {
+		if pc.Price.IsNil() || !pc.Price.IsPositive() {
+			return fmt.Errorf("revealed price must be positive: %s", pc.Price)
+		}
+		if pc.Expiry.Unix() <= 0 {
+			return errors.New("revealed expiry cannot be zero")
+		}
+	}
+	return nil
+}
+
+// PriceCommits is a slice of PriceCommit
+type PriceCommits []PriceCommit
+
+// Validate checks if all the price commits are valid and there are no duplicated entries.
+func (pcs PriceCommits) Validate() error {
+	seenCommits := make(map[string]bool)
+	for _, pc := range pcs {
+		if err := pc.Validate(); err != nil {
+			return err
+		}
+		if seenCommits[pc.MarketID+pc.OracleAddress.String()] {
+			return fmt.Errorf("duplicated price commit for market id %s and oracle address %s", pc.MarketID, pc.OracleAddress)
+		}
+		seenCommits[pc.MarketID+pc.OracleAddress.String()] = true
+	}
+	return nil
+}
+
+// NewMissedRevealCount returns a new MissedRevealCount
+func NewMissedRevealCount(marketID string, oracle sdk.AccAddress, count uint64) MissedRevealCount {
+	return MissedRevealCount{
+		MarketID:      marketID,
+		OracleAddress: oracle,
+		Count:         count,
+	}
+}
+
+// Validate performs a basic validation of a missed reveal count
+func (mr MissedRevealCount) Validate() error {
+	if strings.TrimSpace(mr.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(mr.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if mr.Count == 0 {
+		return fmt.Errorf("missed reveal count for market id %s and oracle address %s cannot be zero", mr.MarketID, mr.OracleAddress)
+	}
+	return nil
+}
+
+// MissedRevealCounts is a slice of MissedRevealCount
+type MissedRevealCounts []MissedRevealCount
+
+// Validate checks if all the missed reveal counts are valid and there are no duplicated entries.
+func (mrs MissedRevealCounts) Validate() error {
+	seenCounts := make(map[string]bool)
+	for _, mr := range mrs {
+		if err := mr.Validate(); err != nil {
+			return err
+		}
+		if seenCounts[mr.MarketID+mr.OracleAddress.String()] {
+			return fmt.Errorf("duplicated missed reveal count for market id %s and oracle address %s", mr.MarketID, mr.OracleAddress)
+		}
+		seenCounts[mr.MarketID+mr.OracleAddress.String()] = true
+	}
+	return nil
+}
+
+// MissedRevealCountResponses is a slice of MissedRevealCountResponse
+type MissedRevealCountResponses []MissedRevealCountResponse
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDerivedPriceSource returns a new DerivedPriceSource
+func NewDerivedPriceSource(terms ...DerivedPriceTerm) DerivedPriceSource {
+	return DerivedPriceSource{
+		Terms: terms,
+	}
+}
+
+// NewDerivedPriceTerm returns a new DerivedPriceTerm
+func NewDerivedPriceTerm(marketID string, inverse bool) DerivedPriceTerm {
+	return DerivedPriceTerm{
+		MarketID: marketID,
+		Inverse:  inverse,
+	}
+}
+
+// IsEnabled returns true if the market is priced from the prices of other markets
+func (s DerivedPriceSource) IsEnabled() bool {
+	return len(s.Terms) != 0
+}
+
+// Validate performs a basic validation of the derived price source
+func (s DerivedPriceSource) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, term := range s.Terms {
+		if strings.TrimSpace(term.MarketID) == "" {
+			return errors.New("market id cannot be blank")
+		}
+		if seenMarkets[term.MarketID] {
+			return fmt.Errorf("duplicated market %s", term.MarketID)
+		}
+		seenMarkets[term.MarketID] = true
+	}
+	return nil
+}
+
+// Price returns the derived price from the prices of the term markets, in the same order as the terms
+func (s DerivedPriceSource) Price(prices []sdk.Dec) (sdk.Dec, error) {
+	if len(prices) != len(s.Terms) {
+		return sdk.Dec{}, fmt.Errorf("expected %d prices, got %d", len(s.Terms), len(prices))
+	}
+
+	price := sdk.OneDec()
+	for i, term := range s.Terms {
+		if !prices[i].IsPositive() {
+			return sdk.Dec{}, fmt.Errorf("price of market %s must be positive: %s", term.MarketID, prices[i])
+		}
+		if term.Inverse {
+			price = price.Quo(prices[i])
+		} else {
+			price = price.Mul(prices[i])
+		}
+	}
+	if !price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("derived price %s must be positive", price)
+	}
+	return price, nil
+}
+
+// Dependencies returns the ids of the markets the price of a market is calculated from
+func (m Market) Dependencies() []string {
+	var dependencies []string
+	if m.TWAPPriceSource.IsEnabled() {
+		dependencies = append(dependencies, m.TWAPPriceSource.MarketID)
+	}
+	for _, term := range m.DerivedPriceSource.Terms {
+		dependencies = append(dependencies, term.MarketID)
+	}
+	return dependencies
+}
+
+// DependencyOrder returns the markets ordered so that each market comes after the markets its price is
+// calculated from, keeping the order of independent markets. It returns an error if a dependency does not exist
+// or the dependencies form a cycle.
+func (ms Markets) DependencyOrder() (Markets, error) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	markets := make(map[string]Market, len(ms))
+	for _, m := range ms {
+		markets[m.MarketID] = m
+	}
+
+	ordered := make(Markets, 0, len(ms))
+	states := make(map[string]int, len(ms))
+	var path []string
+	var visit func(m Market) error
+	visit = func(m Market) error {
+		switch states[m.MarketID] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("market dependency cycle: %s -> %s", strings.Join(path, " -> "), m.MarketID)
+		}
+
+		states[m.MarketID] = visiting
+		path = append(path, m.MarketID)
+		for _, id := range m.Dependencies() {
+			dependency, found := markets[id]
+			if !found {
+				return fmt.Errorf("market %s depends on market %s that does not exist", m.MarketID, id)
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		states[m.MarketID] = visited
+
+		ordered = append(ordered, m)
+		return nil
+	}
+
+	for _, m := range ms {
+		if err := visit(m); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
@@ -17,4 +17,26 @@ var (
 	ErrInvalidOracle = errorsmod.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = errorsmod.Register(ModuleName, 7, "asset not found")
+	// ErrCommitRevealDisabled error for price commits or reveals for markets without commit-reveal
+	ErrCommitRevealDisabled = errorsmod.Register(ModuleName, 8, "market does not use commit-reveal")
+	// ErrCommitRevealRequired error for posted prices for markets that require commit-reveal
+	ErrCommitRevealRequired = errorsmod.Register(ModuleName, 9, "market requires prices to be committed and revealed")
+	// ErrPriceCommitNotFound error for price reveals without a commit
+	ErrPriceCommitNotFound = errorsmod.Register(ModuleName, 10, "price commit not found")
+	// ErrInvalidPriceReveal error for price reveals that do not match their commit
+	ErrInvalidPriceReveal = errorsmod.Register(ModuleName, 11, "price reveal does not match commit")
+	// ErrRevealWindow error for price reveals outside of the reveal phase of the round of their commit
+	ErrRevealWindow = errorsmod.Register(ModuleName, 12, "price reveal outside of reveal phase")
+	// ErrPriceHistoryNotFound error for time-weighted average prices outside of the stored price history
+	ErrPriceHistoryNotFound = errorsmod.Register(ModuleName, 13, "price history not found")
+	// ErrInvalidWindow error for invalid time-weighted average price windows
+	ErrInvalidWindow = errorsmod.Register(ModuleName, 14, "invalid window")
+	// ErrInvalidFeeder error for feeder delegations to addresses that can not be a feeder
+	ErrInvalidFeeder = errorsmod.Register(ModuleName, 15, "invalid feeder")
+	// ErrFeederDelegationNotFound error for oracles without a feeder delegation
+	ErrFeederDelegationNotFound = errorsmod.Register(ModuleName, 16, "feeder delegation not found")
+	// ErrCommitPhase error for price commits outside of the commit phase of a round
+	ErrCommitPhase = errorsmod.Register(ModuleName, 17, "price commit outside of commit phase")
+	// ErrPriceCommitExists error for price commits of oracles that already committed in the round
+	ErrPriceCommitExists = errorsmod.Register(ModuleName, 18, "price already committed in round")
 )
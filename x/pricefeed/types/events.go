@@ -5,10 +5,22 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeOracleCommitPrice  = "oracle_commit_price"
+	EventTypeOracleMissedReveal = "oracle_missed_reveal"
+	EventTypeOracleSuspended    = "oracle_suspended"
+	EventTypeMarketHalted       = "market_halted"
+	EventTypeMarketResumed      = "market_resumed"
+	EventTypeFeederDelegated    = "feeder_delegated"
+	EventTypeFeederRevoked      = "feeder_revoked"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeCommitHash    = "commit_hash"
+	AttributeMissedReveals = "missed_reveals"
+	AttributeMissedWindows = "missed_windows"
+	AttributeHeldPrice     = "held_price"
+	AttributeFeeder        = "feeder"
 )
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolTWAPSource defines the expected interface for pricing markets from liquidity pools
+type PoolTWAPSource interface {
+	// GetPoolTWAP returns the time-weighted average price of a base unit of the base denom of a pool, in
+	// base units of the other pool denom, over the window ending at the current block time
+	GetPoolTWAP(ctx sdk.Context, poolID string, baseDenom string, window time.Duration) (sdk.Dec, error)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeederDelegation returns a new FeederDelegation
+func NewFeederDelegation(oracle, feeder sdk.AccAddress) FeederDelegation {
+	return FeederDelegation{
+		OracleAddress: oracle,
+		FeederAddress: feeder,
+	}
+}
+
+// Validate performs a basic validation of a feeder delegation
+func (d FeederDelegation) Validate() error {
+	if len(d.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if len(d.FeederAddress) == 0 {
+		return errors.New("feeder address cannot be empty")
+	}
+	if d.OracleAddress.Equals(d.FeederAddress) {
+		return fmt.Errorf("oracle %s cannot be its own feeder", d.OracleAddress)
+	}
+	return nil
+}
+
+// ToResponse returns the query response of the feeder delegation
+func (d FeederDelegation) ToResponse() FeederDelegationResponse {
+	return FeederDelegationResponse{
+		OracleAddress: d.OracleAddress.String(),
+		FeederAddress: d.FeederAddress.String(),
+	}
+}
+
+// FeederDelegations is a slice of FeederDelegation
+type FeederDelegations []FeederDelegation
+
+// Validate checks if all the feeder delegations are valid and each oracle and feeder has at most one delegation.
+func (ds FeederDelegations) Validate() error {
+	seenOracles := make(map[string]bool)
+	seenFeeders := make(map[string]bool)
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if seenOracles[d.OracleAddress.String()] {
+			return fmt.Errorf("duplicated feeder delegation for oracle %s", d.OracleAddress)
+		}
+		if seenFeeders[d.FeederAddress.String()] {
+			return fmt.Errorf("duplicated feeder delegation to feeder %s", d.FeederAddress)
+		}
+		seenOracles[d.OracleAddress.String()] = true
+		seenFeeders[d.FeederAddress.String()] = true
+	}
+	return nil
+}
+
+// FeederDelegationResponses is a slice of FeederDelegationResponse
+type FeederDelegationResponses []FeederDelegationResponse
@@ -1,10 +1,16 @@
 package types
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, pcs []PriceCommit, mrs []MissedRevealCount, prs []PriceRecord, oss []OracleStats, cbs []CircuitBreakerState, fds []FeederDelegation) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:               p,
+		PostedPrices:         pp,
+		PriceCommits:         pcs,
+		MissedRevealCounts:   mrs,
+		PriceHistory:         prs,
+		OracleStats:          oss,
+		CircuitBreakerStates: cbs,
+		FeederDelegations:    fds,
 	}
 }
 
@@ -13,6 +19,12 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		[]PriceCommit{},
+		[]MissedRevealCount{},
+		[]PriceRecord{},
+		[]OracleStats{},
+		[]CircuitBreakerState{},
+		[]FeederDelegation{},
 	)
 }
 
@@ -23,5 +35,29 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PriceCommits.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.MissedRevealCounts.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PriceHistory.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.OracleStats.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.CircuitBreakerStates.Validate(); err != nil {
+		return err
+	}
+
+	return gs.FeederDelegations.Validate()
 }
@@ -26,8 +26,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the pricefeed module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
-	Params       Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PostedPrices PostedPrices `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	Params             Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PostedPrices       PostedPrices       `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	PriceCommits       PriceCommits       `protobuf:"bytes,3,rep,name=price_commits,json=priceCommits,proto3,castrepeated=PriceCommits" json:"price_commits"`
+	MissedRevealCounts MissedRevealCounts `protobuf:"bytes,4,rep,name=missed_reveal_counts,json=missedRevealCounts,proto3,castrepeated=MissedRevealCounts" json:"missed_reveal_counts"`
+	// price_history is the stored price history of each market, in time order
+	PriceHistory PriceRecords `protobuf:"bytes,5,rep,name=price_history,json=priceHistory,proto3,castrepeated=PriceRecords" json:"price_history"`
+	// oracle_stats are the performance statistics of the oracles of each market
+	OracleStats OracleStatsList `protobuf:"bytes,6,rep,name=oracle_stats,json=oracleStats,proto3,castrepeated=OracleStatsList" json:"oracle_stats"`
+	// circuit_breaker_states are the states of the circuit breakers of markets
+	CircuitBreakerStates CircuitBreakerStates `protobuf:"bytes,7,rep,name=circuit_breaker_states,json=circuitBreakerStates,proto3,castrepeated=CircuitBreakerStates" json:"circuit_breaker_states"`
+	// feeder_delegations are the feeder addresses authorized to post prices on behalf of oracles
+	FeederDelegations FeederDelegations `protobuf:"bytes,8,rep,name=feeder_delegations,json=feederDelegations,proto3,castrepeated=FeederDelegations" json:"feeder_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +87,48 @@ func (m *GenesisState) GetPostedPrices() PostedPrices {
 	return nil
 }
 
+func (m *GenesisState) GetPriceCommits() PriceCommits {
+	if m != nil {
+		return m.PriceCommits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedRevealCounts() MissedRevealCounts {
+	if m != nil {
+		return m.MissedRevealCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHistory() PriceRecords {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleStats() OracleStatsList {
+	if m != nil {
+		return m.OracleStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerStates() CircuitBreakerStates {
+	if m != nil {
+		return m.CircuitBreakerStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeederDelegations() FeederDelegations {
+	if m != nil {
+		return m.FeederDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.pricefeed.v1beta1.GenesisState")
 }
@@ -86,24 +138,38 @@ func init() {
 }
 
 var fileDescriptor_fffec798191784d2 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x28, 0xca, 0x4c, 0x4e, 0x4d, 0x4b, 0x4d, 0x4d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xa9, 0xd2, 0x83, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x70, 0x98, 0x59, 0x5c, 0x92, 0x5f, 0x94,
-	0x0a, 0x51, 0xa3, 0xb4, 0x86, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x0d, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x9c, 0x1e, 0x76, 0x3b, 0xf5, 0x02, 0xc0, 0xaa, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xea, 0x11, 0x8a, 0xe3, 0xe2, 0x2d, 0xc8, 0x2f, 0x2e, 0x49, 0x4d, 0x89, 0x07, 0x6b, 0x28,
-	0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0x69, 0x08, 0x58, 0x71, 0x00, 0x48, 0xdc,
-	0x49, 0x04, 0x64, 0xd2, 0xaa, 0xfb, 0xf2, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0x05, 0x48, 0x3c,
-	0x27, 0xdf, 0x07, 0x0f, 0xe5, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0x90, 0xa5, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x60, 0x96, 0x7e, 0x05, 0x52, 0x58, 0x94, 0x54,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf3,
-	0x1e, 0xef, 0x7e, 0x01, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x63, 0x5a, 0x02, 0x72, 0x83, 0x50, 0x47, 0x56, 0x31, 0x11, 0x9a, 0x56, 0x85, 0x45,
+	0x50, 0x85, 0xad, 0x96, 0x2d, 0x2b, 0x07, 0x01, 0x0b, 0x2a, 0x2a, 0xb3, 0x63, 0x81, 0x35, 0x1e,
+	0xbf, 0xba, 0xa3, 0xc6, 0x19, 0x6b, 0xde, 0x24, 0xd0, 0x15, 0x57, 0xe0, 0x18, 0x88, 0x2b, 0x70,
+	0x81, 0x2e, 0xbb, 0x64, 0x05, 0x25, 0xb9, 0x08, 0x9a, 0xb1, 0x69, 0xac, 0x34, 0x06, 0x76, 0x33,
+	0xff, 0xfb, 0xff, 0xff, 0xcb, 0x53, 0x3c, 0xee, 0xa3, 0x53, 0x36, 0x65, 0x61, 0xa9, 0x04, 0x87,
+	0x63, 0x80, 0x2c, 0x9c, 0xee, 0xa7, 0xa0, 0xd9, 0x7e, 0x98, 0xc3, 0x18, 0x50, 0x60, 0x50, 0x2a,
+	0xa9, 0x25, 0xd9, 0x32, 0xae, 0xe0, 0xca, 0x15, 0xd4, 0xae, 0xbe, 0x97, 0xcb, 0x5c, 0x5a, 0x4b,
+	0x68, 0x4e, 0x95, 0xbb, 0xbf, 0xdb, 0xd2, 0x89, 0x5a, 0x2a, 0xa8, 0x3c, 0xbb, 0xdf, 0xba, 0x6e,
+	0xef, 0x65, 0xc5, 0x78, 0xab, 0x99, 0x06, 0xf2, 0xcc, 0xed, 0x96, 0x4c, 0xb1, 0x02, 0x7d, 0x67,
+	0xc7, 0x19, 0x6c, 0x1c, 0xd0, 0x60, 0x35, 0x33, 0x38, 0xb2, 0xae, 0x68, 0xfd, 0xfc, 0xc7, 0x76,
+	0x27, 0xae, 0x33, 0xe4, 0xbd, 0x7b, 0xa7, 0x94, 0xa8, 0x21, 0x4b, 0x6c, 0x00, 0xfd, 0x1b, 0x3b,
+	0x6b, 0x83, 0x8d, 0x83, 0x87, 0xad, 0x25, 0xd6, 0x7c, 0x64, 0xf4, 0xc8, 0x33, 0x4d, 0x5f, 0x7f,
+	0x6e, 0xf7, 0x1a, 0x22, 0xc6, 0xbd, 0xb2, 0x71, 0xb3, 0xfd, 0xe6, 0x94, 0x70, 0x59, 0x14, 0x42,
+	0xa3, 0xbf, 0xf6, 0x8f, 0x7e, 0xa3, 0x0c, 0xad, 0xb7, 0xd1, 0xbf, 0x10, 0x4d, 0x7f, 0xe3, 0x46,
+	0x3e, 0xb8, 0x5e, 0x21, 0x10, 0x21, 0x4b, 0x14, 0x4c, 0x81, 0x8d, 0x12, 0x2e, 0x27, 0x63, 0x8d,
+	0xfe, 0xba, 0xc5, 0x3c, 0x6e, 0xc3, 0x1c, 0xda, 0x4c, 0x6c, 0x23, 0x43, 0x93, 0x88, 0xfa, 0x35,
+	0x8c, 0x5c, 0x1b, 0x61, 0x4c, 0x8a, 0x6b, 0xda, 0x62, 0xb1, 0x13, 0x61, 0xfe, 0x9e, 0x33, 0xff,
+	0xe6, 0x7f, 0x2c, 0x16, 0x03, 0x97, 0x2a, 0x5b, 0x5a, 0xac, 0x12, 0xff, 0x2c, 0xf6, 0xaa, 0xaa,
+	0x23, 0x89, 0xdb, 0x93, 0x8a, 0xf1, 0x11, 0x24, 0xa8, 0x99, 0x46, 0xbf, 0xfb, 0xf7, 0xfa, 0x37,
+	0xd6, 0x6b, 0xbe, 0x08, 0x8c, 0xee, 0xd5, 0xf5, 0x77, 0x1b, 0xe2, 0x6b, 0x81, 0x3a, 0xde, 0x90,
+	0x0b, 0x81, 0x7c, 0x72, 0xb7, 0xb8, 0x50, 0x7c, 0x22, 0x74, 0x92, 0x2a, 0x60, 0xa7, 0xa0, 0x2c,
+	0x09, 0xd0, 0xbf, 0x65, 0x51, 0x7b, 0x6d, 0xa8, 0x61, 0x95, 0x8a, 0xaa, 0x90, 0x29, 0x83, 0xe8,
+	0x41, 0x8d, 0xf4, 0x56, 0x0c, 0x31, 0xf6, 0xf8, 0x0a, 0x95, 0x28, 0x97, 0x98, 0x5e, 0x50, 0x49,
+	0x06, 0x23, 0xc8, 0x99, 0x16, 0x72, 0x8c, 0xfe, 0x6d, 0x0b, 0x1f, 0xb4, 0xc1, 0x5f, 0xd8, 0xc4,
+	0xf3, 0xab, 0x40, 0x74, 0xbf, 0x26, 0x6f, 0x2e, 0x4f, 0x30, 0xde, 0x3c, 0x5e, 0x96, 0xa2, 0xc3,
+	0xcb, 0x5f, 0xd4, 0xf9, 0x32, 0xa3, 0xce, 0xf9, 0x8c, 0x3a, 0x17, 0x33, 0xea, 0x5c, 0xce, 0xa8,
+	0xf3, 0x79, 0x4e, 0x3b, 0x17, 0x73, 0xda, 0xf9, 0x3e, 0xa7, 0x9d, 0x77, 0x7b, 0xb9, 0xd0, 0x27,
+	0x93, 0x34, 0xe0, 0xb2, 0x08, 0xcd, 0x6f, 0x78, 0x32, 0x62, 0x29, 0xda, 0x53, 0xf8, 0xb1, 0xf1,
+	0x34, 0xf5, 0x59, 0x09, 0x98, 0x76, 0xed, 0x9b, 0x7c, 0xfa, 0x7b, 0x00, 0xe1, 0xc6, 0xf9, 0x18,
+	0x0d, 0x04, 0x00, 0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -142,6 +208,54 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("PostedPrices this[%v](%v) Not Equal that[%v](%v)", i, this.PostedPrices[i], i, that1.PostedPrices[i])
 		}
 	}
+	if len(this.PriceCommits) != len(that1.PriceCommits) {
+		return fmt.Errorf("PriceCommits this(%v) Not Equal that(%v)", len(this.PriceCommits), len(that1.PriceCommits))
+	}
+	for i := range this.PriceCommits {
+		if !this.PriceCommits[i].Equal(&that1.PriceCommits[i]) {
+			return fmt.Errorf("PriceCommits this[%v](%v) Not Equal that[%v](%v)", i, this.PriceCommits[i], i, that1.PriceCommits[i])
+		}
+	}
+	if len(this.MissedRevealCounts) != len(that1.MissedRevealCounts) {
+		return fmt.Errorf("MissedRevealCounts this(%v) Not Equal that(%v)", len(this.MissedRevealCounts), len(that1.MissedRevealCounts))
+	}
+	for i := range this.MissedRevealCounts {
+		if !this.MissedRevealCounts[i].Equal(&that1.MissedRevealCounts[i]) {
+			return fmt.Errorf("MissedRevealCounts this[%v](%v) Not Equal that[%v](%v)", i, this.MissedRevealCounts[i], i, that1.MissedRevealCounts[i])
+		}
+	}
+	if len(this.PriceHistory) != len(that1.PriceHistory) {
+		return fmt.Errorf("PriceHistory this(%v) Not Equal that(%v)", len(this.PriceHistory), len(that1.PriceHistory))
+	}
+	for i := range this.PriceHistory {
+		if !this.PriceHistory[i].Equal(&that1.PriceHistory[i]) {
+			return fmt.Errorf("PriceHistory this[%v](%v) Not Equal that[%v](%v)", i, this.PriceHistory[i], i, that1.PriceHistory[i])
+		}
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return fmt.Errorf("OracleStats this(%v) Not Equal that(%v)", len(this.OracleStats), len(that1.OracleStats))
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return fmt.Errorf("OracleStats this[%v](%v) Not Equal that[%v](%v)", i, this.OracleStats[i], i, that1.OracleStats[i])
+		}
+	}
+	if len(this.CircuitBreakerStates) != len(that1.CircuitBreakerStates) {
+		return fmt.Errorf("CircuitBreakerStates this(%v) Not Equal that(%v)", len(this.CircuitBreakerStates), len(that1.CircuitBreakerStates))
+	}
+	for i := range this.CircuitBreakerStates {
+		if !this.CircuitBreakerStates[i].Equal(&that1.CircuitBreakerStates[i]) {
+			return fmt.Errorf("CircuitBreakerStates this[%v](%v) Not Equal that[%v](%v)", i, this.CircuitBreakerStates[i], i, that1.CircuitBreakerStates[i])
+		}
+	}
+	if len(this.FeederDelegations) != len(that1.FeederDelegations) {
+		return fmt.Errorf("FeederDelegations this(%v) Not Equal that(%v)", len(this.FeederDelegations), len(that1.FeederDelegations))
+	}
+	for i := range this.FeederDelegations {
+		if !this.FeederDelegations[i].Equal(&that1.FeederDelegations[i]) {
+			return fmt.Errorf("FeederDelegations this[%v](%v) Not Equal that[%v](%v)", i, this.FeederDelegations[i], i, that1.FeederDelegations[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -174,6 +288,54 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.PriceCommits) != len(that1.PriceCommits) {
+		return false
+	}
+	for i := range this.PriceCommits {
+		if !this.PriceCommits[i].Equal(&that1.PriceCommits[i]) {
+			return false
+		}
+	}
+	if len(this.MissedRevealCounts) != len(that1.MissedRevealCounts) {
+		return false
+	}
+	for i := range this.MissedRevealCounts {
+		if !this.MissedRevealCounts[i].Equal(&that1.MissedRevealCounts[i]) {
+			return false
+		}
+	}
+	if len(this.PriceHistory) != len(that1.PriceHistory) {
+		return false
+	}
+	for i := range this.PriceHistory {
+		if !this.PriceHistory[i].Equal(&that1.PriceHistory[i]) {
+			return false
+		}
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return false
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return false
+		}
+	}
+	if len(this.CircuitBreakerStates) != len(that1.CircuitBreakerStates) {
+		return false
+	}
+	for i := range this.CircuitBreakerStates {
+		if !this.CircuitBreakerStates[i].Equal(&that1.CircuitBreakerStates[i]) {
+			return false
+		}
+	}
+	if len(this.FeederDelegations) != len(that1.FeederDelegations) {
+		return false
+	}
+	for i := range this.FeederDelegations {
+		if !this.FeederDelegations[i].Equal(&that1.FeederDelegations[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +358,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeederDelegations) > 0 {
+		for iNdEx := len(m.FeederDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeederDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CircuitBreakerStates) > 0 {
+		for iNdEx := len(m.CircuitBreakerStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OracleStats) > 0 {
+		for iNdEx := len(m.OracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MissedRevealCounts) > 0 {
+		for iNdEx := len(m.MissedRevealCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedRevealCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PriceCommits) > 0 {
+		for iNdEx := len(m.PriceCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PostedPrices) > 0 {
 		for iNdEx := len(m.PostedPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -248,6 +494,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PriceCommits) > 0 {
+		for _, e := range m.PriceCommits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedRevealCounts) > 0 {
+		for _, e := range m.MissedRevealCounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleStats) > 0 {
+		for _, e := range m.OracleStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerStates) > 0 {
+		for _, e := range m.CircuitBreakerStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeederDelegations) > 0 {
+		for _, e := range m.FeederDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -353,6 +635,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceCommits = append(m.PriceCommits, PriceCommit{})
+			if err := m.PriceCommits[len(m.PriceCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRevealCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedRevealCounts = append(m.MissedRevealCounts, MissedRevealCount{})
+			if err := m.MissedRevealCounts[len(m.MissedRevealCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHistory = append(m.PriceHistory, PriceRecord{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleStats = append(m.OracleStats, OracleStats{})
+			if err := m.OracleStats[len(m.OracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerStates = append(m.CircuitBreakerStates, CircuitBreakerState{})
+			if err := m.CircuitBreakerStates[len(m.CircuitBreakerStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederDelegations = append(m.FeederDelegations, FeederDelegation{})
+			if err := m.FeederDelegations[len(m.FeederDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
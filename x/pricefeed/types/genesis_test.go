@@ -17,6 +17,8 @@ func TestGenesisStateValidate(t *testing.T) {
 	pubkey, err := mockPrivKey.GetPubKey()
 	require.NoError(t, err)
 	addr := sdk.AccAddress(pubkey.Address())
+	addr2 := sdk.AccAddress("oracle 2------------")
+	feeder := sdk.AccAddress("feeder--------------")
 
 	testCases := []struct {
 		msg          string
@@ -32,9 +34,15 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, PoolPriceSource{}, PriceAggregation{}, CommitReveal{}, TWAPPriceSource{}, OracleMonitor{}, DerivedPriceSource{}, CircuitBreaker{}},
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
 			),
 			expPass: true,
 		},
@@ -42,9 +50,15 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true, PoolPriceSource{}, PriceAggregation{}, CommitReveal{}, TWAPPriceSource{}, OracleMonitor{}, DerivedPriceSource{}, CircuitBreaker{}},
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
 			),
 			expPass: false,
 		},
@@ -52,10 +66,16 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, PoolPriceSource{}, PriceAggregation{}, CommitReveal{}, TWAPPriceSource{}, OracleMonitor{}, DerivedPriceSource{}, CircuitBreaker{}},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, PoolPriceSource{}, PriceAggregation{}, CommitReveal{}, TWAPPriceSource{}, OracleMonitor{}, DerivedPriceSource{}, CircuitBreaker{}},
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
 			),
 			expPass: false,
 		},
@@ -64,6 +84,12 @@ func TestGenesisStateValidate(t *testing.T) {
 			genesisState: NewGenesisState(
 				NewParams([]Market{}),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
 			),
 			expPass: false,
 		},
@@ -75,6 +101,291 @@ func TestGenesisStateValidate(t *testing.T) {
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid price commits and missed reveals",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{NewPriceCommit("xrp", addr, PriceCommitHash("xrp", addr, sdk.OneDec(), now, "salt"), 1)},
+				[]MissedRevealCount{NewMissedRevealCount("xrp", addr, 2)},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid price commit hash",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{NewPriceCommit("xrp", addr, []byte("hash"), 1)},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated price commit",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{
+					NewPriceCommit("xrp", addr, PriceCommitHash("xrp", addr, sdk.OneDec(), now, "salt"), 1),
+					NewPriceCommit("xrp", addr, PriceCommitHash("xrp", addr, sdk.OneDec(), now, "salt"), 1),
+				},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "zero missed reveal count",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{NewMissedRevealCount("xrp", addr, 0)},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated missed reveal count",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{NewMissedRevealCount("xrp", addr, 1), NewMissedRevealCount("xrp", addr, 2)},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid price history",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{
+					NewPriceRecord("xrp", now, sdk.OneDec(), sdk.ZeroDec()),
+					NewPriceRecord("bnb", now, sdk.OneDec(), sdk.ZeroDec()),
+					NewPriceRecord("xrp", now.Add(time.Minute), sdk.OneDec(), sdk.NewDec(60)),
+				},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid price record",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{NewPriceRecord("xrp", now, sdk.OneDec().Neg(), sdk.ZeroDec())},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "price history out of order",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{
+					NewPriceRecord("xrp", now.Add(time.Minute), sdk.OneDec(), sdk.NewDec(60)),
+					NewPriceRecord("xrp", now, sdk.OneDec(), sdk.ZeroDec()),
+				},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "decreasing cumulative price",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{
+					NewPriceRecord("xrp", now, sdk.OneDec(), sdk.NewDec(60)),
+					NewPriceRecord("xrp", now.Add(time.Minute), sdk.OneDec(), sdk.ZeroDec()),
+				},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{NewOracleStats("xrp", addr, now)},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid oracle stats deviation",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{{MarketID: "xrp", OracleAddress: addr, WindowStart: now}},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{NewOracleStats("xrp", addr, now), NewOracleStats("xrp", addr, now)},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid circuit breaker state",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{NewCircuitBreakerState("xrp", now, sdk.OneDec())},
+				[]FeederDelegation{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid circuit breaker state window price",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{NewCircuitBreakerState("xrp", now, sdk.ZeroDec())},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated circuit breaker state",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{NewCircuitBreakerState("xrp", now, sdk.OneDec()), NewCircuitBreakerState("xrp", now, sdk.OneDec())},
+				[]FeederDelegation{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid feeder delegation",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{NewFeederDelegation(addr, feeder)},
+			),
+			expPass: true,
+		},
+		{
+			msg: "feeder delegation to the oracle itself",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{NewFeederDelegation(addr, addr)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated feeder delegation oracle",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{NewFeederDelegation(addr, feeder), NewFeederDelegation(addr, addr2)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated feeder delegation feeder",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceCommit{},
+				[]MissedRevealCount{},
+				[]PriceRecord{},
+				[]OracleStats{},
+				[]CircuitBreakerState{},
+				[]FeederDelegation{NewFeederDelegation(addr, feeder), NewFeederDelegation(addr2, feeder)},
 			),
 			expPass: false,
 		},
@@ -25,6 +25,30 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// PriceCommitPrefix prefix for the unrevealed price commits of oracles
+	PriceCommitPrefix = []byte{0x02}
+
+	// MissedRevealCountPrefix prefix for the number of price commits oracles did not reveal
+	MissedRevealCountPrefix = []byte{0x03}
+
+	// PriceHistoryPrefix prefix for the price history ring buffer of a market
+	PriceHistoryPrefix = []byte{0x04}
+
+	// PriceHistoryCountPrefix prefix for the number of price records written for a market
+	PriceHistoryCountPrefix = []byte{0x05}
+
+	// OracleStatsPrefix prefix for the performance statistics of oracles
+	OracleStatsPrefix = []byte{0x06}
+
+	// CircuitBreakerStatePrefix prefix for the circuit breaker states of markets
+	CircuitBreakerStatePrefix = []byte{0x07}
+
+	// FeederDelegationPrefix prefix for the feeder delegations of oracles
+	FeederDelegationPrefix = []byte{0x08}
+
+	// FeederOraclePrefix prefix for the oracles that delegated to each feeder
+	FeederOraclePrefix = []byte{0x09}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -32,6 +56,21 @@ func CurrentPriceKey(marketID string) []byte {
 	return append(CurrentPricePrefix, []byte(marketID)...)
 }
 
+// CircuitBreakerStateKey returns the key for the circuit breaker state of a market
+func CircuitBreakerStateKey(marketID string) []byte {
+	return append(CircuitBreakerStatePrefix, []byte(marketID)...)
+}
+
+// FeederDelegationKey returns the key for the feeder delegation of an oracle
+func FeederDelegationKey(oracle sdk.AccAddress) []byte {
+	return append(FeederDelegationPrefix, oracle...)
+}
+
+// FeederOracleKey returns the key for the oracle that delegated to a feeder
+func FeederOracleKey(feeder sdk.AccAddress) []byte {
+	return append(FeederOraclePrefix, feeder...)
+}
+
 // RawPriceIteratorKey returns the prefix for the raw price for a single market
 func RawPriceIteratorKey(marketID string) []byte {
 	return append(
@@ -48,6 +87,59 @@ func RawPriceKey(marketID string, oracleAddr sdk.AccAddress) []byte {
 	)
 }
 
+// PriceCommitKey returns the key for the price commit of an oracle in a market
+func PriceCommitKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		PriceCommitPrefix,
+		append(lengthPrefixWithByte([]byte(marketID)), lengthPrefixWithByte(oracleAddr)...)...,
+	)
+}
+
+// MissedRevealCountIteratorKey returns the prefix for the missed reveal counts of a single market
+func MissedRevealCountIteratorKey(marketID string) []byte {
+	return append(
+		MissedRevealCountPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// MissedRevealCountKey returns the key for the missed reveal count of an oracle in a market
+func MissedRevealCountKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		MissedRevealCountIteratorKey(marketID),
+		lengthPrefixWithByte(oracleAddr)...,
+	)
+}
+
+// PriceRecordKey returns the key for a slot of the price history ring buffer of a market
+func PriceRecordKey(marketID string, slot uint64) []byte {
+	return append(
+		append(PriceHistoryPrefix, lengthPrefixWithByte([]byte(marketID))...),
+		sdk.Uint64ToBigEndian(slot)...,
+	)
+}
+
+// PriceHistoryCountKey returns the key for the number of price records written for a market
+func PriceHistoryCountKey(marketID string) []byte {
+	return append(PriceHistoryCountPrefix, []byte(marketID)...)
+}
+
+// OracleStatsIteratorKey returns the prefix for the oracle stats of a single market
+func OracleStatsIteratorKey(marketID string) []byte {
+	return append(
+		OracleStatsPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// OracleStatsKey returns the key for the stats of an oracle in a market
+func OracleStatsKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		OracleStatsIteratorKey(marketID),
+		lengthPrefixWithByte(oracleAddr)...,
+	)
+}
+
 // lengthPrefixWithByte returns the input bytes prefixes with one byte containing its length.
 // It panics if the input is greater than 255 in length.
 func lengthPrefixWithByte(bz []byte) []byte {
@@ -3,20 +3,23 @@ package types
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewMarket returns a new Market
 func NewMarket(id, base, quote string, oracles []sdk.AccAddress, active bool) Market {
 	return Market{
-		MarketID:   id,
-		BaseAsset:  base,
-		QuoteAsset: quote,
-		Oracles:    oracles,
-		Active:     active,
+		MarketID:    id,
+		BaseAsset:   base,
+		QuoteAsset:  quote,
+		Oracles:     oracles,
+		Active:      active,
+		Aggregation: DefaultPriceAggregation(),
 	}
 }
 
@@ -41,9 +44,276 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if err := m.PoolPriceSource.Validate(); err != nil {
+		return fmt.Errorf("invalid pool price source: %w", err)
+	}
+	if err := m.Aggregation.Validate(m.Oracles); err != nil {
+		return fmt.Errorf("invalid price aggregation: %w", err)
+	}
+	if err := m.CommitReveal.Validate(); err != nil {
+		return fmt.Errorf("invalid commit reveal: %w", err)
+	}
+	if err := m.TWAPPriceSource.Validate(); err != nil {
+		return fmt.Errorf("invalid twap price source: %w", err)
+	}
+	if m.TWAPPriceSource.MarketID == m.MarketID {
+		return errors.New("twap price source cannot be the market itself")
+	}
+	if m.CommitReveal.IsEnabled() && m.PoolPriceSource.IsEnabled() {
+		return errors.New("commit reveal cannot be enabled for markets priced by a pool")
+	}
+	if m.TWAPPriceSource.IsEnabled() && (m.PoolPriceSource.IsEnabled() || m.CommitReveal.IsEnabled()) {
+		return errors.New("twap price source cannot be combined with a pool price source or commit reveal")
+	}
+	if err := m.OracleMonitor.Validate(); err != nil {
+		return fmt.Errorf("invalid oracle monitor: %w", err)
+	}
+	if m.OracleMonitor.IsEnabled() && (m.PoolPriceSource.IsEnabled() || m.TWAPPriceSource.IsEnabled()) {
+		return errors.New("oracle monitor cannot be enabled for markets that are not priced by oracles")
+	}
+	if err := m.DerivedPriceSource.Validate(); err != nil {
+		return fmt.Errorf("invalid derived price source: %w", err)
+	}
+	if m.DerivedPriceSource.IsEnabled() {
+		if m.PoolPriceSource.IsEnabled() || m.TWAPPriceSource.IsEnabled() || m.CommitReveal.IsEnabled() || m.OracleMonitor.IsEnabled() {
+			return errors.New("derived price source cannot be combined with other price sources, commit reveal or an oracle monitor")
+		}
+		for _, term := range m.DerivedPriceSource.Terms {
+			if term.MarketID == m.MarketID {
+				return errors.New("derived price source cannot include the market itself")
+			}
+		}
+	}
+	if err := m.CircuitBreaker.Validate(); err != nil {
+		return fmt.Errorf("invalid circuit breaker: %w", err)
+	}
+	return nil
+}
+
+// NewTWAPPriceSource returns a new TWAPPriceSource
+func NewTWAPPriceSource(marketID string, window time.Duration) TWAPPriceSource {
+	return TWAPPriceSource{
+		MarketID: marketID,
+		Window:   window,
+	}
+}
+
+// IsEnabled returns true if the market is priced by the time-weighted average price of another market
+func (s TWAPPriceSource) IsEnabled() bool {
+	return s.MarketID != ""
+}
+
+// Validate performs a basic validation of the twap price source
+func (s TWAPPriceSource) Validate() error {
+	if !s.IsEnabled() {
+		if s.Window != 0 {
+			return errors.New("window must be empty without a market id")
+		}
+		return nil
+	}
+	if strings.TrimSpace(s.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if s.Window <= 0 {
+		return fmt.Errorf("window must be positive: %s", s.Window)
+	}
+	if s.Window > MaxTWAPWindow {
+		return fmt.Errorf("window cannot be longer than the price history of %s: %s", MaxTWAPWindow, s.Window)
+	}
 	return nil
 }
 
+// NewPriceAggregation returns a new PriceAggregation
+func NewPriceAggregation(minOracles uint32, maxDeviation *sdk.Dec, oracleWeights []OracleWeight) PriceAggregation {
+	return PriceAggregation{
+		MinOracles:    minOracles,
+		MaxDeviation:  maxDeviation,
+		OracleWeights: oracleWeights,
+	}
+}
+
+// DefaultPriceAggregation returns a PriceAggregation that takes the unweighted median of all valid posted prices
+func DefaultPriceAggregation() PriceAggregation {
+	return NewPriceAggregation(0, nil, nil)
+}
+
+// NewOracleWeight returns a new OracleWeight
+func NewOracleWeight(oracle sdk.AccAddress, weight uint64) OracleWeight {
+	return OracleWeight{
+		Oracle: oracle,
+		Weight: weight,
+	}
+}
+
+// Quorum returns the minimum number of oracles with a valid posted price required to update the price
+func (a PriceAggregation) Quorum() int {
+	if a.MinOracles == 0 {
+		return 1
+	}
+	return int(a.MinOracles)
+}
+
+// HasMaxDeviation returns true if posted prices that deviate too far from the median are dropped
+func (a PriceAggregation) HasMaxDeviation() bool {
+	return a.MaxDeviation != nil && a.MaxDeviation.IsPositive()
+}
+
+// OracleWeight returns the weight of an oracle's posted prices, which is one for oracles without a weight
+func (a PriceAggregation) OracleWeight(oracle sdk.AccAddress) uint64 {
+	for _, w := range a.OracleWeights {
+		if w.Oracle.Equals(oracle) {
+			return w.Weight
+		}
+	}
+	return 1
+}
+
+// MaxOracleWeight is the maximum weight of an oracle, which keeps the total weight of the oracles of a market far
+// from overflowing when taking the weighted median
+const MaxOracleWeight = 1_000_000
+
+// Validate performs a basic validation of the price aggregation against the oracles of its market
+func (a PriceAggregation) Validate(oracles []sdk.AccAddress) error {
+	if len(oracles) > 0 && int(a.MinOracles) > len(oracles) {
+		return fmt.Errorf("min oracles %d is greater than the number of oracles %d", a.MinOracles, len(oracles))
+	}
+	if a.MaxDeviation != nil {
+		if a.MaxDeviation.IsNil() {
+			return errors.New("max deviation cannot be nil")
+		}
+		if a.MaxDeviation.IsNegative() {
+			return fmt.Errorf("max deviation cannot be negative %s", a.MaxDeviation)
+		}
+	}
+	isOracle := make(map[string]bool)
+	for _, oracle := range oracles {
+		isOracle[oracle.String()] = true
+	}
+	seenWeights := make(map[string]bool)
+	for _, w := range a.OracleWeights {
+		if !isOracle[w.Oracle.String()] {
+			return fmt.Errorf("weighted oracle %s is not an oracle of the market", w.Oracle)
+		}
+		if seenWeights[w.Oracle.String()] {
+			return fmt.Errorf("duplicated oracle weight %s", w.Oracle)
+		}
+		if w.Weight == 0 {
+			return fmt.Errorf("weight of oracle %s must be positive", w.Oracle)
+		}
+		if w.Weight > MaxOracleWeight {
+			return fmt.Errorf("weight of oracle %s cannot be greater than %d: %d", w.Oracle, MaxOracleWeight, w.Weight)
+		}
+		seenWeights[w.Oracle.String()] = true
+	}
+	return nil
+}
+
+// Aggregate combines posted prices into a single price. The weighted median of the prices is taken, then prices
+// that deviate from it by more than the max deviation are dropped and the median is taken again. An error is
+// returned if fewer prices than the quorum remain.
+func (a PriceAggregation) Aggregate(prices PostedPrices) (sdk.Dec, error) {
+	if len(prices) < a.Quorum() {
+		return sdk.Dec{}, fmt.Errorf("%d valid prices is below the minimum of %d oracles", len(prices), a.Quorum())
+	}
+	median := a.weightedMedian(prices)
+	if !a.HasMaxDeviation() || !median.IsPositive() {
+		return median, nil
+	}
+
+	var inliers PostedPrices
+	for _, pp := range prices {
+		if pp.Price.Sub(median).Abs().Quo(median).LTE(*a.MaxDeviation) {
+			inliers = append(inliers, pp)
+		}
+	}
+	if len(inliers) < a.Quorum() {
+		return sdk.Dec{}, fmt.Errorf(
+			"%d valid prices within %s of the median %s is below the minimum of %d oracles",
+			len(inliers), a.MaxDeviation, median, a.Quorum(),
+		)
+	}
+	return a.weightedMedian(inliers), nil
+}
+
+// weightedMedian returns the price at which half of the total oracle weight is reached, taking the mean of the two
+// middle prices when the weight is split exactly in half. With equal weights this is the ordinary median.
+func (a PriceAggregation) weightedMedian(prices PostedPrices) sdk.Dec {
+	sorted := make(PostedPrices, len(prices))
+	copy(sorted, prices)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Price.LT(sorted[j].Price)
+	})
+
+	weights := make([]uint64, len(sorted))
+	var total uint64
+	for i, pp := range sorted {
+		weights[i] = a.OracleWeight(pp.OracleAddress)
+		total += weights[i]
+	}
+
+	var cumulative uint64
+	for i, pp := range sorted {
+		cumulative += weights[i]
+		if cumulative*2 == total && i+1 < len(sorted) {
+			return pp.Price.Add(sorted[i+1].Price).QuoInt64(2)
+		}
+		if cumulative*2 >= total {
+			return pp.Price
+		}
+	}
+	return sorted[len(sorted)-1].Price
+}
+
+// MaxPoolConversionFactor is the maximum number of decimals of a pool price source denom
+const MaxPoolConversionFactor = 18
+
+// NewPoolPriceSource returns a new PoolPriceSource
+func NewPoolPriceSource(poolID, baseDenom string, window time.Duration, baseConversionFactor, quoteConversionFactor uint32) PoolPriceSource {
+	return PoolPriceSource{
+		PoolID:                poolID,
+		BaseDenom:             baseDenom,
+		Window:                window,
+		BaseConversionFactor:  baseConversionFactor,
+		QuoteConversionFactor: quoteConversionFactor,
+	}
+}
+
+// IsEnabled returns true if the market is priced by a pool instead of oracle posted prices
+func (s PoolPriceSource) IsEnabled() bool {
+	return s.PoolID != ""
+}
+
+// Validate performs a basic validation of the pool price source
+func (s PoolPriceSource) Validate() error {
+	if !s.IsEnabled() {
+		if s.BaseDenom != "" || s.Window != 0 || s.BaseConversionFactor != 0 || s.QuoteConversionFactor != 0 {
+			return errors.New("base denom, window and conversion factors must be empty without a pool id")
+		}
+		return nil
+	}
+	if strings.TrimSpace(s.PoolID) == "" {
+		return errors.New("pool id cannot be blank")
+	}
+	if err := sdk.ValidateDenom(s.BaseDenom); err != nil {
+		return fmt.Errorf("invalid base denom: %w", err)
+	}
+	if s.Window <= 0 {
+		return fmt.Errorf("window must be positive: %s", s.Window)
+	}
+	if s.BaseConversionFactor > MaxPoolConversionFactor || s.QuoteConversionFactor > MaxPoolConversionFactor {
+		return fmt.Errorf("conversion factors cannot be greater than %d: %d, %d", MaxPoolConversionFactor, s.BaseConversionFactor, s.QuoteConversionFactor)
+	}
+	return nil
+}
+
+// ConvertPrice converts a pool price of the base denom in base units of the other pool denom per base unit into
+// a price of a whole base token in whole tokens of the other pool denom
+func (s PoolPriceSource) ConvertPrice(price sdk.Dec) sdk.Dec {
+	baseFactor := sdk.NewDecFromInt(sdkmath.NewIntWithDecimal(1, int(s.BaseConversionFactor)))
+	quoteFactor := sdk.NewDecFromInt(sdkmath.NewIntWithDecimal(1, int(s.QuoteConversionFactor)))
+	return price.Mul(baseFactor).Quo(quoteFactor)
+}
+
 // ToMarketResponse returns a new MarketResponse from a Market
 func (m Market) ToMarketResponse() MarketResponse {
 	return NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
@@ -55,15 +325,32 @@ type Markets []Market
 // Validate checks if all the markets are valid and there are no duplicated
 // entries.
 func (ms Markets) Validate() error {
-	seenMarkets := make(map[string]bool)
+	seenMarkets := make(map[string]Market)
 	for _, m := range ms {
-		if seenMarkets[m.MarketID] {
+		if _, found := seenMarkets[m.MarketID]; found {
 			return fmt.Errorf("duplicated market %s", m.MarketID)
 		}
 		if err := m.Validate(); err != nil {
 			return err
 		}
-		seenMarkets[m.MarketID] = true
+		seenMarkets[m.MarketID] = m
+	}
+	// twap sources must be markets that are not themselves priced by a twap
+	for _, m := range ms {
+		if !m.TWAPPriceSource.IsEnabled() {
+			continue
+		}
+		source, found := seenMarkets[m.TWAPPriceSource.MarketID]
+		if !found {
+			return fmt.Errorf("twap price source %s of market %s does not exist", m.TWAPPriceSource.MarketID, m.MarketID)
+		}
+		if source.TWAPPriceSource.IsEnabled() {
+			return fmt.Errorf("twap price source %s of market %s cannot be priced by a twap", source.MarketID, m.MarketID)
+		}
+	}
+	// markets are updated after the markets they depend on, so there can not be a dependency cycle
+	if _, err := ms.DependencyOrder(); err != nil {
+		return err
 	}
 	return nil
 }
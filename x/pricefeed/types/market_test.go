@@ -16,6 +16,9 @@ func TestMarketValidate(t *testing.T) {
 	pubkey, err := mockPrivKey.GetPubKey()
 	require.NoError(t, err)
 	addr := sdk.AccAddress(pubkey.Address())
+	addr2 := sdk.AccAddress("oracle 2------------")
+	maxDeviation := sdk.MustNewDecFromStr("0.1")
+	negativeDeviation := sdk.MustNewDecFromStr("-0.1")
 
 	testCases := []struct {
 		msg     string
@@ -78,6 +81,401 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid pool price source",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				Oracles:         []sdk.AccAddress{},
+				Active:          true,
+				PoolPriceSource: NewPoolPriceSource("bnb:xrp", "xrp", time.Hour, 6, 8),
+			},
+			true,
+		},
+		{
+			"pool price source missing base denom",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				PoolPriceSource: NewPoolPriceSource("bnb:xrp", "", time.Hour, 6, 8),
+			},
+			false,
+		},
+		{
+			"pool price source zero window",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				PoolPriceSource: NewPoolPriceSource("bnb:xrp", "xrp", 0, 6, 8),
+			},
+			false,
+		},
+		{
+			"pool price source conversion factor too large",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				PoolPriceSource: NewPoolPriceSource("bnb:xrp", "xrp", time.Hour, MaxPoolConversionFactor+1, 8),
+			},
+			false,
+		},
+		{
+			"disabled pool price source with conversion factor",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				PoolPriceSource: PoolPriceSource{QuoteConversionFactor: 6},
+			},
+			false,
+		},
+		{
+			"disabled pool price source with window",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				PoolPriceSource: PoolPriceSource{Window: time.Hour},
+			},
+			false,
+		},
+		{
+			"valid price aggregation",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Oracles:     []sdk.AccAddress{addr, addr2},
+				Aggregation: NewPriceAggregation(2, &maxDeviation, []OracleWeight{NewOracleWeight(addr, 3)}),
+			},
+			true,
+		},
+		{
+			"min oracles greater than oracles",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Oracles:     []sdk.AccAddress{addr},
+				Aggregation: NewPriceAggregation(2, nil, nil),
+			},
+			false,
+		},
+		{
+			"negative max deviation",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Oracles:     []sdk.AccAddress{addr},
+				Aggregation: NewPriceAggregation(0, &negativeDeviation, nil),
+			},
+			false,
+		},
+		{
+			"weighted oracle not in market",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Oracles:     []sdk.AccAddress{addr},
+				Aggregation: NewPriceAggregation(0, nil, []OracleWeight{NewOracleWeight(addr2, 1)}),
+			},
+			false,
+		},
+		{
+			"duplicated oracle weight",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Oracles:     []sdk.AccAddress{addr},
+				Aggregation: NewPriceAggregation(0, nil, []OracleWeight{NewOracleWeight(addr, 1), NewOracleWeight(addr, 2)}),
+			},
+			false,
+		},
+		{
+			"valid commit reveal",
+			Market{
+				MarketID:     "market",
+				BaseAsset:    "xrp",
+				QuoteAsset:   "bnb",
+				Oracles:      []sdk.AccAddress{addr},
+				CommitReveal: NewCommitReveal(10),
+			},
+			true,
+		},
+		{
+			"too many commit reveal phase blocks",
+			Market{
+				MarketID:     "market",
+				BaseAsset:    "xrp",
+				QuoteAsset:   "bnb",
+				Oracles:      []sdk.AccAddress{addr},
+				CommitReveal: NewCommitReveal(MaxCommitRevealPhaseBlocks + 1),
+			},
+			false,
+		},
+		{
+			"commit reveal with pool price source",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				PoolPriceSource: NewPoolPriceSource("bnb:xrp", "xrp", time.Hour, 6, 8),
+				CommitReveal:    NewCommitReveal(10),
+			},
+			false,
+		},
+		{
+			"zero oracle weight",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Oracles:     []sdk.AccAddress{addr},
+				Aggregation: NewPriceAggregation(0, nil, []OracleWeight{NewOracleWeight(addr, 0)}),
+			},
+			false,
+		},
+		{
+			"oracle weight too large",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Oracles:     []sdk.AccAddress{addr},
+				Aggregation: NewPriceAggregation(0, nil, []OracleWeight{NewOracleWeight(addr, MaxOracleWeight+1)}),
+			},
+			false,
+		},
+		{
+			"valid twap price source",
+			Market{
+				MarketID:        "market:30m",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				TWAPPriceSource: NewTWAPPriceSource("market", 30*time.Minute),
+			},
+			true,
+		},
+		{
+			"twap price source window longer than the price history",
+			Market{
+				MarketID:        "market:3h",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				TWAPPriceSource: NewTWAPPriceSource("market", MaxTWAPWindow+time.Second),
+			},
+			false,
+		},
+		{
+			"twap price source without window",
+			Market{
+				MarketID:        "market:30m",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				TWAPPriceSource: NewTWAPPriceSource("market", 0),
+			},
+			false,
+		},
+		{
+			"twap price source window without market",
+			Market{
+				MarketID:        "market:30m",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				TWAPPriceSource: TWAPPriceSource{Window: time.Hour},
+			},
+			false,
+		},
+		{
+			"twap price source of itself",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				TWAPPriceSource: NewTWAPPriceSource("market", time.Hour),
+			},
+			false,
+		},
+		{
+			"twap price source with pool price source",
+			Market{
+				MarketID:        "market:30m",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				PoolPriceSource: NewPoolPriceSource("bnb:xrp", "xrp", time.Hour, 6, 8),
+				TWAPPriceSource: NewTWAPPriceSource("market", time.Hour),
+			},
+			false,
+		},
+		{
+			"valid oracle monitor",
+			Market{
+				MarketID:      "market",
+				BaseAsset:     "xrp",
+				QuoteAsset:    "bnb",
+				Oracles:       []sdk.AccAddress{addr},
+				OracleMonitor: NewOracleMonitor(time.Minute, 3),
+			},
+			true,
+		},
+		{
+			"negative oracle monitor update window",
+			Market{
+				MarketID:      "market",
+				BaseAsset:     "xrp",
+				QuoteAsset:    "bnb",
+				Oracles:       []sdk.AccAddress{addr},
+				OracleMonitor: NewOracleMonitor(-time.Minute, 0),
+			},
+			false,
+		},
+		{
+			"oracle monitor max missed windows without update window",
+			Market{
+				MarketID:      "market",
+				BaseAsset:     "xrp",
+				QuoteAsset:    "bnb",
+				Oracles:       []sdk.AccAddress{addr},
+				OracleMonitor: NewOracleMonitor(0, 3),
+			},
+			false,
+		},
+		{
+			"oracle monitor with pool price source",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				PoolPriceSource: NewPoolPriceSource("bnb:xrp", "xrp", time.Hour, 6, 8),
+				OracleMonitor:   NewOracleMonitor(time.Minute, 3),
+			},
+			false,
+		},
+		{
+			"valid derived price source",
+			Market{
+				MarketID:           "xrp:usd",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "usd",
+				DerivedPriceSource: NewDerivedPriceSource(NewDerivedPriceTerm("xrp:bnb", false), NewDerivedPriceTerm("usd:bnb", true)),
+			},
+			true,
+		},
+		{
+			"derived price source with blank market",
+			Market{
+				MarketID:           "xrp:usd",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "usd",
+				DerivedPriceSource: NewDerivedPriceSource(NewDerivedPriceTerm(" ", false)),
+			},
+			false,
+		},
+		{
+			"derived price source with duplicated market",
+			Market{
+				MarketID:           "xrp:usd",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "usd",
+				DerivedPriceSource: NewDerivedPriceSource(NewDerivedPriceTerm("xrp:bnb", false), NewDerivedPriceTerm("xrp:bnb", true)),
+			},
+			false,
+		},
+		{
+			"derived price source of itself",
+			Market{
+				MarketID:           "xrp:usd",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "usd",
+				DerivedPriceSource: NewDerivedPriceSource(NewDerivedPriceTerm("xrp:usd", false)),
+			},
+			false,
+		},
+		{
+			"derived price source with twap price source",
+			Market{
+				MarketID:           "xrp:usd",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "usd",
+				TWAPPriceSource:    NewTWAPPriceSource("xrp:bnb", time.Hour),
+				DerivedPriceSource: NewDerivedPriceSource(NewDerivedPriceTerm("xrp:bnb", false)),
+			},
+			false,
+		},
+		{
+			"derived price source with oracle monitor",
+			Market{
+				MarketID:           "xrp:usd",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "usd",
+				Oracles:            []sdk.AccAddress{addr},
+				OracleMonitor:      NewOracleMonitor(time.Minute, 3),
+				DerivedPriceSource: NewDerivedPriceSource(NewDerivedPriceTerm("xrp:bnb", false)),
+			},
+			false,
+		},
+		{
+			"valid circuit breaker",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				Oracles:        []sdk.AccAddress{addr},
+				CircuitBreaker: NewCircuitBreaker(&maxDeviation, &maxDeviation, time.Hour),
+			},
+			true,
+		},
+		{
+			"circuit breaker with negative max block change",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				Oracles:        []sdk.AccAddress{addr},
+				CircuitBreaker: NewCircuitBreaker(&negativeDeviation, nil, time.Hour),
+			},
+			false,
+		},
+		{
+			"circuit breaker with negative max window change",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				Oracles:        []sdk.AccAddress{addr},
+				CircuitBreaker: NewCircuitBreaker(nil, &negativeDeviation, time.Hour),
+			},
+			false,
+		},
+		{
+			"circuit breaker without window",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				Oracles:        []sdk.AccAddress{addr},
+				CircuitBreaker: NewCircuitBreaker(&maxDeviation, nil, 0),
+			},
+			false,
+		},
+		{
+			"circuit breaker with negative window",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				Oracles:        []sdk.AccAddress{addr},
+				CircuitBreaker: NewCircuitBreaker(nil, nil, -time.Hour),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -92,6 +490,154 @@ func TestMarketValidate(t *testing.T) {
 	}
 }
 
+func TestMarketsValidateTWAPPriceSource(t *testing.T) {
+	spot := NewMarket("market", "xrp", "bnb", nil, true)
+	twap := NewMarket("market:30m", "xrp", "bnb", nil, true)
+	twap.TWAPPriceSource = NewTWAPPriceSource("market", 30*time.Minute)
+	nestedTWAP := NewMarket("market:1h", "xrp", "bnb", nil, true)
+	nestedTWAP.TWAPPriceSource = NewTWAPPriceSource("market:30m", time.Hour)
+
+	require.NoError(t, Markets{twap, spot}.Validate())
+	require.Error(t, Markets{twap}.Validate(), "source market must exist")
+	require.Error(t, Markets{spot, twap, nestedTWAP}.Validate(), "source market cannot be priced by a twap")
+}
+
+func TestMarketsDependencyOrder(t *testing.T) {
+	xrpBnb := NewMarket("xrp:bnb", "xrp", "bnb", nil, true)
+	usdBnb := NewMarket("usd:bnb", "usd", "bnb", nil, true)
+	xrpUsd := NewMarket("xrp:usd", "xrp", "usd", nil, true)
+	xrpUsd.DerivedPriceSource = NewDerivedPriceSource(NewDerivedPriceTerm("xrp:bnb", false), NewDerivedPriceTerm("usd:bnb", true))
+	xrpUsdTWAP := NewMarket("xrp:usd:30m", "xrp", "usd", nil, true)
+	xrpUsdTWAP.TWAPPriceSource = NewTWAPPriceSource("xrp:usd", 30*time.Minute)
+
+	ordered, err := Markets{xrpUsdTWAP, xrpUsd, xrpBnb, usdBnb}.DependencyOrder()
+	require.NoError(t, err)
+	require.Equal(t, Markets{xrpBnb, usdBnb, xrpUsd, xrpUsdTWAP}, ordered)
+
+	ordered, err = Markets{usdBnb, xrpBnb, xrpUsd}.DependencyOrder()
+	require.NoError(t, err)
+	require.Equal(t, Markets{usdBnb, xrpBnb, xrpUsd}, ordered, "independent markets keep their order")
+
+	_, err = Markets{xrpUsd, xrpBnb}.DependencyOrder()
+	require.EqualError(t, err, "market xrp:usd depends on market usd:bnb that does not exist")
+
+	cyclic := usdBnb
+	cyclic.DerivedPriceSource = NewDerivedPriceSource(NewDerivedPriceTerm("xrp:usd", true), NewDerivedPriceTerm("xrp:bnb", false))
+	_, err = Markets{xrpBnb, cyclic, xrpUsd}.DependencyOrder()
+	require.EqualError(t, err, "market dependency cycle: usd:bnb -> xrp:usd -> usd:bnb")
+	require.Error(t, Markets{xrpBnb, cyclic, xrpUsd}.Validate())
+}
+
+func TestDerivedPriceSourcePrice(t *testing.T) {
+	source := NewDerivedPriceSource(NewDerivedPriceTerm("xrp:bnb", false), NewDerivedPriceTerm("usd:bnb", true))
+
+	price, err := source.Price([]sdk.Dec{sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.25")})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), price)
+
+	_, err = source.Price([]sdk.Dec{sdk.OneDec()})
+	require.Error(t, err, "one price per term")
+	_, err = source.Price([]sdk.Dec{sdk.OneDec(), sdk.ZeroDec()})
+	require.Error(t, err, "prices must be positive")
+	_, err = source.Price([]sdk.Dec{sdk.SmallestDec(), sdk.NewDec(10)})
+	require.Error(t, err, "derived price cannot round to zero")
+}
+
+func TestPriceAggregationAggregate(t *testing.T) {
+	now := time.Now()
+	oracles := []sdk.AccAddress{
+		sdk.AccAddress("oracle 1------------"),
+		sdk.AccAddress("oracle 2------------"),
+		sdk.AccAddress("oracle 3------------"),
+		sdk.AccAddress("oracle 4------------"),
+	}
+	postedPrices := func(prices ...string) PostedPrices {
+		var pps PostedPrices
+		for i, p := range prices {
+			pps = append(pps, NewPostedPrice("market", oracles[i], sdk.MustNewDecFromStr(p), now))
+		}
+		return pps
+	}
+	maxDeviation := sdk.MustNewDecFromStr("0.1")
+
+	testCases := []struct {
+		msg         string
+		aggregation PriceAggregation
+		prices      PostedPrices
+		expPrice    sdk.Dec
+		expErr      string
+	}{
+		{
+			"single price",
+			DefaultPriceAggregation(),
+			postedPrices("1.5"),
+			sdk.MustNewDecFromStr("1.5"),
+			"",
+		},
+		{
+			"median of odd number of prices",
+			DefaultPriceAggregation(),
+			postedPrices("3", "1", "2"),
+			sdk.MustNewDecFromStr("2"),
+			"",
+		},
+		{
+			"mean of middle prices for even number of prices",
+			DefaultPriceAggregation(),
+			postedPrices("4", "1", "2", "3"),
+			sdk.MustNewDecFromStr("2.5"),
+			"",
+		},
+		{
+			"below min oracles",
+			NewPriceAggregation(3, nil, nil),
+			postedPrices("1", "2"),
+			sdk.Dec{},
+			"2 valid prices is below the minimum of 3 oracles",
+		},
+		{
+			"weighted median",
+			NewPriceAggregation(0, nil, []OracleWeight{NewOracleWeight(oracles[2], 3)}),
+			postedPrices("1", "2", "3"),
+			sdk.MustNewDecFromStr("3"),
+			"",
+		},
+		{
+			"weighted median split in half",
+			NewPriceAggregation(0, nil, []OracleWeight{NewOracleWeight(oracles[0], 2)}),
+			postedPrices("1", "2", "3"),
+			sdk.MustNewDecFromStr("1.5"),
+			"",
+		},
+		{
+			"outlier dropped",
+			NewPriceAggregation(0, &maxDeviation, nil),
+			postedPrices("1", "1.05", "0.98", "10"),
+			sdk.MustNewDecFromStr("1"),
+			"",
+		},
+		{
+			"below min oracles after outliers dropped",
+			NewPriceAggregation(3, &maxDeviation, nil),
+			postedPrices("1", "1.05", "2", "2.1"),
+			sdk.Dec{},
+			"0 valid prices within 0.100000000000000000 of the median 1.525000000000000000 is below the minimum of 3 oracles",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			price, err := tc.aggregation.Aggregate(tc.prices)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expPrice, price)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
 func TestPostedPriceValidate(t *testing.T) {
 	now := time.Now()
 	mockPrivKey := tmtypes.NewMockPV()
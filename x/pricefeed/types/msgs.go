@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,13 +15,30 @@ import (
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgPostPrices type of PostPrices msg
+	TypeMsgPostPrices = "post_prices"
+	// TypeMsgCommitPrice type of CommitPrice msg
+	TypeMsgCommitPrice = "commit_price"
+	// TypeMsgRevealPrice type of RevealPrice msg
+	TypeMsgRevealPrice = "reveal_price"
+	// TypeMsgDelegateFeeder type of DelegateFeeder msg
+	TypeMsgDelegateFeeder = "delegate_feeder"
+	// TypeMsgRevokeFeeder type of RevokeFeeder msg
+	TypeMsgRevokeFeeder = "revoke_feeder"
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgPostPrices{}
+	_ sdk.Msg = &MsgCommitPrice{}
+	_ sdk.Msg = &MsgRevealPrice{}
+	_ sdk.Msg = &MsgDelegateFeeder{}
+	_ sdk.Msg = &MsgRevokeFeeder{}
+)
 
 // NewMsgPostPrice returns a new MsgPostPrice
 func NewMsgPostPrice(from string, marketID string, price sdk.Dec, expiry time.Time) *MsgPostPrice {
@@ -69,3 +87,259 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewPriceEntry returns a new PriceEntry
+func NewPriceEntry(marketID string, price sdk.Dec, expiry time.Time) PriceEntry {
+	return PriceEntry{
+		MarketID: marketID,
+		Price:    price,
+		Expiry:   expiry,
+	}
+}
+
+// Validate performs a basic validation of a price entry
+func (e PriceEntry) Validate() error {
+	if strings.TrimSpace(e.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if e.Price.IsNil() || e.Price.IsNegative() {
+		return fmt.Errorf("price cannot be negative: %s", e.Price)
+	}
+	if e.Expiry.Unix() <= 0 {
+		return fmt.Errorf("must set an expiration time for market %s", e.MarketID)
+	}
+	return nil
+}
+
+// PriceEntries is a slice of PriceEntry
+type PriceEntries []PriceEntry
+
+// Validate checks if all the price entries are valid and there is at most one entry for each market.
+func (es PriceEntries) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, e := range es {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		if seenMarkets[e.MarketID] {
+			return fmt.Errorf("duplicated price for market %s", e.MarketID)
+		}
+		seenMarkets[e.MarketID] = true
+	}
+	return nil
+}
+
+// NewMsgPostPrices returns a new MsgPostPrices
+func NewMsgPostPrices(from string, prices PriceEntries) *MsgPostPrices {
+	return &MsgPostPrices{
+		From:   from,
+		Prices: prices,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPostPrices) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPostPrices) Type() string { return TypeMsgPostPrices }
+
+// GetSignBytes Implements Msg.
+func (msg MsgPostPrices) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPostPrices) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPostPrices) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.Prices) == 0 {
+		return errors.New("prices cannot be empty")
+	}
+	return msg.Prices.Validate()
+}
+
+// NewMsgCommitPrice returns a new MsgCommitPrice
+func NewMsgCommitPrice(from string, marketID string, hash []byte) *MsgCommitPrice {
+	return &MsgCommitPrice{
+		From:     from,
+		MarketID: marketID,
+		Hash:     hash,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCommitPrice) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCommitPrice) Type() string { return TypeMsgCommitPrice }
+
+// GetSignBytes Implements Msg.
+func (msg MsgCommitPrice) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCommitPrice) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCommitPrice) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if strings.TrimSpace(msg.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(msg.Hash) != sha256.Size {
+		return fmt.Errorf("hash must be %d bytes, got %d", sha256.Size, len(msg.Hash))
+	}
+	return nil
+}
+
+// NewMsgRevealPrice returns a new MsgRevealPrice
+func NewMsgRevealPrice(from string, marketID string, price sdk.Dec, expiry time.Time, salt string) *MsgRevealPrice {
+	return &MsgRevealPrice{
+		From:     from,
+		MarketID: marketID,
+		Price:    price,
+		Expiry:   expiry,
+		Salt:     salt,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRevealPrice) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRevealPrice) Type() string { return TypeMsgRevealPrice }
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevealPrice) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealPrice) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRevealPrice) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if strings.TrimSpace(msg.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if msg.Price.IsNegative() {
+		return fmt.Errorf("price cannot be negative: %s", msg.Price.String())
+	}
+	if msg.Expiry.Unix() <= 0 {
+		return errors.New("must set an expiration time")
+	}
+	if strings.TrimSpace(msg.Salt) == "" {
+		return errors.New("salt cannot be blank")
+	}
+	return nil
+}
+
+// NewMsgDelegateFeeder returns a new MsgDelegateFeeder
+func NewMsgDelegateFeeder(from string, feeder string) *MsgDelegateFeeder {
+	return &MsgDelegateFeeder{
+		From:   from,
+		Feeder: feeder,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgDelegateFeeder) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgDelegateFeeder) Type() string { return TypeMsgDelegateFeeder }
+
+// GetSignBytes Implements Msg.
+func (msg MsgDelegateFeeder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDelegateFeeder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDelegateFeeder) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Feeder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid feeder address: %s", err)
+	}
+	if msg.Feeder == msg.From {
+		return errorsmod.Wrap(ErrInvalidFeeder, "oracle cannot be its own feeder")
+	}
+	return nil
+}
+
+// NewMsgRevokeFeeder returns a new MsgRevokeFeeder
+func NewMsgRevokeFeeder(from string) *MsgRevokeFeeder {
+	return &MsgRevokeFeeder{
+		From: from,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeFeeder) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRevokeFeeder) Type() string { return TypeMsgRevokeFeeder }
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeFeeder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeFeeder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRevokeFeeder) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	return nil
+}
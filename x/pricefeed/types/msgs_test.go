@@ -36,3 +36,118 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPostPrices_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price, _ := sdk.NewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+	negativePrice, _ := sdk.NewDecFromStr("-3.05")
+
+	tests := []struct {
+		name       string
+		msg        MsgPostPrices
+		expectPass bool
+	}{
+		{"normal", MsgPostPrices{addr.String(), PriceEntries{{"xrp", price, expiry}, {"bnb", price, expiry}}}, true},
+		{"emptyAddr", MsgPostPrices{"", PriceEntries{{"xrp", price, expiry}}}, false},
+		{"emptyPrices", MsgPostPrices{addr.String(), PriceEntries{}}, false},
+		{"emptyAsset", MsgPostPrices{addr.String(), PriceEntries{{"", price, expiry}}}, false},
+		{"negativePrice", MsgPostPrices{addr.String(), PriceEntries{{"xrp", negativePrice, expiry}}}, false},
+		{"duplicatedAsset", MsgPostPrices{addr.String(), PriceEntries{{"xrp", price, expiry}, {"xrp", price, expiry}}}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgCommitPrice_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	hash := PriceCommitHash("xrp", addr, sdk.MustNewDecFromStr("0.3005"), tmtime.Now(), "salt")
+
+	tests := []struct {
+		name       string
+		msg        MsgCommitPrice
+		expectPass bool
+	}{
+		{"normal", MsgCommitPrice{addr.String(), "xrp", hash}, true},
+		{"emptyAddr", MsgCommitPrice{"", "xrp", hash}, false},
+		{"emptyAsset", MsgCommitPrice{addr.String(), "", hash}, false},
+		{"shortHash", MsgCommitPrice{addr.String(), "xrp", hash[:16]}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgRevealPrice_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price, _ := sdk.NewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+	negativePrice, _ := sdk.NewDecFromStr("-3.05")
+
+	tests := []struct {
+		name       string
+		msg        MsgRevealPrice
+		expectPass bool
+	}{
+		{"normal", MsgRevealPrice{addr.String(), "xrp", price, expiry, "salt"}, true},
+		{"emptyAddr", MsgRevealPrice{"", "xrp", price, expiry, "salt"}, false},
+		{"emptyAsset", MsgRevealPrice{addr.String(), "", price, expiry, "salt"}, false},
+		{"negativePrice", MsgRevealPrice{addr.String(), "xrp", negativePrice, expiry, "salt"}, false},
+		{"emptySalt", MsgRevealPrice{addr.String(), "xrp", price, expiry, ""}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgDelegateFeeder_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	feeder := sdk.AccAddress([]byte("someFeeder"))
+
+	tests := []struct {
+		name       string
+		msg        MsgDelegateFeeder
+		expectPass bool
+	}{
+		{"normal", MsgDelegateFeeder{addr.String(), feeder.String()}, true},
+		{"emptyAddr", MsgDelegateFeeder{"", feeder.String()}, false},
+		{"emptyFeeder", MsgDelegateFeeder{addr.String(), ""}, false},
+		{"invalidFeeder", MsgDelegateFeeder{addr.String(), "feeder"}, false},
+		{"selfFeeder", MsgDelegateFeeder{addr.String(), addr.String()}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgRevokeFeeder_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+
+	require.Nil(t, NewMsgRevokeFeeder(addr.String()).ValidateBasic())
+	require.NotNil(t, NewMsgRevokeFeeder("").ValidateBasic())
+}
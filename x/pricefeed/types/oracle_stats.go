@@ -0,0 +1,101 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOracleMonitor returns a new OracleMonitor
+func NewOracleMonitor(updateWindow time.Duration, maxMissedWindows uint32) OracleMonitor {
+	return OracleMonitor{
+		UpdateWindow:     updateWindow,
+		MaxMissedWindows: maxMissedWindows,
+	}
+}
+
+// IsEnabled returns true if oracles are expected to post a price in each update window
+func (m OracleMonitor) IsEnabled() bool {
+	return m.UpdateWindow != 0
+}
+
+// SuspensionEnabled returns true if oracles are suspended after missing too many update windows in a row
+func (m OracleMonitor) SuspensionEnabled() bool {
+	return m.IsEnabled() && m.MaxMissedWindows != 0
+}
+
+// Validate performs a basic validation of the oracle monitor
+func (m OracleMonitor) Validate() error {
+	if m.UpdateWindow < 0 {
+		return fmt.Errorf("update window cannot be negative: %s", m.UpdateWindow)
+	}
+	if !m.IsEnabled() && m.MaxMissedWindows != 0 {
+		return errors.New("max missed windows cannot be set without an update window")
+	}
+	return nil
+}
+
+// NewOracleStats returns new OracleStats for an oracle with an update window starting at the given time
+func NewOracleStats(marketID string, oracle sdk.AccAddress, windowStart time.Time) OracleStats {
+	return OracleStats{
+		MarketID:      marketID,
+		OracleAddress: oracle,
+		Deviation:     sdk.ZeroDec(),
+		WindowStart:   windowStart,
+	}
+}
+
+// Validate performs a basic validation of oracle stats
+func (s OracleStats) Validate() error {
+	if strings.TrimSpace(s.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(s.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if s.Deviation.IsNil() || s.Deviation.IsNegative() {
+		return fmt.Errorf("oracle stats for market id %s and oracle address %s have invalid deviation: %s", s.MarketID, s.OracleAddress, s.Deviation)
+	}
+	if s.ConsecutiveMissedWindows > s.MissedWindows {
+		return fmt.Errorf("oracle stats for market id %s and oracle address %s have more consecutive than total missed windows", s.MarketID, s.OracleAddress)
+	}
+	return nil
+}
+
+// ToResponse returns the query response of the oracle stats
+func (s OracleStats) ToResponse() OracleStatsResponse {
+	return OracleStatsResponse{
+		MarketID:                 s.MarketID,
+		OracleAddress:            s.OracleAddress.String(),
+		LastPostTime:             s.LastPostTime,
+		Deviation:                s.Deviation,
+		MissedWindows:            s.MissedWindows,
+		ConsecutiveMissedWindows: s.ConsecutiveMissedWindows,
+		WindowStart:              s.WindowStart,
+		Suspended:                s.Suspended,
+	}
+}
+
+// OracleStatsList is a slice of OracleStats
+type OracleStatsList []OracleStats
+
+// Validate checks if all the oracle stats are valid and there are no duplicated entries.
+func (sl OracleStatsList) Validate() error {
+	seenStats := make(map[string]bool)
+	for _, s := range sl {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if seenStats[s.MarketID+s.OracleAddress.String()] {
+			return fmt.Errorf("duplicated oracle stats for market id %s and oracle address %s", s.MarketID, s.OracleAddress)
+		}
+		seenStats[s.MarketID+s.OracleAddress.String()] = true
+	}
+	return nil
+}
+
+// OracleStatsResponses is a slice of OracleStatsResponse
+type OracleStatsResponses []OracleStatsResponse
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PriceHistoryLength is the number of price records stored for each market. Older records are overwritten
+	// as new prices are set.
+	PriceHistoryLength = 1200
+	// PriceRecordInterval is the length of the time intervals a market has at most one price record for, so
+	// that the price history covers at least about two hours regardless of block times.
+	PriceRecordInterval = 6 * time.Second
+	// MaxTWAPWindow is the longest time-weighted average price window covered by the price history of a market
+	// with a price recorded in every interval. The oldest record may end before the start of its interval, and
+	// the latest record covers the current interval.
+	MaxTWAPWindow = (PriceHistoryLength - 2) * PriceRecordInterval
+)
+
+// priceRecordIntervalOf returns the index of the record interval of a time
+func priceRecordIntervalOf(t time.Time) int64 {
+	return t.UnixNano() / int64(PriceRecordInterval)
+}
+
+// InSameInterval returns true if the record and a time at or after it are in the same record interval
+func (r PriceRecord) InSameInterval(t time.Time) bool {
+	return priceRecordIntervalOf(r.Timestamp) == priceRecordIntervalOf(t)
+}
+
+// NewPriceRecord returns a new PriceRecord
+func NewPriceRecord(marketID string, timestamp time.Time, price, cumulativePrice sdk.Dec) PriceRecord {
+	return PriceRecord{
+		MarketID:        marketID,
+		Timestamp:       timestamp,
+		Price:           price,
+		CumulativePrice: cumulativePrice,
+	}
+}
+
+// CumulativePriceAt returns the cumulative price of the record extended to a time at or after the record,
+// using the price held since the record
+func (r PriceRecord) CumulativePriceAt(t time.Time) sdk.Dec {
+	elapsed := sdk.NewDecWithPrec(t.Sub(r.Timestamp).Nanoseconds(), 9)
+	return r.CumulativePrice.Add(r.Price.Mul(elapsed))
+}
+
+// Validate performs a basic validation of a price record
+func (r PriceRecord) Validate() error {
+	if strings.TrimSpace(r.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if r.Timestamp.IsZero() {
+		return fmt.Errorf("market %s has invalid price record time", r.MarketID)
+	}
+	for _, price := range []sdk.Dec{r.Price, r.CumulativePrice} {
+		if price.IsNil() || price.IsNegative() {
+			return fmt.Errorf("market %s has invalid price record price: %s", r.MarketID, price)
+		}
+	}
+	return nil
+}
+
+// PriceRecords is a slice of PriceRecord
+type PriceRecords []PriceRecord
+
+// Validate checks if all the price records are valid, in time order for each market, and within the stored
+// history length
+func (rs PriceRecords) Validate() error {
+	lastRecords := make(map[string]PriceRecord)
+	counts := make(map[string]int)
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if last, found := lastRecords[r.MarketID]; found {
+			if !r.Timestamp.After(last.Timestamp) {
+				return fmt.Errorf("price records for market %s must be in time order", r.MarketID)
+			}
+			if r.CumulativePrice.LT(last.CumulativePrice) {
+				return fmt.Errorf("cumulative price of market %s cannot decrease", r.MarketID)
+			}
+		}
+		lastRecords[r.MarketID] = r
+		counts[r.MarketID]++
+		if counts[r.MarketID] > PriceHistoryLength {
+			return fmt.Errorf("market %s has more than %d price records", r.MarketID, PriceHistoryLength)
+		}
+	}
+	return nil
+}
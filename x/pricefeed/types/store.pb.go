@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -82,6 +83,22 @@ type Market struct {
 	QuoteAsset string                                          `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	Oracles    []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=oracles,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracles,omitempty"`
 	Active     bool                                            `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// pool_price_source optionally prices the market with the time-weighted average price of a swap pool
+	// instead of oracle posted prices
+	PoolPriceSource PoolPriceSource `protobuf:"bytes,6,opt,name=pool_price_source,json=poolPriceSource,proto3" json:"pool_price_source"`
+	// aggregation configures how oracle posted prices are combined into the current price
+	Aggregation PriceAggregation `protobuf:"bytes,7,opt,name=aggregation,proto3" json:"aggregation"`
+	// commit_reveal optionally requires oracles to commit to a price hash before revealing their price
+	CommitReveal CommitReveal `protobuf:"bytes,8,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal"`
+	// twap_price_source optionally prices the market with the time-weighted average price of another market
+	TWAPPriceSource TWAPPriceSource `protobuf:"bytes,9,opt,name=twap_price_source,json=twapPriceSource,proto3" json:"twap_price_source"`
+	// oracle_monitor optionally tracks whether oracles post prices in each update window, suspending oracles that
+	// miss too many windows in a row
+	OracleMonitor OracleMonitor `protobuf:"bytes,10,opt,name=oracle_monitor,json=oracleMonitor,proto3" json:"oracle_monitor"`
+	// derived_price_source optionally prices the market as the product or quotient of the prices of other markets
+	DerivedPriceSource DerivedPriceSource `protobuf:"bytes,11,opt,name=derived_price_source,json=derivedPriceSource,proto3" json:"derived_price_source"`
+	// circuit_breaker optionally halts the market, holding its previous price, when its price moves too far
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,12,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -152,26 +169,80 @@ func (m *Market) GetActive() bool {
 	return false
 }
 
-// PostedPrice defines a price for market posted by a specific oracle.
-type PostedPrice struct {
-	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
-	Price         github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Expiry        time.Time                                     `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry"`
+func (m *Market) GetPoolPriceSource() PoolPriceSource {
+	if m != nil {
+		return m.PoolPriceSource
+	}
+	return PoolPriceSource{}
 }
 
-func (m *PostedPrice) Reset()         { *m = PostedPrice{} }
-func (m *PostedPrice) String() string { return proto.CompactTextString(m) }
-func (*PostedPrice) ProtoMessage()    {}
-func (*PostedPrice) Descriptor() ([]byte, []int) {
+func (m *Market) GetAggregation() PriceAggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return PriceAggregation{}
+}
+
+func (m *Market) GetCommitReveal() CommitReveal {
+	if m != nil {
+		return m.CommitReveal
+	}
+	return CommitReveal{}
+}
+
+func (m *Market) GetTWAPPriceSource() TWAPPriceSource {
+	if m != nil {
+		return m.TWAPPriceSource
+	}
+	return TWAPPriceSource{}
+}
+
+func (m *Market) GetOracleMonitor() OracleMonitor {
+	if m != nil {
+		return m.OracleMonitor
+	}
+	return OracleMonitor{}
+}
+
+func (m *Market) GetDerivedPriceSource() DerivedPriceSource {
+	if m != nil {
+		return m.DerivedPriceSource
+	}
+	return DerivedPriceSource{}
+}
+
+func (m *Market) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+// CircuitBreaker defines the max price changes of a market past which the market is halted
+type CircuitBreaker struct {
+	// max_block_change is the maximum fractional change of the current price from the previous current price in a
+	// single update, where an empty or zero value disables the check
+	MaxBlockChange *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_block_change,json=maxBlockChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_block_change,omitempty"`
+	// max_window_change is the maximum fractional change of the current price from the price of the market at the
+	// start of the window, where an empty or zero value disables the check
+	MaxWindowChange *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_window_change,json=maxWindowChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_window_change,omitempty"`
+	// window is the duration of the windows of the max window change and of halts, after which the new price
+	// is accepted as confirmed
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40639f5e16f9a, []int{2}
 }
-func (m *PostedPrice) XXX_Unmarshal(b []byte) error {
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PostedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PostedPrice.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -181,58 +252,90 @@ func (m *PostedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PostedPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PostedPrice.Merge(m, src)
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
 }
-func (m *PostedPrice) XXX_Size() int {
+func (m *CircuitBreaker) XXX_Size() int {
 	return m.Size()
 }
-func (m *PostedPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_PostedPrice.DiscardUnknown(m)
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PostedPrice proto.InternalMessageInfo
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
 
-func (m *PostedPrice) GetMarketID() string {
+func (m *CircuitBreaker) GetWindow() time.Duration {
 	if m != nil {
-		return m.MarketID
+		return m.Window
 	}
-	return ""
+	return 0
 }
 
-func (m *PostedPrice) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.OracleAddress
+// DerivedPriceSource defines the markets whose prices are combined into the price of a market
+type DerivedPriceSource struct {
+	// terms are multiplied together to give the price, where no terms disables the source
+	Terms []DerivedPriceTerm `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms"`
+}
+
+func (m *DerivedPriceSource) Reset()         { *m = DerivedPriceSource{} }
+func (m *DerivedPriceSource) String() string { return proto.CompactTextString(m) }
+func (*DerivedPriceSource) ProtoMessage()    {}
+func (*DerivedPriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{3}
+}
+func (m *DerivedPriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedPriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivedPriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *DerivedPriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedPriceSource.Merge(m, src)
+}
+func (m *DerivedPriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedPriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedPriceSource.DiscardUnknown(m)
 }
 
-func (m *PostedPrice) GetExpiry() time.Time {
+var xxx_messageInfo_DerivedPriceSource proto.InternalMessageInfo
+
+func (m *DerivedPriceSource) GetTerms() []DerivedPriceTerm {
 	if m != nil {
-		return m.Expiry
+		return m.Terms
 	}
-	return time.Time{}
+	return nil
 }
 
-// CurrentPrice defines a current price for a particular market in the pricefeed
-// module.
-type CurrentPrice struct {
-	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+// DerivedPriceTerm defines a market whose price is a factor of the price of a derived market
+type DerivedPriceTerm struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// inverse divides by the price of the market instead of multiplying by it
+	Inverse bool `protobuf:"varint,2,opt,name=inverse,proto3" json:"inverse,omitempty"`
 }
 
-func (m *CurrentPrice) Reset()         { *m = CurrentPrice{} }
-func (m *CurrentPrice) String() string { return proto.CompactTextString(m) }
-func (*CurrentPrice) ProtoMessage()    {}
-func (*CurrentPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40639f5e16f9a, []int{3}
+func (m *DerivedPriceTerm) Reset()         { *m = DerivedPriceTerm{} }
+func (m *DerivedPriceTerm) String() string { return proto.CompactTextString(m) }
+func (*DerivedPriceTerm) ProtoMessage()    {}
+func (*DerivedPriceTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{4}
 }
-func (m *CurrentPrice) XXX_Unmarshal(b []byte) error {
+func (m *DerivedPriceTerm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CurrentPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DerivedPriceTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CurrentPrice.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DerivedPriceTerm.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -242,650 +345,6166 @@ func (m *CurrentPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CurrentPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CurrentPrice.Merge(m, src)
+func (m *DerivedPriceTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedPriceTerm.Merge(m, src)
 }
-func (m *CurrentPrice) XXX_Size() int {
+func (m *DerivedPriceTerm) XXX_Size() int {
 	return m.Size()
 }
-func (m *CurrentPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_CurrentPrice.DiscardUnknown(m)
+func (m *DerivedPriceTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedPriceTerm.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CurrentPrice proto.InternalMessageInfo
+var xxx_messageInfo_DerivedPriceTerm proto.InternalMessageInfo
 
-func (m *CurrentPrice) GetMarketID() string {
+func (m *DerivedPriceTerm) GetMarketID() string {
 	if m != nil {
 		return m.MarketID
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "kava.pricefeed.v1beta1.Params")
-	proto.RegisterType((*Market)(nil), "kava.pricefeed.v1beta1.Market")
-	proto.RegisterType((*PostedPrice)(nil), "kava.pricefeed.v1beta1.PostedPrice")
-	proto.RegisterType((*CurrentPrice)(nil), "kava.pricefeed.v1beta1.CurrentPrice")
+func (m *DerivedPriceTerm) GetInverse() bool {
+	if m != nil {
+		return m.Inverse
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("kava/pricefeed/v1beta1/store.proto", fileDescriptor_9df40639f5e16f9a)
+// OracleMonitor defines the update windows oracles of a market are expected to post a price in
+type OracleMonitor struct {
+	// update_window is the duration in which each oracle must post at least one price, where zero disables
+	// tracking missed windows
+	UpdateWindow time.Duration `protobuf:"bytes,1,opt,name=update_window,json=updateWindow,proto3,stdduration" json:"update_window"`
+	// max_missed_windows is the number of consecutive update windows an oracle can miss before it is suspended
+	// from the oracles of the market, where zero disables suspension
+	MaxMissedWindows uint32 `protobuf:"varint,2,opt,name=max_missed_windows,json=maxMissedWindows,proto3" json:"max_missed_windows,omitempty"`
 }
 
-var fileDescriptor_9df40639f5e16f9a = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0x6d, 0xfe, 0x5c, 0x02, 0x48, 0x06, 0x55, 0x26, 0x12, 0x76, 0x94, 0x01, 0x19,
-	0xa1, 0x9c, 0xd5, 0xb2, 0xb2, 0xc4, 0x64, 0x20, 0x43, 0xa5, 0xc8, 0x30, 0xb1, 0x44, 0x67, 0xfb,
-	0xd5, 0x58, 0x89, 0x39, 0x73, 0x77, 0x89, 0x9a, 0x89, 0xaf, 0xd0, 0x8f, 0x81, 0x90, 0xd8, 0xf8,
-	0x10, 0x1d, 0x2b, 0x26, 0xc4, 0x90, 0x16, 0xe7, 0x03, 0xb0, 0x33, 0x21, 0xdf, 0xd9, 0x55, 0x07,
-	0x06, 0x2a, 0x98, 0xee, 0xde, 0xef, 0xfd, 0xde, 0xbf, 0xdf, 0xbd, 0xc3, 0xc3, 0x05, 0x5d, 0x53,
-	0x37, 0xe3, 0x49, 0x08, 0x27, 0x00, 0x91, 0xbb, 0x3e, 0x0c, 0x40, 0xd2, 0x43, 0x57, 0x48, 0xc6,
-	0x81, 0x64, 0x9c, 0x49, 0x66, 0x1c, 0x14, 0x1c, 0x72, 0xcd, 0x21, 0x25, 0xa7, 0xff, 0x30, 0x64,
-	0x22, 0x65, 0x62, 0xae, 0x58, 0xae, 0x36, 0x74, 0x48, 0xff, 0x41, 0xcc, 0x62, 0xa6, 0xf1, 0xe2,
-	0x56, 0xa2, 0x76, 0xcc, 0x58, 0xbc, 0x04, 0x57, 0x59, 0xc1, 0xea, 0xc4, 0x95, 0x49, 0x0a, 0x42,
-	0xd2, 0x34, 0xd3, 0x84, 0xe1, 0x2b, 0xdc, 0x9c, 0x51, 0x4e, 0x53, 0x61, 0x4c, 0x71, 0x2b, 0xa5,
-	0x7c, 0x01, 0x52, 0x98, 0x68, 0xd0, 0x70, 0xba, 0x47, 0x16, 0xf9, 0x73, 0x17, 0xe4, 0x58, 0xd1,
-	0xbc, 0x7b, 0xe7, 0x5b, 0xbb, 0xf6, 0xe9, 0xd2, 0x6e, 0x69, 0x5b, 0xf8, 0x55, 0xfc, 0xf0, 0x27,
-	0xc2, 0x4d, 0x0d, 0x1a, 0x4f, 0x70, 0x47, 0xa3, 0xf3, 0x24, 0x32, 0xd1, 0x00, 0x39, 0x1d, 0xaf,
-	0x97, 0x6f, 0xed, 0xb6, 0x76, 0x4f, 0x27, 0x7e, 0x5b, 0xbb, 0xa7, 0x91, 0xf1, 0x08, 0xe3, 0x80,
-	0x0a, 0x98, 0x53, 0x21, 0x40, 0x9a, 0xf5, 0x82, 0xeb, 0x77, 0x0a, 0x64, 0x5c, 0x00, 0x86, 0x8d,
-	0xbb, 0xef, 0x57, 0x4c, 0x56, 0xfe, 0x86, 0xf2, 0x63, 0x05, 0x69, 0x42, 0x80, 0x5b, 0x8c, 0xd3,
-	0x70, 0x09, 0xc2, 0xdc, 0x1b, 0x34, 0x9c, 0x9e, 0xf7, 0xf2, 0xd7, 0xd6, 0x1e, 0xc5, 0x89, 0x7c,
-	0xbb, 0x0a, 0x48, 0xc8, 0xd2, 0x52, 0xaf, 0xf2, 0x18, 0x89, 0x68, 0xe1, 0xca, 0x4d, 0x06, 0x82,
-	0x8c, 0xc3, 0x70, 0x1c, 0x45, 0x1c, 0x84, 0xf8, 0xfa, 0x65, 0x74, 0xbf, 0x54, 0xb5, 0x44, 0xbc,
-	0x8d, 0x04, 0xe1, 0x57, 0x89, 0x8d, 0x03, 0xdc, 0xa4, 0xa1, 0x4c, 0xd6, 0x60, 0xee, 0x0f, 0x90,
-	0xd3, 0xf6, 0x4b, 0x6b, 0xf8, 0xb9, 0x8e, 0xbb, 0x33, 0x26, 0x24, 0x44, 0xb3, 0x42, 0xae, 0xdb,
-	0x8c, 0xcd, 0xf0, 0x5d, 0x9d, 0x7d, 0x4e, 0x75, 0x49, 0x35, 0xfa, 0xff, 0xec, 0xfe, 0x8e, 0xce,
-	0x5f, 0x62, 0xc6, 0x04, 0xef, 0xab, 0x37, 0xd5, 0x12, 0x7a, 0xa4, 0x78, 0xc6, 0xef, 0x5b, 0xfb,
-	0xf1, 0x5f, 0xd4, 0x9a, 0x40, 0xe8, 0xeb, 0x60, 0xe3, 0x39, 0x6e, 0xc2, 0x69, 0x96, 0xf0, 0x8d,
-	0xb9, 0x37, 0x40, 0x4e, 0xf7, 0xa8, 0x4f, 0xf4, 0xaa, 0x91, 0x6a, 0xd5, 0xc8, 0xeb, 0x6a, 0xd5,
-	0xbc, 0x76, 0x51, 0xe2, 0xec, 0xd2, 0x46, 0x7e, 0x19, 0x33, 0xfc, 0x80, 0x7b, 0x2f, 0x56, 0x9c,
-	0xc3, 0x3b, 0x79, 0x6b, 0xbd, 0xae, 0xdb, 0xaf, 0xff, 0x43, 0xfb, 0xde, 0xf1, 0xd5, 0x0f, 0x0b,
-	0x7d, 0xcc, 0x2d, 0x74, 0x9e, 0x5b, 0xe8, 0x22, 0xb7, 0xd0, 0x55, 0x6e, 0xa1, 0xb3, 0x9d, 0x55,
-	0xbb, 0xd8, 0x59, 0xb5, 0x6f, 0x3b, 0xab, 0xf6, 0xe6, 0xe9, 0x8d, 0x84, 0xc5, 0x47, 0x18, 0x2d,
-	0x69, 0x20, 0xd4, 0xcd, 0x3d, 0xbd, 0xf1, 0x7d, 0x55, 0xe6, 0xa0, 0xa9, 0xa6, 0x7e, 0xf6, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0x18, 0xb5, 0x5b, 0xc1, 0xdd, 0x03, 0x00, 0x00,
+func (m *OracleMonitor) Reset()         { *m = OracleMonitor{} }
+func (m *OracleMonitor) String() string { return proto.CompactTextString(m) }
+func (*OracleMonitor) ProtoMessage()    {}
+func (*OracleMonitor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{5}
 }
-
-func (this *Params) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+func (m *OracleMonitor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleMonitor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleMonitor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *OracleMonitor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleMonitor.Merge(m, src)
+}
+func (m *OracleMonitor) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleMonitor) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleMonitor.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *Params")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *Params but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *Params but is not nil && this == nil")
-	}
-	if len(this.Markets) != len(that1.Markets) {
-		return fmt.Errorf("Markets this(%v) Not Equal that(%v)", len(this.Markets), len(that1.Markets))
-	}
-	for i := range this.Markets {
-		if !this.Markets[i].Equal(&that1.Markets[i]) {
-			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
-		}
+var xxx_messageInfo_OracleMonitor proto.InternalMessageInfo
+
+func (m *OracleMonitor) GetUpdateWindow() time.Duration {
+	if m != nil {
+		return m.UpdateWindow
 	}
-	return nil
+	return 0
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *OracleMonitor) GetMaxMissedWindows() uint32 {
+	if m != nil {
+		return m.MaxMissedWindows
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// TWAPPriceSource defines another market whose time-weighted average price is used as the price of a market
+type TWAPPriceSource struct {
+	// market_id is the id of the source market, an empty id disables the source
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// window is the duration of the time-weighted average price
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *TWAPPriceSource) Reset()         { *m = TWAPPriceSource{} }
+func (m *TWAPPriceSource) String() string { return proto.CompactTextString(m) }
+func (*TWAPPriceSource) ProtoMessage()    {}
+func (*TWAPPriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{6}
+}
+func (m *TWAPPriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TWAPPriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TWAPPriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *TWAPPriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TWAPPriceSource.Merge(m, src)
+}
+func (m *TWAPPriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *TWAPPriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_TWAPPriceSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TWAPPriceSource proto.InternalMessageInfo
+
+func (m *TWAPPriceSource) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	if len(this.Markets) != len(that1.Markets) {
-		return false
+	return ""
+}
+
+func (m *TWAPPriceSource) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	for i := range this.Markets {
-		if !this.Markets[i].Equal(&that1.Markets[i]) {
-			return false
+	return 0
+}
+
+// CommitReveal defines the commit-reveal price posting mode of a market
+type CommitReveal struct {
+	// phase_blocks is the number of blocks of each commit phase and each reveal phase of a round, where zero
+	// disables commit-reveal and oracles post prices directly. Prices committed in the commit phase of a round are
+	// revealed in its reveal phase, and are posted at the end of the round.
+	PhaseBlocks uint64 `protobuf:"varint,1,opt,name=phase_blocks,json=phaseBlocks,proto3" json:"phase_blocks,omitempty"`
+}
+
+func (m *CommitReveal) Reset()         { *m = CommitReveal{} }
+func (m *CommitReveal) String() string { return proto.CompactTextString(m) }
+func (*CommitReveal) ProtoMessage()    {}
+func (*CommitReveal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{7}
+}
+func (m *CommitReveal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitReveal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitReveal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Market) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+func (m *CommitReveal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitReveal.Merge(m, src)
+}
+func (m *CommitReveal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitReveal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitReveal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitReveal proto.InternalMessageInfo
+
+func (m *CommitReveal) GetPhaseBlocks() uint64 {
+	if m != nil {
+		return m.PhaseBlocks
 	}
+	return 0
+}
 
-	that1, ok := that.(*Market)
-	if !ok {
-		that2, ok := that.(Market)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *Market")
+// PriceAggregation defines how the posted prices of a market are combined into its current price
+type PriceAggregation struct {
+	// min_oracles is the minimum number of oracles with a valid posted price required to update the price,
+	// where zero requires a single oracle
+	MinOracles uint32 `protobuf:"varint,1,opt,name=min_oracles,json=minOracles,proto3" json:"min_oracles,omitempty"`
+	// max_deviation is the maximum fractional deviation of a posted price from the median of all valid posted
+	// prices, past which the posted price is dropped as an outlier, where an empty or zero value disables the check
+	MaxDeviation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation,omitempty"`
+	// oracle_weights optionally weights the posted prices of oracles in the median, where oracles without a
+	// weight have a weight of one
+	OracleWeights []OracleWeight `protobuf:"bytes,3,rep,name=oracle_weights,json=oracleWeights,proto3" json:"oracle_weights"`
+}
+
+func (m *PriceAggregation) Reset()         { *m = PriceAggregation{} }
+func (m *PriceAggregation) String() string { return proto.CompactTextString(m) }
+func (*PriceAggregation) ProtoMessage()    {}
+func (*PriceAggregation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{8}
+}
+func (m *PriceAggregation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAggregation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAggregation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *Market but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *Market but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
-	}
-	if this.BaseAsset != that1.BaseAsset {
-		return fmt.Errorf("BaseAsset this(%v) Not Equal that(%v)", this.BaseAsset, that1.BaseAsset)
-	}
-	if this.QuoteAsset != that1.QuoteAsset {
-		return fmt.Errorf("QuoteAsset this(%v) Not Equal that(%v)", this.QuoteAsset, that1.QuoteAsset)
-	}
-	if len(this.Oracles) != len(that1.Oracles) {
-		return fmt.Errorf("Oracles this(%v) Not Equal that(%v)", len(this.Oracles), len(that1.Oracles))
-	}
-	for i := range this.Oracles {
-		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
-			return fmt.Errorf("Oracles this[%v](%v) Not Equal that[%v](%v)", i, this.Oracles[i], i, that1.Oracles[i])
-		}
+}
+func (m *PriceAggregation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAggregation.Merge(m, src)
+}
+func (m *PriceAggregation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAggregation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAggregation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAggregation proto.InternalMessageInfo
+
+func (m *PriceAggregation) GetMinOracles() uint32 {
+	if m != nil {
+		return m.MinOracles
 	}
-	if this.Active != that1.Active {
-		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
+	return 0
+}
+
+func (m *PriceAggregation) GetOracleWeights() []OracleWeight {
+	if m != nil {
+		return m.OracleWeights
 	}
 	return nil
 }
-func (this *Market) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Market)
-	if !ok {
-		that2, ok := that.(Market)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MarketID != that1.MarketID {
-		return false
-	}
-	if this.BaseAsset != that1.BaseAsset {
-		return false
-	}
-	if this.QuoteAsset != that1.QuoteAsset {
-		return false
-	}
-	if len(this.Oracles) != len(that1.Oracles) {
-		return false
-	}
-	for i := range this.Oracles {
-		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
-			return false
-		}
-	}
-	if this.Active != that1.Active {
-		return false
-	}
-	return true
+// OracleWeight defines the weight of an oracle's posted prices in the median price of a market
+type OracleWeight struct {
+	Oracle github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=oracle,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle,omitempty"`
+	Weight uint64                                        `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
-func (this *PostedPrice) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
-	}
 
-	that1, ok := that.(*PostedPrice)
-	if !ok {
-		that2, ok := that.(PostedPrice)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *PostedPrice")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
+func (m *OracleWeight) Reset()         { *m = OracleWeight{} }
+func (m *OracleWeight) String() string { return proto.CompactTextString(m) }
+func (*OracleWeight) ProtoMessage()    {}
+func (*OracleWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{9}
+}
+func (m *OracleWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
-	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
-		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
-	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+		return b[:n], nil
 	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+}
+func (m *OracleWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleWeight.Merge(m, src)
+}
+func (m *OracleWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleWeight proto.InternalMessageInfo
+
+func (m *OracleWeight) GetOracle() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Oracle
 	}
 	return nil
 }
-func (this *PostedPrice) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*PostedPrice)
-	if !ok {
-		that2, ok := that.(PostedPrice)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MarketID != that1.MarketID {
-		return false
-	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
-		return false
-	}
-	if !this.Price.Equal(that1.Price) {
-		return false
-	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return false
+func (m *OracleWeight) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
 	}
-	return true
+	return 0
 }
-func (this *CurrentPrice) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
-	}
 
-	that1, ok := that.(*CurrentPrice)
-	if !ok {
-		that2, ok := that.(CurrentPrice)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *CurrentPrice")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
+// PoolPriceSource defines a liquidity pool used as the price source of a market
+type PoolPriceSource struct {
+	// pool_id is the id of the pool, an empty id disables the source
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// base_denom is the pool token that is priced in units of the other pool token
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// window is the duration of the time-weighted average price
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	// base_conversion_factor is the number of decimals of the base denom, used to convert the pool price from
+	// base units to whole tokens
+	BaseConversionFactor uint32 `protobuf:"varint,4,opt,name=base_conversion_factor,json=baseConversionFactor,proto3" json:"base_conversion_factor,omitempty"`
+	// quote_conversion_factor is the number of decimals of the other pool denom, used to convert the pool price
+	// from base units to whole tokens
+	QuoteConversionFactor uint32 `protobuf:"varint,5,opt,name=quote_conversion_factor,json=quoteConversionFactor,proto3" json:"quote_conversion_factor,omitempty"`
+}
+
+func (m *PoolPriceSource) Reset()         { *m = PoolPriceSource{} }
+func (m *PoolPriceSource) String() string { return proto.CompactTextString(m) }
+func (*PoolPriceSource) ProtoMessage()    {}
+func (*PoolPriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{10}
+}
+func (m *PoolPriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
-	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+		return b[:n], nil
 	}
-	return nil
 }
-func (this *CurrentPrice) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PoolPriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPriceSource.Merge(m, src)
+}
+func (m *PoolPriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPriceSource.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*CurrentPrice)
-	if !ok {
-		that2, ok := that.(CurrentPrice)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_PoolPriceSource proto.InternalMessageInfo
+
+func (m *PoolPriceSource) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *PoolPriceSource) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	if this.MarketID != that1.MarketID {
-		return false
+	return ""
+}
+
+func (m *PoolPriceSource) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	if !this.Price.Equal(that1.Price) {
-		return false
+	return 0
+}
+
+func (m *PoolPriceSource) GetBaseConversionFactor() uint32 {
+	if m != nil {
+		return m.BaseConversionFactor
 	}
-	return true
+	return 0
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *PoolPriceSource) GetQuoteConversionFactor() uint32 {
+	if m != nil {
+		return m.QuoteConversionFactor
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PostedPrice defines a price for market posted by a specific oracle.
+type PostedPrice struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	Price         github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Expiry        time.Time                                     `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStore(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PostedPrice) Reset()         { *m = PostedPrice{} }
+func (m *PostedPrice) String() string { return proto.CompactTextString(m) }
+func (*PostedPrice) ProtoMessage()    {}
+func (*PostedPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{11}
+}
+func (m *PostedPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostedPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PostedPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostedPrice.Merge(m, src)
+}
+func (m *PostedPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostedPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostedPrice.DiscardUnknown(m)
 }
 
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PostedPrice proto.InternalMessageInfo
+
+func (m *PostedPrice) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PostedPrice) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return nil
 }
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+func (m *PostedPrice) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
 	}
-	if len(m.Oracles) > 0 {
-		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Oracles[iNdEx])
-			copy(dAtA[i:], m.Oracles[iNdEx])
-			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	return time.Time{}
+}
+
+// CurrentPrice defines a current price for a particular market in the pricefeed
+// module.
+type CurrentPrice struct {
+	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *CurrentPrice) Reset()         { *m = CurrentPrice{} }
+func (m *CurrentPrice) String() string { return proto.CompactTextString(m) }
+func (*CurrentPrice) ProtoMessage()    {}
+func (*CurrentPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{12}
+}
+func (m *CurrentPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrentPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrentPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *CurrentPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentPrice.Merge(m, src)
+}
+func (m *CurrentPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrentPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentPrice.DiscardUnknown(m)
 }
 
-func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CurrentPrice proto.InternalMessageInfo
+
+func (m *CurrentPrice) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PriceRecord defines the current price of a market set in a block, with the cumulative price of the market
+type PriceRecord struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// timestamp is the block time the price was set
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// price is the current price of the market from the time of the record
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// cumulative_price is the sum of each previous price multiplied by the seconds it was held until the time
+	// of the record
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
 }
 
-func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintStore(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PriceRecord) Reset()         { *m = PriceRecord{} }
+func (m *PriceRecord) String() string { return proto.CompactTextString(m) }
+func (*PriceRecord) ProtoMessage()    {}
+func (*PriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{13}
+}
+func (m *PriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStore(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleAddress) > 0 {
-		i -= len(m.OracleAddress)
-		copy(dAtA[i:], m.OracleAddress)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRecord.Merge(m, src)
+}
+func (m *PriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRecord proto.InternalMessageInfo
+
+func (m *PriceRecord) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceRecord) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PriceCommit defines a hash of a price committed by an oracle for a commit-reveal round, and the price once revealed
+type PriceCommit struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	Hash          []byte                                        `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// round is the commit-reveal round of the market the price was committed in
+	Round uint64 `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	// revealed is true once the price has been revealed, to be posted at the end of the round
+	Revealed bool                                   `protobuf:"varint,5,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Expiry   time.Time                              `protobuf:"bytes,7,opt,name=expiry,proto3,stdtime" json:"expiry"`
 }
 
-func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PriceCommit) Reset()         { *m = PriceCommit{} }
+func (m *PriceCommit) String() string { return proto.CompactTextString(m) }
+func (*PriceCommit) ProtoMessage()    {}
+func (*PriceCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{14}
+}
+func (m *PriceCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStore(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PriceCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceCommit.Merge(m, src)
+}
+func (m *PriceCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceCommit.DiscardUnknown(m)
 }
 
-func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStore(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_PriceCommit proto.InternalMessageInfo
+
+func (m *PriceCommit) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PriceCommit) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
 	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovStore(uint64(l))
+	return nil
+}
+
+func (m *PriceCommit) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *PriceCommit) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *PriceCommit) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+func (m *PriceCommit) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+// MissedRevealCount defines the number of price commits an oracle has not revealed within the round of a market
+type MissedRevealCount struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	Count         uint64                                        `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MissedRevealCount) Reset()         { *m = MissedRevealCount{} }
+func (m *MissedRevealCount) String() string { return proto.CompactTextString(m) }
+func (*MissedRevealCount) ProtoMessage()    {}
+func (*MissedRevealCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{15}
+}
+func (m *MissedRevealCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedRevealCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedRevealCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MissedRevealCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedRevealCount.Merge(m, src)
+}
+func (m *MissedRevealCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedRevealCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedRevealCount.DiscardUnknown(m)
 }
 
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MissedRevealCount proto.InternalMessageInfo
+
+func (m *MissedRevealCount) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	return ""
+}
+
+func (m *MissedRevealCount) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	return nil
+}
+
+func (m *MissedRevealCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	return 0
+}
+
+// OracleStats defines the performance statistics of an oracle in a market
+type OracleStats struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	// last_post_time is the block time of the latest price posted by the oracle
+	LastPostTime time.Time `protobuf:"bytes,3,opt,name=last_post_time,json=lastPostTime,proto3,stdtime" json:"last_post_time"`
+	// deviation is the fractional deviation of the oracle's price from the current price of the market when the
+	// current price was last set from the oracle's price
+	Deviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=deviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deviation"`
+	// missed_windows is the total number of update windows the oracle did not post a price in
+	MissedWindows uint64 `protobuf:"varint,5,opt,name=missed_windows,json=missedWindows,proto3" json:"missed_windows,omitempty"`
+	// consecutive_missed_windows is the number of update windows in a row the oracle did not post a price in
+	ConsecutiveMissedWindows uint64 `protobuf:"varint,6,opt,name=consecutive_missed_windows,json=consecutiveMissedWindows,proto3" json:"consecutive_missed_windows,omitempty"`
+	// window_start is the start time of the current update window of the oracle
+	WindowStart time.Time `protobuf:"bytes,7,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// suspended is true when the oracle was removed from the oracles of the market for missing too many windows
+	Suspended bool `protobuf:"varint,8,opt,name=suspended,proto3" json:"suspended,omitempty"`
+}
+
+func (m *OracleStats) Reset()         { *m = OracleStats{} }
+func (m *OracleStats) String() string { return proto.CompactTextString(m) }
+func (*OracleStats) ProtoMessage()    {}
+func (*OracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{16}
+}
+func (m *OracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Oracles) > 0 {
-		for _, b := range m.Oracles {
-			l = len(b)
-			n += 1 + l + sovStore(uint64(l))
+}
+func (m *OracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleStats.Merge(m, src)
+}
+func (m *OracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleStats proto.InternalMessageInfo
+
+func (m *OracleStats) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *OracleStats) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return nil
+}
+
+func (m *OracleStats) GetLastPostTime() time.Time {
+	if m != nil {
+		return m.LastPostTime
+	}
+	return time.Time{}
+}
+
+func (m *OracleStats) GetMissedWindows() uint64 {
+	if m != nil {
+		return m.MissedWindows
+	}
+	return 0
+}
+
+func (m *OracleStats) GetConsecutiveMissedWindows() uint64 {
+	if m != nil {
+		return m.ConsecutiveMissedWindows
+	}
+	return 0
+}
+
+func (m *OracleStats) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *OracleStats) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+// CircuitBreakerState defines the current window and halt status of the circuit breaker of a market
+type CircuitBreakerState struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// window_start is the start time of the current window of the circuit breaker
+	WindowStart time.Time `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// window_price is the current price of the market at the start of the window
+	WindowPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=window_price,json=windowPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"window_price"`
+	// halted is true while the market holds its previous price after a price moved past the max change
+	Halted bool `protobuf:"varint,4,opt,name=halted,proto3" json:"halted,omitempty"`
+	// halt_time is the block time the market was last halted
+	HaltTime time.Time `protobuf:"bytes,5,opt,name=halt_time,json=haltTime,proto3,stdtime" json:"halt_time"`
+}
+
+func (m *CircuitBreakerState) Reset()         { *m = CircuitBreakerState{} }
+func (m *CircuitBreakerState) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerState) ProtoMessage()    {}
+func (*CircuitBreakerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{17}
+}
+func (m *CircuitBreakerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Active {
-		n += 2
+}
+func (m *CircuitBreakerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerState.Merge(m, src)
+}
+func (m *CircuitBreakerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerState proto.InternalMessageInfo
+
+func (m *CircuitBreakerState) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	return n
+	return ""
 }
 
-func (m *PostedPrice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CircuitBreakerState) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	return time.Time{}
+}
+
+func (m *CircuitBreakerState) GetHalted() bool {
+	if m != nil {
+		return m.Halted
 	}
-	l = len(m.OracleAddress)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	return false
+}
+
+func (m *CircuitBreakerState) GetHaltTime() time.Time {
+	if m != nil {
+		return m.HaltTime
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
-	n += 1 + l + sovStore(uint64(l))
-	return n
+	return time.Time{}
 }
 
-func (m *CurrentPrice) Size() (n int) {
-	if m == nil {
-		return 0
+// FeederDelegation defines a feeder address authorized to post prices on behalf of an oracle
+type FeederDelegation struct {
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	FeederAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=feeder_address,json=feederAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"feeder_address,omitempty"`
+}
+
+func (m *FeederDelegation) Reset()         { *m = FeederDelegation{} }
+func (m *FeederDelegation) String() string { return proto.CompactTextString(m) }
+func (*FeederDelegation) ProtoMessage()    {}
+func (*FeederDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{18}
+}
+func (m *FeederDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeederDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeederDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+}
+func (m *FeederDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeederDelegation.Merge(m, src)
+}
+func (m *FeederDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeederDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeederDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeederDelegation proto.InternalMessageInfo
+
+func (m *FeederDelegation) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return nil
+}
+
+func (m *FeederDelegation) GetFeederAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FeederAddress
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kava.pricefeed.v1beta1.Params")
+	proto.RegisterType((*Market)(nil), "kava.pricefeed.v1beta1.Market")
+	proto.RegisterType((*CircuitBreaker)(nil), "kava.pricefeed.v1beta1.CircuitBreaker")
+	proto.RegisterType((*DerivedPriceSource)(nil), "kava.pricefeed.v1beta1.DerivedPriceSource")
+	proto.RegisterType((*DerivedPriceTerm)(nil), "kava.pricefeed.v1beta1.DerivedPriceTerm")
+	proto.RegisterType((*OracleMonitor)(nil), "kava.pricefeed.v1beta1.OracleMonitor")
+	proto.RegisterType((*TWAPPriceSource)(nil), "kava.pricefeed.v1beta1.TWAPPriceSource")
+	proto.RegisterType((*CommitReveal)(nil), "kava.pricefeed.v1beta1.CommitReveal")
+	proto.RegisterType((*PriceAggregation)(nil), "kava.pricefeed.v1beta1.PriceAggregation")
+	proto.RegisterType((*OracleWeight)(nil), "kava.pricefeed.v1beta1.OracleWeight")
+	proto.RegisterType((*PoolPriceSource)(nil), "kava.pricefeed.v1beta1.PoolPriceSource")
+	proto.RegisterType((*PostedPrice)(nil), "kava.pricefeed.v1beta1.PostedPrice")
+	proto.RegisterType((*CurrentPrice)(nil), "kava.pricefeed.v1beta1.CurrentPrice")
+	proto.RegisterType((*PriceRecord)(nil), "kava.pricefeed.v1beta1.PriceRecord")
+	proto.RegisterType((*PriceCommit)(nil), "kava.pricefeed.v1beta1.PriceCommit")
+	proto.RegisterType((*MissedRevealCount)(nil), "kava.pricefeed.v1beta1.MissedRevealCount")
+	proto.RegisterType((*OracleStats)(nil), "kava.pricefeed.v1beta1.OracleStats")
+	proto.RegisterType((*CircuitBreakerState)(nil), "kava.pricefeed.v1beta1.CircuitBreakerState")
+	proto.RegisterType((*FeederDelegation)(nil), "kava.pricefeed.v1beta1.FeederDelegation")
+}
+
+func init() {
+	proto.RegisterFile("kava/pricefeed/v1beta1/store.proto", fileDescriptor_9df40639f5e16f9a)
+}
+
+var fileDescriptor_9df40639f5e16f9a = []byte{
+	// 1487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x6f, 0x1b, 0xc5,
+	0x1f, 0xcf, 0x3a, 0x8e, 0x63, 0x7f, 0xed, 0xc4, 0xc9, 0x34, 0xbf, 0x74, 0x1b, 0xfd, 0xb0, 0xc3,
+	0x42, 0x4b, 0x78, 0xc4, 0x56, 0x03, 0xe2, 0x42, 0x2e, 0x71, 0xac, 0xd2, 0x20, 0x45, 0x0d, 0x9b,
+	0xa2, 0x88, 0x5e, 0x96, 0xf1, 0xee, 0xc4, 0x5e, 0xc5, 0xbb, 0x63, 0x76, 0x66, 0xf3, 0x38, 0x01,
+	0x27, 0x38, 0xf6, 0xc8, 0x05, 0x71, 0x45, 0x48, 0xdc, 0x7a, 0xe1, 0x0f, 0xa8, 0xd4, 0x63, 0xd5,
+	0x13, 0xe2, 0x90, 0x16, 0xf7, 0x04, 0x67, 0x4e, 0x9c, 0xd0, 0x3c, 0xfc, 0x0c, 0xa9, 0xe2, 0xd6,
+	0x95, 0x7a, 0xca, 0xce, 0xf7, 0xf1, 0x99, 0xef, 0x7c, 0xdf, 0x0e, 0x58, 0x07, 0xf8, 0x10, 0x97,
+	0x5b, 0x91, 0xef, 0x92, 0x7d, 0x42, 0xbc, 0xf2, 0xe1, 0xf5, 0x1a, 0xe1, 0xf8, 0x7a, 0x99, 0x71,
+	0x1a, 0x91, 0x52, 0x2b, 0xa2, 0x9c, 0xa2, 0x45, 0x21, 0x53, 0xea, 0xca, 0x94, 0xb4, 0xcc, 0xd2,
+	0x15, 0x97, 0xb2, 0x80, 0x32, 0x47, 0x4a, 0x95, 0xd5, 0x41, 0xa9, 0x2c, 0x2d, 0xd4, 0x69, 0x9d,
+	0x2a, 0xba, 0xf8, 0xd2, 0xd4, 0x42, 0x9d, 0xd2, 0x7a, 0x93, 0x94, 0xe5, 0xa9, 0x16, 0xef, 0x97,
+	0xbd, 0x38, 0xc2, 0xdc, 0xa7, 0xa1, 0xe6, 0x17, 0x87, 0xf9, 0xdc, 0x0f, 0x08, 0xe3, 0x38, 0x68,
+	0x29, 0x01, 0x6b, 0x17, 0x52, 0x3b, 0x38, 0xc2, 0x01, 0x43, 0x5b, 0x30, 0x1d, 0xe0, 0xe8, 0x80,
+	0x70, 0x66, 0x1a, 0xcb, 0x93, 0x2b, 0xd9, 0xb5, 0x42, 0xe9, 0xbf, 0xad, 0x2c, 0x6d, 0x4b, 0xb1,
+	0x4a, 0xfe, 0xc1, 0x69, 0x71, 0xe2, 0xe7, 0xc7, 0xc5, 0x69, 0x75, 0x66, 0x76, 0x47, 0xdf, 0xfa,
+	0x2b, 0x05, 0x29, 0x45, 0x44, 0x6f, 0x43, 0x46, 0x51, 0x1d, 0xdf, 0x33, 0x8d, 0x65, 0x63, 0x25,
+	0x53, 0xc9, 0xb5, 0x4f, 0x8b, 0x69, 0xc5, 0xde, 0xaa, 0xda, 0x69, 0xc5, 0xde, 0xf2, 0xd0, 0x6b,
+	0x00, 0x35, 0xcc, 0x88, 0x83, 0x19, 0x23, 0xdc, 0x4c, 0x08, 0x59, 0x3b, 0x23, 0x28, 0x1b, 0x82,
+	0x80, 0x8a, 0x90, 0xfd, 0x32, 0xa6, 0xbc, 0xc3, 0x9f, 0x94, 0x7c, 0x90, 0x24, 0x25, 0x50, 0x83,
+	0x69, 0x1a, 0x61, 0xb7, 0x49, 0x98, 0x99, 0x5c, 0x9e, 0x5c, 0xc9, 0x55, 0x6e, 0xfe, 0x73, 0x5a,
+	0x5c, 0xad, 0xfb, 0xbc, 0x11, 0xd7, 0x4a, 0x2e, 0x0d, 0xb4, 0x3f, 0xf5, 0x9f, 0x55, 0xe6, 0x1d,
+	0x94, 0xf9, 0x49, 0x8b, 0xb0, 0xd2, 0x86, 0xeb, 0x6e, 0x78, 0x5e, 0x44, 0x18, 0x7b, 0x74, 0x6f,
+	0xf5, 0x92, 0xf6, 0xba, 0xa6, 0x54, 0x4e, 0x38, 0x61, 0x76, 0x07, 0x18, 0x2d, 0x42, 0x0a, 0xbb,
+	0xdc, 0x3f, 0x24, 0xe6, 0xd4, 0xb2, 0xb1, 0x92, 0xb6, 0xf5, 0x09, 0x7d, 0x0e, 0xf3, 0x2d, 0x4a,
+	0x9b, 0x8e, 0x74, 0x96, 0xc3, 0x68, 0x1c, 0xb9, 0xc4, 0x4c, 0x2d, 0x1b, 0x2b, 0xd9, 0xb5, 0xb7,
+	0xce, 0x73, 0xe3, 0x0e, 0xa5, 0xcd, 0x1d, 0x41, 0xdd, 0x95, 0xe2, 0x95, 0xa4, 0xf0, 0xa7, 0x9d,
+	0x6f, 0x0d, 0x92, 0xd1, 0x0e, 0x64, 0x71, 0xbd, 0x1e, 0x91, 0xba, 0x8c, 0xab, 0x39, 0x2d, 0x41,
+	0x57, 0xce, 0x05, 0x15, 0x94, 0x8d, 0x9e, 0xbc, 0x46, 0xed, 0x87, 0x40, 0xb7, 0x60, 0xc6, 0xa5,
+	0x41, 0xe0, 0x73, 0x27, 0x22, 0x87, 0x04, 0x37, 0xcd, 0xb4, 0xc4, 0x7c, 0xf3, 0x3c, 0xcc, 0x4d,
+	0x29, 0x6c, 0x4b, 0x59, 0x8d, 0x97, 0x73, 0xfb, 0x68, 0xa8, 0x09, 0xf3, 0xfc, 0x08, 0xb7, 0x06,
+	0x5f, 0x9f, 0x79, 0xf6, 0xeb, 0x6f, 0xef, 0x6d, 0xec, 0xf4, 0xbf, 0xfe, 0xb2, 0xc0, 0x6d, 0x9f,
+	0x16, 0xf3, 0x43, 0x0c, 0x3b, 0x2f, 0xa0, 0xfb, 0x1d, 0x62, 0xc3, 0xac, 0x0a, 0x87, 0x13, 0xd0,
+	0xd0, 0xe7, 0x34, 0x32, 0x41, 0x5e, 0x75, 0xf5, 0xbc, 0xab, 0x6e, 0x49, 0xe9, 0x6d, 0x25, 0xac,
+	0x1f, 0x30, 0x43, 0xfb, 0x89, 0xa8, 0x06, 0x0b, 0x1e, 0x89, 0xfc, 0x43, 0xe2, 0x0d, 0x3e, 0x22,
+	0x2b, 0x91, 0xdf, 0x39, 0x0f, 0xb9, 0xaa, 0x74, 0xce, 0x46, 0x11, 0x79, 0x67, 0x38, 0xe8, 0x33,
+	0xc8, 0xbb, 0x7e, 0xe4, 0xc6, 0x3e, 0x77, 0x6a, 0x11, 0xc1, 0x07, 0x24, 0x32, 0x73, 0x12, 0xfe,
+	0xda, 0xb9, 0x8e, 0x57, 0xe2, 0x15, 0x25, 0xad, 0xa1, 0x67, 0xdd, 0x01, 0xaa, 0xf5, 0x63, 0x02,
+	0x66, 0x07, 0x05, 0xd1, 0x3e, 0xcc, 0x05, 0xf8, 0xd8, 0xa9, 0x35, 0xa9, 0x7b, 0xe0, 0xb8, 0x0d,
+	0x1c, 0xd6, 0x89, 0xae, 0xbd, 0xf5, 0x07, 0xa7, 0x45, 0xe3, 0xf7, 0xd3, 0xe2, 0xb5, 0x0b, 0x94,
+	0x45, 0x95, 0xb8, 0x8f, 0xee, 0xad, 0x82, 0xa2, 0x8b, 0x93, 0x3d, 0x1b, 0xe0, 0xe3, 0x8a, 0x00,
+	0xdd, 0x94, 0x98, 0xa8, 0x01, 0xf3, 0xe2, 0x9e, 0x23, 0x3f, 0xf4, 0xe8, 0x51, 0xe7, 0xa2, 0xc4,
+	0x18, 0x2e, 0xca, 0x07, 0xf8, 0x78, 0x4f, 0xa2, 0xea, 0x9b, 0x3e, 0x82, 0x94, 0xba, 0x45, 0xd6,
+	0x7d, 0x76, 0xed, 0x4a, 0x49, 0x35, 0xb6, 0x52, 0xa7, 0xb1, 0x95, 0xaa, 0xba, 0xf1, 0x55, 0xd2,
+	0xc2, 0x4b, 0xdf, 0x3f, 0x2e, 0x1a, 0xb6, 0x56, 0xb1, 0xee, 0x00, 0x3a, 0x1b, 0x28, 0x54, 0x85,
+	0x29, 0x4e, 0xa2, 0xa0, 0xd3, 0xed, 0x56, 0x2e, 0x12, 0xe3, 0xdb, 0x24, 0x0a, 0x74, 0x18, 0x94,
+	0xb2, 0xb5, 0x07, 0x73, 0xc3, 0x02, 0xa3, 0xf4, 0x3c, 0x13, 0xa6, 0xfd, 0xf0, 0x90, 0x44, 0x4c,
+	0xf9, 0x2d, 0x6d, 0x77, 0x8e, 0xd6, 0xb7, 0x06, 0xcc, 0x0c, 0x24, 0x2e, 0xba, 0x09, 0x33, 0x71,
+	0xcb, 0xc3, 0x9c, 0x68, 0x87, 0x9b, 0xc6, 0xc5, 0x5d, 0x91, 0x53, 0x9a, 0xca, 0xa7, 0xe8, 0x3d,
+	0x40, 0x22, 0x6e, 0x81, 0xcf, 0x18, 0xf1, 0x34, 0x1a, 0x93, 0x06, 0xcc, 0xd8, 0x22, 0x73, 0xb6,
+	0x25, 0x43, 0x09, 0x33, 0xeb, 0x04, 0x86, 0x6b, 0x72, 0x94, 0x17, 0xf6, 0x22, 0x97, 0x18, 0x3d,
+	0x72, 0xd7, 0x21, 0xd7, 0xdf, 0x7c, 0xd0, 0xeb, 0x90, 0x6b, 0x35, 0xc4, 0x8c, 0x90, 0xa9, 0xcd,
+	0xe4, 0xd5, 0x49, 0x3b, 0x2b, 0x69, 0x32, 0x31, 0x99, 0xf5, 0xa7, 0x01, 0x73, 0xc3, 0x4d, 0x50,
+	0xcc, 0x8e, 0xc0, 0x0f, 0x9d, 0xce, 0x78, 0x30, 0xe4, 0x4b, 0x21, 0xf0, 0x43, 0xe5, 0x61, 0x86,
+	0x30, 0xcc, 0x08, 0x8f, 0x78, 0xe4, 0xd0, 0x57, 0x6d, 0x76, 0x1c, 0x59, 0x9c, 0x0b, 0xf0, 0x71,
+	0xb5, 0x83, 0x88, 0x3e, 0xed, 0xb6, 0xad, 0x23, 0xe2, 0xd7, 0x1b, 0x9c, 0x99, 0x93, 0xcb, 0x93,
+	0xcf, 0x6a, 0xbb, 0xca, 0xb6, 0x3d, 0x29, 0x3c, 0xd8, 0xb5, 0x14, 0x8d, 0x59, 0xdf, 0x19, 0x90,
+	0xeb, 0x97, 0x42, 0x5f, 0x40, 0x4a, 0x49, 0xc8, 0x27, 0x8e, 0x73, 0x02, 0x6a, 0x5c, 0x31, 0x00,
+	0x95, 0xf9, 0xd2, 0x43, 0x49, 0x5b, 0x9f, 0xac, 0xaf, 0x13, 0x90, 0x1f, 0x1a, 0x68, 0xe8, 0x0d,
+	0x98, 0x96, 0x43, 0xb1, 0x9b, 0x23, 0xd0, 0x3e, 0x2d, 0xa6, 0x84, 0xd4, 0x56, 0xd5, 0x4e, 0x09,
+	0x56, 0xdf, 0xd4, 0xf7, 0x48, 0x48, 0x83, 0xfe, 0xa9, 0x5f, 0x15, 0x84, 0x17, 0x2a, 0x7c, 0xf4,
+	0x01, 0x2c, 0x4a, 0x6c, 0x97, 0xca, 0xa2, 0xf2, 0x69, 0xe8, 0xec, 0x63, 0x57, 0x4c, 0x8c, 0xa4,
+	0xcc, 0x80, 0x05, 0xc1, 0xdd, 0xec, 0x32, 0x6f, 0x48, 0x1e, 0xfa, 0x10, 0x2e, 0xab, 0x45, 0xe3,
+	0xac, 0xda, 0x94, 0x54, 0xfb, 0x9f, 0x64, 0x0f, 0xeb, 0x59, 0xbf, 0x24, 0x20, 0xbb, 0x43, 0x19,
+	0xd7, 0xad, 0x60, 0x94, 0x22, 0xa1, 0xdd, 0xdc, 0xc0, 0xca, 0xe9, 0x66, 0x62, 0xcc, 0xf1, 0xd3,
+	0x99, 0xa3, 0x69, 0xa2, 0xf9, 0xc9, 0x84, 0x53, 0x6b, 0x54, 0xa5, 0x24, 0x5c, 0x77, 0xf1, 0x3c,
+	0xb7, 0x95, 0x32, 0x5a, 0x87, 0x14, 0x39, 0x6e, 0xf9, 0xd1, 0x89, 0xf4, 0x67, 0x76, 0x6d, 0xe9,
+	0x4c, 0x70, 0x6e, 0x77, 0xd6, 0x4d, 0x15, 0x9d, 0xbb, 0x32, 0x3a, 0x4a, 0xc7, 0xfa, 0x0a, 0x72,
+	0x9b, 0x71, 0x14, 0x91, 0x90, 0x8f, 0xec, 0xaf, 0xae, 0xf9, 0x89, 0x17, 0x30, 0xdf, 0xfa, 0x55,
+	0x04, 0x4c, 0x7c, 0xd9, 0xc4, 0xa5, 0x91, 0x37, 0x8a, 0x01, 0x15, 0xc8, 0x74, 0x37, 0x69, 0x33,
+	0x31, 0xc2, 0xe3, 0x7b, 0x6a, 0xc8, 0x1e, 0x8c, 0xc1, 0xfa, 0x68, 0x8f, 0x18, 0xea, 0x35, 0x3a,
+	0x22, 0x75, 0x98, 0x73, 0xe3, 0x20, 0x6e, 0x62, 0xb1, 0x95, 0xaa, 0x55, 0xc6, 0x4c, 0x8e, 0x01,
+	0x3e, 0xdf, 0x43, 0x95, 0x1e, 0xb3, 0xbe, 0x99, 0xd4, 0xbe, 0x53, 0xfd, 0xf9, 0x95, 0x4e, 0x76,
+	0x04, 0xc9, 0x06, 0x66, 0x0d, 0xe9, 0xe7, 0x9c, 0x2d, 0xbf, 0xd1, 0x02, 0x4c, 0x45, 0x34, 0x0e,
+	0x3d, 0xe9, 0x9d, 0xa4, 0xad, 0x0e, 0x68, 0x09, 0xd2, 0x6a, 0x25, 0x26, 0x9e, 0x5e, 0xf0, 0xbb,
+	0xe7, 0x5e, 0xb8, 0x52, 0xe3, 0x0b, 0x57, 0xaf, 0x80, 0xa6, 0x9f, 0xa3, 0x80, 0xee, 0x1b, 0x30,
+	0xaf, 0x46, 0xb5, 0x1a, 0x8f, 0x9b, 0x34, 0x0e, 0x5f, 0xed, 0x48, 0x2c, 0xc0, 0x94, 0x2b, 0x8c,
+	0x94, 0xa1, 0x48, 0xda, 0xea, 0x60, 0xfd, 0x90, 0x84, 0xac, 0x1a, 0x63, 0xbb, 0x1c, 0x73, 0xf6,
+	0x4a, 0xbf, 0xe0, 0x13, 0x98, 0x6d, 0x62, 0xc6, 0x9d, 0x16, 0x65, 0xdc, 0x11, 0xb5, 0x6c, 0x4e,
+	0x8e, 0x10, 0xb9, 0x9c, 0xd0, 0x15, 0x33, 0x42, 0x30, 0xd1, 0x1d, 0xc8, 0xf4, 0x16, 0x8e, 0x71,
+	0x54, 0x69, 0x0f, 0x0e, 0x5d, 0x85, 0xd9, 0xa1, 0xf5, 0x6e, 0x4a, 0xba, 0x7c, 0x26, 0xe8, 0xdf,
+	0xed, 0xd0, 0x3a, 0x2c, 0xb9, 0x34, 0x64, 0xc4, 0x8d, 0x65, 0xc3, 0x18, 0x52, 0x49, 0x49, 0x15,
+	0xb3, 0x4f, 0x62, 0x60, 0x33, 0x44, 0x1f, 0x43, 0x4e, 0x89, 0x3a, 0x8c, 0xe3, 0x88, 0x8f, 0x94,
+	0xc4, 0x59, 0xa5, 0xb9, 0x2b, 0x14, 0xd1, 0xff, 0x21, 0xc3, 0x62, 0xd6, 0x22, 0xa1, 0x47, 0x3c,
+	0xf9, 0x6b, 0x34, 0x6d, 0xf7, 0x08, 0xd6, 0xfd, 0x04, 0x5c, 0x1a, 0xfc, 0x85, 0x23, 0xf2, 0x64,
+	0xa4, 0x81, 0x31, 0x6c, 0x69, 0xe2, 0x79, 0x2d, 0x75, 0xba, 0x40, 0xe3, 0xeb, 0xdd, 0xfa, 0x02,
+	0x35, 0x05, 0x17, 0x21, 0xd5, 0xc0, 0x4d, 0x4e, 0x54, 0x67, 0x4a, 0xdb, 0xfa, 0x84, 0x36, 0x20,
+	0x23, 0xbe, 0x54, 0xce, 0x4d, 0x8d, 0x60, 0x7e, 0x5a, 0xa8, 0x09, 0x86, 0xf5, 0xb7, 0x01, 0x73,
+	0x37, 0x08, 0xf1, 0x48, 0x54, 0x25, 0xcd, 0xce, 0x6a, 0x7c, 0xb6, 0x82, 0x8c, 0x97, 0x5b, 0x41,
+	0x14, 0x66, 0xf7, 0xa5, 0x11, 0x2f, 0xaf, 0x64, 0x15, 0x7e, 0x87, 0xb6, 0xfd, 0xe4, 0x8f, 0x82,
+	0xf1, 0x53, 0xbb, 0x60, 0x3c, 0x68, 0x17, 0x8c, 0x87, 0xed, 0x82, 0xf1, 0xa4, 0x5d, 0x30, 0xee,
+	0x3e, 0x2d, 0x4c, 0x3c, 0x7c, 0x5a, 0x98, 0xf8, 0xed, 0x69, 0x61, 0xe2, 0xce, 0xbb, 0x7d, 0xd7,
+	0x8a, 0x65, 0x7c, 0xb5, 0x89, 0x6b, 0x4c, 0x7e, 0x95, 0x8f, 0xfb, 0xfe, 0x93, 0x27, 0xef, 0xaf,
+	0xa5, 0xa4, 0xb7, 0xdf, 0xff, 0x77, 0x00, 0x52, 0xbd, 0x3b, 0xf4, 0xe8, 0x13, 0x00, 0x00,
+}
+
+func (this *Params) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Params")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Params but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Params but is not nil && this == nil")
+	}
+	if len(this.Markets) != len(that1.Markets) {
+		return fmt.Errorf("Markets this(%v) Not Equal that(%v)", len(this.Markets), len(that1.Markets))
+	}
+	for i := range this.Markets {
+		if !this.Markets[i].Equal(&that1.Markets[i]) {
+			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
+		}
+	}
+	return nil
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Markets) != len(that1.Markets) {
+		return false
+	}
+	for i := range this.Markets {
+		if !this.Markets[i].Equal(&that1.Markets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Market) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Market)
+	if !ok {
+		that2, ok := that.(Market)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Market")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Market but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Market but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.BaseAsset != that1.BaseAsset {
+		return fmt.Errorf("BaseAsset this(%v) Not Equal that(%v)", this.BaseAsset, that1.BaseAsset)
+	}
+	if this.QuoteAsset != that1.QuoteAsset {
+		return fmt.Errorf("QuoteAsset this(%v) Not Equal that(%v)", this.QuoteAsset, that1.QuoteAsset)
+	}
+	if len(this.Oracles) != len(that1.Oracles) {
+		return fmt.Errorf("Oracles this(%v) Not Equal that(%v)", len(this.Oracles), len(that1.Oracles))
+	}
+	for i := range this.Oracles {
+		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
+			return fmt.Errorf("Oracles this[%v](%v) Not Equal that[%v](%v)", i, this.Oracles[i], i, that1.Oracles[i])
+		}
+	}
+	if this.Active != that1.Active {
+		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
+	}
+	if !this.PoolPriceSource.Equal(&that1.PoolPriceSource) {
+		return fmt.Errorf("PoolPriceSource this(%v) Not Equal that(%v)", this.PoolPriceSource, that1.PoolPriceSource)
+	}
+	if !this.Aggregation.Equal(&that1.Aggregation) {
+		return fmt.Errorf("Aggregation this(%v) Not Equal that(%v)", this.Aggregation, that1.Aggregation)
+	}
+	if !this.CommitReveal.Equal(&that1.CommitReveal) {
+		return fmt.Errorf("CommitReveal this(%v) Not Equal that(%v)", this.CommitReveal, that1.CommitReveal)
+	}
+	if !this.TWAPPriceSource.Equal(&that1.TWAPPriceSource) {
+		return fmt.Errorf("TWAPPriceSource this(%v) Not Equal that(%v)", this.TWAPPriceSource, that1.TWAPPriceSource)
+	}
+	if !this.OracleMonitor.Equal(&that1.OracleMonitor) {
+		return fmt.Errorf("OracleMonitor this(%v) Not Equal that(%v)", this.OracleMonitor, that1.OracleMonitor)
+	}
+	if !this.DerivedPriceSource.Equal(&that1.DerivedPriceSource) {
+		return fmt.Errorf("DerivedPriceSource this(%v) Not Equal that(%v)", this.DerivedPriceSource, that1.DerivedPriceSource)
+	}
+	if !this.CircuitBreaker.Equal(&that1.CircuitBreaker) {
+		return fmt.Errorf("CircuitBreaker this(%v) Not Equal that(%v)", this.CircuitBreaker, that1.CircuitBreaker)
+	}
+	return nil
+}
+func (this *Market) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Market)
+	if !ok {
+		that2, ok := that.(Market)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.BaseAsset != that1.BaseAsset {
+		return false
+	}
+	if this.QuoteAsset != that1.QuoteAsset {
+		return false
+	}
+	if len(this.Oracles) != len(that1.Oracles) {
+		return false
+	}
+	for i := range this.Oracles {
+		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
+			return false
+		}
+	}
+	if this.Active != that1.Active {
+		return false
+	}
+	if !this.PoolPriceSource.Equal(&that1.PoolPriceSource) {
+		return false
+	}
+	if !this.Aggregation.Equal(&that1.Aggregation) {
+		return false
+	}
+	if !this.CommitReveal.Equal(&that1.CommitReveal) {
+		return false
+	}
+	if !this.TWAPPriceSource.Equal(&that1.TWAPPriceSource) {
+		return false
+	}
+	if !this.OracleMonitor.Equal(&that1.OracleMonitor) {
+		return false
+	}
+	if !this.DerivedPriceSource.Equal(&that1.DerivedPriceSource) {
+		return false
+	}
+	if !this.CircuitBreaker.Equal(&that1.CircuitBreaker) {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreaker) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CircuitBreaker)
+	if !ok {
+		that2, ok := that.(CircuitBreaker)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CircuitBreaker")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CircuitBreaker but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CircuitBreaker but is not nil && this == nil")
+	}
+	if that1.MaxBlockChange == nil {
+		if this.MaxBlockChange != nil {
+			return fmt.Errorf("this.MaxBlockChange != nil && that1.MaxBlockChange == nil")
+		}
+	} else if !this.MaxBlockChange.Equal(*that1.MaxBlockChange) {
+		return fmt.Errorf("MaxBlockChange this(%v) Not Equal that(%v)", this.MaxBlockChange, that1.MaxBlockChange)
+	}
+	if that1.MaxWindowChange == nil {
+		if this.MaxWindowChange != nil {
+			return fmt.Errorf("this.MaxWindowChange != nil && that1.MaxWindowChange == nil")
+		}
+	} else if !this.MaxWindowChange.Equal(*that1.MaxWindowChange) {
+		return fmt.Errorf("MaxWindowChange this(%v) Not Equal that(%v)", this.MaxWindowChange, that1.MaxWindowChange)
+	}
+	if this.Window != that1.Window {
+		return fmt.Errorf("Window this(%v) Not Equal that(%v)", this.Window, that1.Window)
+	}
+	return nil
+}
+func (this *CircuitBreaker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreaker)
+	if !ok {
+		that2, ok := that.(CircuitBreaker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.MaxBlockChange == nil {
+		if this.MaxBlockChange != nil {
+			return false
+		}
+	} else if !this.MaxBlockChange.Equal(*that1.MaxBlockChange) {
+		return false
+	}
+	if that1.MaxWindowChange == nil {
+		if this.MaxWindowChange != nil {
+			return false
+		}
+	} else if !this.MaxWindowChange.Equal(*that1.MaxWindowChange) {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	return true
+}
+func (this *DerivedPriceSource) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DerivedPriceSource)
+	if !ok {
+		that2, ok := that.(DerivedPriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DerivedPriceSource")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DerivedPriceSource but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DerivedPriceSource but is not nil && this == nil")
+	}
+	if len(this.Terms) != len(that1.Terms) {
+		return fmt.Errorf("Terms this(%v) Not Equal that(%v)", len(this.Terms), len(that1.Terms))
+	}
+	for i := range this.Terms {
+		if !this.Terms[i].Equal(&that1.Terms[i]) {
+			return fmt.Errorf("Terms this[%v](%v) Not Equal that[%v](%v)", i, this.Terms[i], i, that1.Terms[i])
+		}
+	}
+	return nil
+}
+func (this *DerivedPriceSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DerivedPriceSource)
+	if !ok {
+		that2, ok := that.(DerivedPriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Terms) != len(that1.Terms) {
+		return false
+	}
+	for i := range this.Terms {
+		if !this.Terms[i].Equal(&that1.Terms[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DerivedPriceTerm) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DerivedPriceTerm)
+	if !ok {
+		that2, ok := that.(DerivedPriceTerm)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DerivedPriceTerm")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DerivedPriceTerm but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DerivedPriceTerm but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.Inverse != that1.Inverse {
+		return fmt.Errorf("Inverse this(%v) Not Equal that(%v)", this.Inverse, that1.Inverse)
+	}
+	return nil
+}
+func (this *DerivedPriceTerm) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DerivedPriceTerm)
+	if !ok {
+		that2, ok := that.(DerivedPriceTerm)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.Inverse != that1.Inverse {
+		return false
+	}
+	return true
+}
+func (this *OracleMonitor) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleMonitor)
+	if !ok {
+		that2, ok := that.(OracleMonitor)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleMonitor")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleMonitor but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleMonitor but is not nil && this == nil")
+	}
+	if this.UpdateWindow != that1.UpdateWindow {
+		return fmt.Errorf("UpdateWindow this(%v) Not Equal that(%v)", this.UpdateWindow, that1.UpdateWindow)
+	}
+	if this.MaxMissedWindows != that1.MaxMissedWindows {
+		return fmt.Errorf("MaxMissedWindows this(%v) Not Equal that(%v)", this.MaxMissedWindows, that1.MaxMissedWindows)
+	}
+	return nil
+}
+func (this *OracleMonitor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleMonitor)
+	if !ok {
+		that2, ok := that.(OracleMonitor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UpdateWindow != that1.UpdateWindow {
+		return false
+	}
+	if this.MaxMissedWindows != that1.MaxMissedWindows {
+		return false
+	}
+	return true
+}
+func (this *TWAPPriceSource) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*TWAPPriceSource)
+	if !ok {
+		that2, ok := that.(TWAPPriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *TWAPPriceSource")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *TWAPPriceSource but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *TWAPPriceSource but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.Window != that1.Window {
+		return fmt.Errorf("Window this(%v) Not Equal that(%v)", this.Window, that1.Window)
+	}
+	return nil
+}
+func (this *TWAPPriceSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TWAPPriceSource)
+	if !ok {
+		that2, ok := that.(TWAPPriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	return true
+}
+func (this *CommitReveal) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CommitReveal)
+	if !ok {
+		that2, ok := that.(CommitReveal)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CommitReveal")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CommitReveal but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CommitReveal but is not nil && this == nil")
+	}
+	if this.PhaseBlocks != that1.PhaseBlocks {
+		return fmt.Errorf("PhaseBlocks this(%v) Not Equal that(%v)", this.PhaseBlocks, that1.PhaseBlocks)
+	}
+	return nil
+}
+func (this *CommitReveal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommitReveal)
+	if !ok {
+		that2, ok := that.(CommitReveal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PhaseBlocks != that1.PhaseBlocks {
+		return false
+	}
+	return true
+}
+func (this *PriceAggregation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceAggregation)
+	if !ok {
+		that2, ok := that.(PriceAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceAggregation")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceAggregation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceAggregation but is not nil && this == nil")
+	}
+	if this.MinOracles != that1.MinOracles {
+		return fmt.Errorf("MinOracles this(%v) Not Equal that(%v)", this.MinOracles, that1.MinOracles)
+	}
+	if that1.MaxDeviation == nil {
+		if this.MaxDeviation != nil {
+			return fmt.Errorf("this.MaxDeviation != nil && that1.MaxDeviation == nil")
+		}
+	} else if !this.MaxDeviation.Equal(*that1.MaxDeviation) {
+		return fmt.Errorf("MaxDeviation this(%v) Not Equal that(%v)", this.MaxDeviation, that1.MaxDeviation)
+	}
+	if len(this.OracleWeights) != len(that1.OracleWeights) {
+		return fmt.Errorf("OracleWeights this(%v) Not Equal that(%v)", len(this.OracleWeights), len(that1.OracleWeights))
+	}
+	for i := range this.OracleWeights {
+		if !this.OracleWeights[i].Equal(&that1.OracleWeights[i]) {
+			return fmt.Errorf("OracleWeights this[%v](%v) Not Equal that[%v](%v)", i, this.OracleWeights[i], i, that1.OracleWeights[i])
+		}
+	}
+	return nil
+}
+func (this *PriceAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceAggregation)
+	if !ok {
+		that2, ok := that.(PriceAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinOracles != that1.MinOracles {
+		return false
+	}
+	if that1.MaxDeviation == nil {
+		if this.MaxDeviation != nil {
+			return false
+		}
+	} else if !this.MaxDeviation.Equal(*that1.MaxDeviation) {
+		return false
+	}
+	if len(this.OracleWeights) != len(that1.OracleWeights) {
+		return false
+	}
+	for i := range this.OracleWeights {
+		if !this.OracleWeights[i].Equal(&that1.OracleWeights[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *OracleWeight) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleWeight)
+	if !ok {
+		that2, ok := that.(OracleWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleWeight")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleWeight but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleWeight but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.Oracle, that1.Oracle) {
+		return fmt.Errorf("Oracle this(%v) Not Equal that(%v)", this.Oracle, that1.Oracle)
+	}
+	if this.Weight != that1.Weight {
+		return fmt.Errorf("Weight this(%v) Not Equal that(%v)", this.Weight, that1.Weight)
+	}
+	return nil
+}
+func (this *OracleWeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleWeight)
+	if !ok {
+		that2, ok := that.(OracleWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Oracle, that1.Oracle) {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	return true
+}
+func (this *PoolPriceSource) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PoolPriceSource)
+	if !ok {
+		that2, ok := that.(PoolPriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PoolPriceSource")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PoolPriceSource but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PoolPriceSource but is not nil && this == nil")
+	}
+	if this.PoolID != that1.PoolID {
+		return fmt.Errorf("PoolID this(%v) Not Equal that(%v)", this.PoolID, that1.PoolID)
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return fmt.Errorf("BaseDenom this(%v) Not Equal that(%v)", this.BaseDenom, that1.BaseDenom)
+	}
+	if this.Window != that1.Window {
+		return fmt.Errorf("Window this(%v) Not Equal that(%v)", this.Window, that1.Window)
+	}
+	if this.BaseConversionFactor != that1.BaseConversionFactor {
+		return fmt.Errorf("BaseConversionFactor this(%v) Not Equal that(%v)", this.BaseConversionFactor, that1.BaseConversionFactor)
+	}
+	if this.QuoteConversionFactor != that1.QuoteConversionFactor {
+		return fmt.Errorf("QuoteConversionFactor this(%v) Not Equal that(%v)", this.QuoteConversionFactor, that1.QuoteConversionFactor)
+	}
+	return nil
+}
+func (this *PoolPriceSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolPriceSource)
+	if !ok {
+		that2, ok := that.(PoolPriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolID != that1.PoolID {
+		return false
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if this.BaseConversionFactor != that1.BaseConversionFactor {
+		return false
+	}
+	if this.QuoteConversionFactor != that1.QuoteConversionFactor {
+		return false
+	}
+	return true
+}
+func (this *PostedPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PostedPrice)
+	if !ok {
+		that2, ok := that.(PostedPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PostedPrice")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	}
+	return nil
+}
+func (this *PostedPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PostedPrice)
+	if !ok {
+		that2, ok := that.(PostedPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	return true
+}
+func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CurrentPrice")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	return nil
+}
+func (this *CurrentPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
+}
+func (this *PriceRecord) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceRecord)
+	if !ok {
+		that2, ok := that.(PriceRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceRecord")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceRecord but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceRecord but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.CumulativePrice.Equal(that1.CumulativePrice) {
+		return fmt.Errorf("CumulativePrice this(%v) Not Equal that(%v)", this.CumulativePrice, that1.CumulativePrice)
+	}
+	return nil
+}
+func (this *PriceRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceRecord)
+	if !ok {
+		that2, ok := that.(PriceRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.CumulativePrice.Equal(that1.CumulativePrice) {
+		return false
+	}
+	return true
+}
+func (this *PriceCommit) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceCommit)
+	if !ok {
+		that2, ok := that.(PriceCommit)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceCommit")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceCommit but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceCommit but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return fmt.Errorf("Hash this(%v) Not Equal that(%v)", this.Hash, that1.Hash)
+	}
+	if this.Round != that1.Round {
+		return fmt.Errorf("Round this(%v) Not Equal that(%v)", this.Round, that1.Round)
+	}
+	if this.Revealed != that1.Revealed {
+		return fmt.Errorf("Revealed this(%v) Not Equal that(%v)", this.Revealed, that1.Revealed)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	}
+	return nil
+}
+func (this *PriceCommit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceCommit)
+	if !ok {
+		that2, ok := that.(PriceCommit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Revealed != that1.Revealed {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	return true
+}
+func (this *MissedRevealCount) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MissedRevealCount)
+	if !ok {
+		that2, ok := that.(MissedRevealCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MissedRevealCount")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MissedRevealCount but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MissedRevealCount but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.Count != that1.Count {
+		return fmt.Errorf("Count this(%v) Not Equal that(%v)", this.Count, that1.Count)
+	}
+	return nil
+}
+func (this *MissedRevealCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MissedRevealCount)
+	if !ok {
+		that2, ok := that.(MissedRevealCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *OracleStats) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleStats")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleStats but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleStats but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if !this.LastPostTime.Equal(that1.LastPostTime) {
+		return fmt.Errorf("LastPostTime this(%v) Not Equal that(%v)", this.LastPostTime, that1.LastPostTime)
+	}
+	if !this.Deviation.Equal(that1.Deviation) {
+		return fmt.Errorf("Deviation this(%v) Not Equal that(%v)", this.Deviation, that1.Deviation)
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return fmt.Errorf("MissedWindows this(%v) Not Equal that(%v)", this.MissedWindows, that1.MissedWindows)
+	}
+	if this.ConsecutiveMissedWindows != that1.ConsecutiveMissedWindows {
+		return fmt.Errorf("ConsecutiveMissedWindows this(%v) Not Equal that(%v)", this.ConsecutiveMissedWindows, that1.ConsecutiveMissedWindows)
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return fmt.Errorf("WindowStart this(%v) Not Equal that(%v)", this.WindowStart, that1.WindowStart)
+	}
+	if this.Suspended != that1.Suspended {
+		return fmt.Errorf("Suspended this(%v) Not Equal that(%v)", this.Suspended, that1.Suspended)
+	}
+	return nil
+}
+func (this *OracleStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if !this.LastPostTime.Equal(that1.LastPostTime) {
+		return false
+	}
+	if !this.Deviation.Equal(that1.Deviation) {
+		return false
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return false
+	}
+	if this.ConsecutiveMissedWindows != that1.ConsecutiveMissedWindows {
+		return false
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return false
+	}
+	if this.Suspended != that1.Suspended {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreakerState) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CircuitBreakerState)
+	if !ok {
+		that2, ok := that.(CircuitBreakerState)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CircuitBreakerState")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CircuitBreakerState but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CircuitBreakerState but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return fmt.Errorf("WindowStart this(%v) Not Equal that(%v)", this.WindowStart, that1.WindowStart)
+	}
+	if !this.WindowPrice.Equal(that1.WindowPrice) {
+		return fmt.Errorf("WindowPrice this(%v) Not Equal that(%v)", this.WindowPrice, that1.WindowPrice)
+	}
+	if this.Halted != that1.Halted {
+		return fmt.Errorf("Halted this(%v) Not Equal that(%v)", this.Halted, that1.Halted)
+	}
+	if !this.HaltTime.Equal(that1.HaltTime) {
+		return fmt.Errorf("HaltTime this(%v) Not Equal that(%v)", this.HaltTime, that1.HaltTime)
+	}
+	return nil
+}
+func (this *CircuitBreakerState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreakerState)
+	if !ok {
+		that2, ok := that.(CircuitBreakerState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return false
+	}
+	if !this.WindowPrice.Equal(that1.WindowPrice) {
+		return false
+	}
+	if this.Halted != that1.Halted {
+		return false
+	}
+	if !this.HaltTime.Equal(that1.HaltTime) {
+		return false
+	}
+	return true
+}
+func (this *FeederDelegation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*FeederDelegation)
+	if !ok {
+		that2, ok := that.(FeederDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *FeederDelegation")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *FeederDelegation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *FeederDelegation but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if !bytes.Equal(this.FeederAddress, that1.FeederAddress) {
+		return fmt.Errorf("FeederAddress this(%v) Not Equal that(%v)", this.FeederAddress, that1.FeederAddress)
+	}
+	return nil
+}
+func (this *FeederDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeederDelegation)
+	if !ok {
+		that2, ok := that.(FeederDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if !bytes.Equal(this.FeederAddress, that1.FeederAddress) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.DerivedPriceSource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.OracleMonitor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.TWAPPriceSource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.CommitReveal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Aggregation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.PoolPriceSource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintStore(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxWindowChange != nil {
+		{
+			size := m.MaxWindowChange.Size()
+			i -= size
+			if _, err := m.MaxWindowChange.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxBlockChange != nil {
+		{
+			size := m.MaxBlockChange.Size()
+			i -= size
+			if _, err := m.MaxBlockChange.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivedPriceSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedPriceSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedPriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Terms) > 0 {
+		for iNdEx := len(m.Terms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Terms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivedPriceTerm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedPriceTerm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedPriceTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inverse {
+		i--
+		if m.Inverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleMonitor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleMonitor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleMonitor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MaxMissedWindows))
+		i--
+		dAtA[i] = 0x10
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UpdateWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpdateWindow):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintStore(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TWAPPriceSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TWAPPriceSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TWAPPriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStore(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitReveal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitReveal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitReveal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PhaseBlocks != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.PhaseBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAggregation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAggregation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleWeights) > 0 {
+		for iNdEx := len(m.OracleWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxDeviation != nil {
+		{
+			size := m.MaxDeviation.Size()
+			i -= size
+			if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MinOracles != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MinOracles))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolPriceSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPriceSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuoteConversionFactor != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.QuoteConversionFactor))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BaseConversionFactor != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.BaseConversionFactor))
+		i--
+		dAtA[i] = 0x20
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintStore(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStore(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStore(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintStore(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedRevealCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedRevealCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedRevealCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintStore(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x3a
+	if m.ConsecutiveMissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.ConsecutiveMissedWindows))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MissedWindows))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Deviation.Size()
+		i -= size
+		if _, err := m.Deviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastPostTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastPostTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintStore(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.HaltTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.HaltTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintStore(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.WindowPrice.Size()
+		i -= size
+		if _, err := m.WindowPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintStore(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeederDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeederDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeederDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeederAddress) > 0 {
+		i -= len(m.FeederAddress)
+		copy(dAtA[i:], m.FeederAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.FeederAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.Oracles) > 0 {
+		for _, b := range m.Oracles {
+			l = len(b)
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.Active {
+		n += 2
+	}
+	l = m.PoolPriceSource.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.Aggregation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.CommitReveal.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.TWAPPriceSource.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.OracleMonitor.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.DerivedPriceSource.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlockChange != nil {
+		l = m.MaxBlockChange.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.MaxWindowChange != nil {
+		l = m.MaxWindowChange.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *DerivedPriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Terms) > 0 {
+		for _, e := range m.Terms {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DerivedPriceTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Inverse {
+		n += 2
+	}
+	return n
+}
+
+func (m *OracleMonitor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpdateWindow)
+	n += 1 + l + sovStore(uint64(l))
+	if m.MaxMissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.MaxMissedWindows))
+	}
+	return n
+}
+
+func (m *TWAPPriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *CommitReveal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PhaseBlocks != 0 {
+		n += 1 + sovStore(uint64(m.PhaseBlocks))
+	}
+	return n
+}
+
+func (m *PriceAggregation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinOracles != 0 {
+		n += 1 + sovStore(uint64(m.MinOracles))
+	}
+	if m.MaxDeviation != nil {
+		l = m.MaxDeviation.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.OracleWeights) > 0 {
+		for _, e := range m.OracleWeights {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovStore(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *PoolPriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovStore(uint64(l))
+	if m.BaseConversionFactor != 0 {
+		n += 1 + sovStore(uint64(m.BaseConversionFactor))
+	}
+	if m.QuoteConversionFactor != 0 {
+		n += 1 + sovStore(uint64(m.QuoteConversionFactor))
+	}
+	return n
+}
+
+func (m *PostedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *CurrentPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PriceCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovStore(uint64(m.Round))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *MissedRevealCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovStore(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *OracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastPostTime)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.Deviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.MissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.MissedWindows))
+	}
+	if m.ConsecutiveMissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.ConsecutiveMissedWindows))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovStore(uint64(l))
+	if m.Suspended {
+		n += 2
+	}
+	return n
+}
+
+func (m *CircuitBreakerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.WindowPrice.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.Halted {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.HaltTime)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *FeederDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.FeederAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	return n
+}
+
+func sovStore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStore(x uint64) (n int) {
+	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
+			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolPriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Aggregation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommitReveal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TWAPPriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TWAPPriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMonitor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleMonitor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedPriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DerivedPriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxBlockChange = &v
+			if err := m.MaxBlockChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWindowChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxWindowChange = &v
+			if err := m.MaxWindowChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivedPriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedPriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedPriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terms = append(m.Terms, DerivedPriceTerm{})
+			if err := m.Terms[len(m.Terms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivedPriceTerm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedPriceTerm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedPriceTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inverse = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleMonitor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleMonitor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleMonitor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UpdateWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedWindows", wireType)
+			}
+			m.MaxMissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedWindows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TWAPPriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TWAPPriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TWAPPriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitReveal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitReveal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitReveal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseBlocks", wireType)
+			}
+			m.PhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhaseBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceAggregation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceAggregation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracles", wireType)
+			}
+			m.MinOracles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOracles |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxDeviation = &v
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleWeights = append(m.OracleWeights, OracleWeight{})
+			if err := m.OracleWeights[len(m.OracleWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = append(m.Oracle[:0], dAtA[iNdEx:postIndex]...)
+			if m.Oracle == nil {
+				m.Oracle = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolPriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseConversionFactor", wireType)
+			}
+			m.BaseConversionFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseConversionFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteConversionFactor", wireType)
+			}
+			m.QuoteConversionFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteConversionFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	return n
-}
 
-func sovStore(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStore(x uint64) (n int) {
-	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MissedRevealCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -908,17 +6527,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MissedRevealCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MissedRevealCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -928,26 +6547,77 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -969,7 +6639,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *OracleStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,10 +6662,10 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1032,9 +6702,9 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1044,27 +6714,62 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPostTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastPostTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1092,13 +6797,53 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			if err := m.Deviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedWindows", wireType)
+			}
+			m.MissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedWindows", wireType)
+			}
+			m.ConsecutiveMissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1108,27 +6853,28 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
-			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1145,7 +6891,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			m.Suspended = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -1167,7 +6913,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreakerState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,10 +6936,10 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreakerState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreakerState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1230,9 +6976,9 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1242,29 +6988,28 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.OracleAddress == nil {
-				m.OracleAddress = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1292,13 +7037,33 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WindowPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1325,7 +7090,7 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.HaltTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1350,7 +7115,7 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
+func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1373,17 +7138,17 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeederDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1393,29 +7158,31 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1425,24 +7192,24 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FeederAddress = append(m.FeederAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeederAddress == nil {
+				m.FeederAddress = []byte{}
 			}
 			iNdEx = postIndex
 		default:
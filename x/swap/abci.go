@@ -0,0 +1,22 @@
+package swap
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// EndBlocker returns the unfilled amount of expired limit orders to their owners, then fills limit orders
+// crossed by the spot price of their pools at the end of each block.  Expiring and filling orders share a
+// budget of MaxLimitOrdersPerBlock orders each block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	remaining := types.MaxLimitOrdersPerBlock
+	remaining -= k.ExpireLimitOrders(ctx, remaining)
+	k.FillLimitOrders(ctx, remaining)
+}
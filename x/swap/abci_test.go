@@ -0,0 +1,52 @@
+package swap_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/swap"
+	"github.com/kava-labs/kava/x/swap/testutil"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+type abciTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *abciTestSuite) TestEndBlocker_LimitOrderBudget() {
+	suite.Require().NoError(suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)))
+
+	orderCount := types.MaxLimitOrdersPerBlock
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(int64(orderCount+1)*1e3)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e3))
+
+	blockTime := suite.Ctx.BlockTime()
+	for i := 0; i < orderCount; i++ {
+		_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "usdx", sdk.MustNewDecFromStr("10"), blockTime.Add(time.Minute))
+		suite.Require().NoError(err)
+	}
+	crossedID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "usdx", sdk.MustNewDecFromStr("4.5"), blockTime.Add(time.Hour))
+	suite.Require().NoError(err)
+
+	// expiring orders uses the budget of the block before any orders are filled
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime.Add(time.Minute))
+	swap.EndBlocker(suite.Ctx, suite.Keeper)
+	orders := suite.Keeper.GetAllLimitOrders(suite.Ctx)
+	suite.Require().Len(orders, 1)
+	suite.Equal(crossedID, orders[0].ID)
+
+	swap.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.Empty(suite.Keeper.GetAllLimitOrders(suite.Ctx))
+}
+
+func TestABCITestSuite(t *testing.T) {
+	suite.Run(t, new(abciTestSuite))
+}
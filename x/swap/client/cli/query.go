@@ -2,12 +2,15 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/swap/types"
 )
@@ -16,6 +19,8 @@ import (
 const (
 	flagOwner = "owner"
 	flagPool  = "pool"
+	flagRoute = "route"
+	flagQuote = "quote"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -32,6 +37,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		queryParamsCmd(queryRoute),
 		queryDepositsCmd(queryRoute),
 		queryPoolsCmd(queryRoute),
+		queryTWAPCmd(queryRoute),
+		queryQuoteExactInputCmd(queryRoute),
+		queryQuoteExactOutputCmd(queryRoute),
+		queryLimitOrdersCmd(queryRoute),
+		queryDepositPositionCmd(queryRoute),
 	}
 
 	for _, cmd := range cmds {
@@ -152,3 +162,242 @@ func queryPoolsCmd(queryRoute string) *cobra.Command {
 	}
 	return cmd
 }
+
+func queryTWAPCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pool-id] [start-time] [end-time]",
+		Short: "get the time-weighted average price of a pool",
+		Long: strings.TrimSpace(`get the time-weighted average price of both tokens of a pool between two RFC3339 times.
+ 		The end time defaults to the latest block time if not provided:
+ 		Example:
+ 		$ kvcli q swap twap ukava:usdx 2022-01-01T00:00:00Z
+ 		$ kvcli q swap twap ukava:usdx 2022-01-01T00:00:00Z 2022-01-02T00:00:00Z`,
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+
+			var endTime time.Time
+			if len(args) == 3 {
+				endTime, err = time.Parse(time.RFC3339, args[2])
+				if err != nil {
+					return fmt.Errorf("invalid end time: %w", err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolTWAP(context.Background(), &types.QueryPoolTWAPRequest{
+				PoolId:    args[0],
+				StartTime: startTime,
+				EndTime:   endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	return cmd
+}
+
+func queryQuoteExactInputCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote-exact-input [exactCoinA] [denomB]",
+		Short: "quote the output of swapping an exact amount of token a for token b",
+		Long: strings.TrimSpace(`quote the output, fees, price impact and resulting pool reserves of swapping an exact amount of token a for token b.
+ 		The route is an optional comma separated list of denoms to swap through, starting with the token a denom and ending with the token b denom:
+ 		Example:
+ 		$ kvcli q swap quote-exact-input 1000000ukava usdx
+ 		$ kvcli q swap quote-exact-input 1000000ukava hard --route=ukava,usdx,hard`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid exact coin a: %w", err)
+			}
+
+			route, err := cmd.Flags().GetStringSlice(flagRoute)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QuoteSwapExactForTokens(context.Background(), &types.QueryQuoteSwapExactForTokensRequest{
+				ExactTokenA: exactTokenA,
+				TokenBDenom: args[1],
+				SwapRoute:   route,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagRoute, nil, "(optional) comma separated denoms to swap through")
+
+	return cmd
+}
+
+func queryQuoteExactOutputCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote-exact-output [denomA] [exactCoinB]",
+		Short: "quote the input required to swap token a for an exact amount of token b",
+		Long: strings.TrimSpace(`quote the input, fees, price impact and resulting pool reserves of swapping token a for an exact amount of token b.
+ 		The route is an optional comma separated list of denoms to swap through, starting with the token a denom and ending with the token b denom:
+ 		Example:
+ 		$ kvcli q swap quote-exact-output ukava 1000000usdx
+ 		$ kvcli q swap quote-exact-output ukava 1000000hard --route=ukava,usdx,hard`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid exact coin b: %w", err)
+			}
+
+			route, err := cmd.Flags().GetStringSlice(flagRoute)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QuoteSwapForExactTokens(context.Background(), &types.QueryQuoteSwapForExactTokensRequest{
+				TokenADenom: args[0],
+				ExactTokenB: exactTokenB,
+				SwapRoute:   route,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagRoute, nil, "(optional) comma separated denoms to swap through")
+
+	return cmd
+}
+
+func queryLimitOrdersCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders",
+		Short: "get open limit orders",
+		Long: strings.TrimSpace(`get open limit orders:
+ 		Example:
+ 		$ kvcli q swap limit-orders --pool ukava:usdx
+ 		$ kvcli q swap limit-orders --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+ 		$ kvcli q swap limit-orders --page=2 --limit=100
+ 		`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bechOwnerAddr, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			pool, err := cmd.Flags().GetString(flagPool)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryLimitOrdersRequest{
+				Owner:      bechOwnerAddr,
+				PoolId:     pool,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.LimitOrders(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders")
+
+	cmd.Flags().String(flagPool, "", "pool name")
+	cmd.Flags().String(flagOwner, "", "owner of the limit orders")
+
+	return cmd
+}
+
+func queryDepositPositionCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-position [owner] [pool-id]",
+		Short: "get the value of a deposit compared to its cost basis",
+		Long: strings.TrimSpace(`get the value of a liquidity provider's shares compared to their cost basis, the value of holding
+ 		the deposited tokens instead, and the impermanent loss. Values are in the second token of the pool unless a quote denom is given:
+ 		Example:
+ 		$ kvcli q swap deposit-position kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny ukava:usdx
+ 		$ kvcli q swap deposit-position kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny ukava:usdx --quote=ukava`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quoteDenom, err := cmd.Flags().GetString(flagQuote)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DepositPosition(context.Background(), &types.QueryDepositPositionRequest{
+				Owner:      args[0],
+				PoolId:     args[1],
+				QuoteDenom: quoteDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagQuote, "", "denom to value the position in")
+
+	return cmd
+}
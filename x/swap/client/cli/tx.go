@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -31,6 +32,12 @@ func GetTxCmd() *cobra.Command {
 		getCmdWithdraw(),
 		getCmdSwapExactForTokens(),
 		getCmdSwapForExactTokens(),
+		getCmdSwapExactForTokensMultiHop(),
+		getCmdSwapForExactTokensMultiHop(),
+		getCmdZapDeposit(),
+		getCmdPlaceLimitOrder(),
+		getCmdCancelLimitOrder(),
+		getCmdSyncShares(),
 	}
 
 	for _, cmd := range cmds {
@@ -226,3 +233,240 @@ func getCmdSwapForExactTokens() *cobra.Command {
 		},
 	}
 }
+
+func getCmdSwapExactForTokensMultiHop() *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-exact-for-tokens-multi-hop [exactCoinA] [route] [coinB] [slippage] [deadline]",
+		Short: "swap an exact amount of token a for token b through a route of pools",
+		Long:  "The route is a comma separated list of denoms to swap through, starting with the token a denom and ending with the token b denom.",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-exact-for-tokens-multi-hop 1000000uatom uatom,usdx,hard 5000000hard 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := strings.Split(args[1], ",")
+
+			tokenB, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			slippage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapExactForTokensMultiHop(fromAddr.String(), exactTokenA, route, tokenB, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdSwapForExactTokensMultiHop() *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-for-exact-tokens-multi-hop [coinA] [route] [exactCoinB] [slippage] [deadline]",
+		Short: "swap token a for exact amount of token b through a route of pools",
+		Long:  "The route is a comma separated list of denoms to swap through, starting with the token a denom and ending with the token b denom.",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-for-exact-tokens-multi-hop 1000000uatom uatom,usdx,hard 5000000hard 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := strings.Split(args[1], ",")
+
+			exactTokenB, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			slippage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapForExactTokensMultiHop(fromAddr.String(), tokenA, route, exactTokenB, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdZapDeposit() *cobra.Command {
+	return &cobra.Command{
+		Use:   "zap-deposit [tokenA] [denomB] [slippage] [deadline]",
+		Short: "deposit a single coin to a swap liquidity pool",
+		Example: fmt.Sprintf(
+			`%s tx %s zap-deposit 10000000ukava usdx 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgZapDeposit(signer.String(), tokenA, args[1], slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdPlaceLimitOrder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "place-limit-order [sellCoin] [buyDenom] [price] [expiration]",
+		Short: "place a limit order that is filled against a swap liquidity pool",
+		Example: fmt.Sprintf(
+			`%s tx %s place-limit-order 10000000ukava usdx 5.5 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sell, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			expiration, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgPlaceLimitOrder(signer.String(), sell, args[1], price, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdCancelLimitOrder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-limit-order [id]",
+		Short: "cancel a limit order and return the unfilled amount",
+		Example: fmt.Sprintf(
+			`%s tx %s cancel-limit-order 1 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgCancelLimitOrder(signer.String(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdSyncShares() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sync-shares [pool-id]",
+		Short: "sync the deposit shares of a pool to the sender's share token balance",
+		Example: fmt.Sprintf(
+			`%s tx %s sync-shares ukava:usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgSyncShares(signer.String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
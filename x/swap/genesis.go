@@ -22,6 +22,25 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, sh := range gs.ShareRecords {
 		k.SetDepositorShares(ctx, sh)
 	}
+	for _, p := range gs.DepositPositions {
+		k.SetDepositPosition(ctx, p)
+	}
+	// genesis states from before share tokens have no share token balances, so they are minted from the share records
+	if err := k.InitializeShareTokens(ctx); err != nil {
+		panic(fmt.Sprintf("failed to initialize %s share tokens: %s", types.ModuleName, err))
+	}
+	for _, pr := range gs.PoolRecords {
+		if supply := k.GetShareSupply(ctx, pr.PoolID); !supply.Equal(pr.TotalShares) {
+			panic(fmt.Sprintf("share token supply %s does not match pool '%s' total shares %s", supply, pr.PoolID, pr.TotalShares))
+		}
+	}
+	for _, o := range gs.PriceObservations {
+		k.SetPriceObservation(ctx, o)
+	}
+	for _, o := range gs.LimitOrders {
+		k.SetLimitOrder(ctx, o)
+	}
+	k.SetNextLimitOrderID(ctx, gs.NextLimitOrderID)
 }
 
 // ExportGenesis exports the genesis state
@@ -29,6 +48,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	pools := k.GetAllPools(ctx)
 	shares := k.GetAllDepositorShares(ctx)
+	observations := k.GetAllPriceObservations(ctx)
+	orders := k.GetAllLimitOrders(ctx)
+	nextOrderID := k.GetNextLimitOrderID(ctx)
+	positions := k.GetAllDepositPositions(ctx)
 
-	return types.NewGenesisState(params, pools, shares)
+	return types.NewGenesisState(params, pools, shares, observations, orders, nextOrderID, positions)
 }
@@ -2,6 +2,7 @@ package swap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/swap"
@@ -20,10 +21,16 @@ type genesisTestSuite struct {
 func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 	invalidState := types.NewGenesisState(
 		types.Params{
-			SwapFee: sdk.NewDec(-1),
+			SwapFee:          sdk.NewDec(-1),
+			ProtocolFee:      sdk.ZeroDec(),
+			MinInitialShares: sdk.ZeroInt(),
 		},
 		types.PoolRecords{},
 		types.ShareRecords{},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+		types.DefaultDepositPositions,
 	)
 
 	suite.Panics(func() {
@@ -31,6 +38,35 @@ func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 	}, "expected init genesis to panic with invalid state")
 }
 
+func (suite *genesisTestSuite) Test_InitGenesis_ShareSupplyPanic() {
+	depositor, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	suite.Require().NoError(err)
+
+	// the share records mint less share tokens than the pool total shares
+	state := types.NewGenesisState(
+		types.Params{
+			AllowedPools:     types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:          sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFee:      sdk.ZeroDec(),
+			MinInitialShares: sdk.ZeroInt(),
+		},
+		types.PoolRecords{
+			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6))), sdkmath.NewInt(3e6)),
+		},
+		types.ShareRecords{
+			types.NewShareRecord(depositor, types.PoolID("ukava", "usdx"), sdkmath.NewInt(2e6)),
+		},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+		types.DefaultDepositPositions,
+	)
+
+	suite.PanicsWithValue("share token supply 2000000 does not match pool 'ukava:usdx' total shares 3000000", func() {
+		swap.InitGenesis(suite.Ctx, suite.Keeper, state)
+	})
+}
+
 func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	depositor_1, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
 	suite.Require().NoError(err)
@@ -40,8 +76,10 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:     types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:          sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFee:      sdk.ZeroDec(),
+			MinInitialShares: sdk.ZeroInt(),
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
@@ -51,6 +89,20 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("60"), sdk.MustNewDecFromStr("15"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.5")),
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 1, 0, 0, time.UTC), sdk.MustNewDecFromStr("180"), sdk.MustNewDecFromStr("45"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.5")),
+			types.NewPriceObservation(types.PoolID("ukava", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("300"), sdk.MustNewDecFromStr("12"), sdk.MustNewDecFromStr("5"), sdk.MustNewDecFromStr("0.2")),
+		},
+		types.LimitOrders{
+			types.NewLimitOrder(1, depositor_1, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.5"), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)),
+			types.NewLimitOrder(3, depositor_2, sdk.NewCoin("usdx", sdkmath.NewInt(2e6)), "hard", sdk.MustNewDecFromStr("0.45"), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)),
+		},
+		4,
+		types.DepositPositions{
+			types.NewDepositPosition(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+			types.NewDepositPosition(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(2e6)), sdk.NewCoin("usdx", sdkmath.NewInt(4e6))), time.Date(2021, 12, 2, 0, 0, 0, 0, time.UTC)),
+		},
 	)
 
 	swap.InitGenesis(suite.Ctx, suite.Keeper, state)
@@ -66,6 +118,17 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	shareRecord2, _ := suite.Keeper.GetDepositorShares(suite.Ctx, depositor_1, types.PoolID("ukava", "usdx"))
 	suite.Equal(state.ShareRecords[1], shareRecord2)
 
+	// share tokens are minted for share records when the genesis state has no share token supply
+	suite.AccountBalanceEqual(depositor_2, sdk.NewCoins(sdk.NewCoin(types.ShareDenom(types.PoolID("hard", "usdx")), sdkmath.NewInt(1e6))))
+	suite.AccountBalanceEqual(depositor_1, sdk.NewCoins(sdk.NewCoin(types.ShareDenom(types.PoolID("ukava", "usdx")), sdkmath.NewInt(3e6))))
+
+	suite.Equal(state.PriceObservations, suite.Keeper.GetAllPriceObservations(suite.Ctx))
+
+	limitOrder, found := suite.Keeper.GetLimitOrder(suite.Ctx, 3)
+	suite.Require().True(found)
+	suite.Equal(state.LimitOrders[1], limitOrder)
+	suite.Equal(uint64(4), suite.Keeper.GetNextLimitOrderID(suite.Ctx))
+
 	exportedState := swap.ExportGenesis(suite.Ctx, suite.Keeper)
 	suite.Equal(state, exportedState)
 }
@@ -79,8 +142,10 @@ func (suite *genesisTestSuite) Test_Marshall() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:     types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:          sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFee:      sdk.ZeroDec(),
+			MinInitialShares: sdk.ZeroInt(),
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
@@ -90,6 +155,20 @@ func (suite *genesisTestSuite) Test_Marshall() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("60"), sdk.MustNewDecFromStr("15"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.5")),
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 1, 0, 0, time.UTC), sdk.MustNewDecFromStr("180"), sdk.MustNewDecFromStr("45"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.5")),
+			types.NewPriceObservation(types.PoolID("ukava", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("300"), sdk.MustNewDecFromStr("12"), sdk.MustNewDecFromStr("5"), sdk.MustNewDecFromStr("0.2")),
+		},
+		types.LimitOrders{
+			types.NewLimitOrder(1, depositor_1, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.5"), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)),
+			types.NewLimitOrder(3, depositor_2, sdk.NewCoin("usdx", sdkmath.NewInt(2e6)), "hard", sdk.MustNewDecFromStr("0.45"), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)),
+		},
+		4,
+		types.DepositPositions{
+			types.NewDepositPosition(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+			types.NewDepositPosition(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(2e6)), sdk.NewCoin("usdx", sdkmath.NewInt(4e6))), time.Date(2021, 12, 2, 0, 0, 0, 0, time.UTC)),
+		},
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -114,8 +193,11 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:     types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:          sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFee:      sdk.ZeroDec(),
+			PoolCreationFee:  sdk.Coins{},
+			MinInitialShares: sdk.ZeroInt(),
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
@@ -125,6 +207,20 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("60"), sdk.MustNewDecFromStr("15"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.5")),
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 1, 0, 0, time.UTC), sdk.MustNewDecFromStr("180"), sdk.MustNewDecFromStr("45"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.5")),
+			types.NewPriceObservation(types.PoolID("ukava", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("300"), sdk.MustNewDecFromStr("12"), sdk.MustNewDecFromStr("5"), sdk.MustNewDecFromStr("0.2")),
+		},
+		types.LimitOrders{
+			types.NewLimitOrder(1, depositor_1, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.5"), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)),
+			types.NewLimitOrder(3, depositor_2, sdk.NewCoin("usdx", sdkmath.NewInt(2e6)), "hard", sdk.MustNewDecFromStr("0.45"), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)),
+		},
+		4,
+		types.DepositPositions{
+			types.NewDepositPosition(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+			types.NewDepositPosition(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(2e6)), sdk.NewCoin("usdx", sdkmath.NewInt(4e6))), time.Date(2021, 12, 2, 0, 0, 0, 0, time.UTC)),
+		},
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -2,16 +2,21 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	communitytypes "github.com/kava-labs/kava/x/community/types"
 	"github.com/kava-labs/kava/x/swap/types"
 )
 
 // Deposit creates a new pool or adds liquidity to an existing pool.  For a pool to be created, a pool
 // for the coin denominations must not exist yet, and it must be allowed by the swap module parameters.
+// Pools in the allowed pools are curated and are created without a fee.  When permissionless pool creation
+// is enabled, any other constant-product pool may be created by paying the pool creation fee to the
+// community pool, and depositing enough liquidity to mint the minimum initial shares.
 //
 // When adding liquidity to an existing pool, the provided coins are considered to be the desired deposit
 // amount, and the actual deposited coins may be less than or equal to the provided coins.  A deposit
@@ -77,17 +82,67 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 		return errorsmod.Wrapf(types.ErrSlippageExceeded, "slippage %s > limit %s", slippage, slippageLimit)
 	}
 
+	if !found {
+		if err := k.chargePoolCreationFee(ctx, depositor, poolID); err != nil {
+			return err
+		}
+	}
+
+	return k.commitDeposit(ctx, depositor, poolID, pool, depositAmount, shares, depositAmount)
+}
+
+// chargePoolCreationFee sends the pool creation fee from the creator of a pool to the community pool if the
+// pool is not curated, then emits a create pool event.
+func (k Keeper) chargePoolCreationFee(ctx sdk.Context, creator sdk.AccAddress, poolID string) error {
+	curated := k.IsCuratedPool(ctx, poolID)
+
+	fee := sdk.NewCoins()
+	if !curated {
+		fee = k.GetParams(ctx).PoolCreationFee
+	}
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, communitytypes.ModuleAccountName, fee); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapCreatePool,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeyCurated, strconv.FormatBool(curated)),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// commitDeposit stores the updated pool, mints share tokens to the depositor and records its shares and cost
+// basis, calls deposit hooks, transfers the deposit from the depositor, then emits a deposit event.  The cost basis
+// is the amount the depositor paid for the shares, which differs from the deposit amount when part of it was
+// acquired by a swap.
+func (k Keeper) commitDeposit(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	poolID string,
+	pool *types.DenominatedPool,
+	depositAmount sdk.Coins,
+	shares sdkmath.Int,
+	costBasis sdk.Coins,
+) error {
+	position := k.GetCurrentDepositPosition(ctx, depositor, poolID, pool)
+
 	k.updatePool(ctx, poolID, pool)
-	if shareRecord, hasExistingShares := k.GetDepositorShares(ctx, depositor, poolID); hasExistingShares {
-		k.BeforePoolDepositModified(ctx, poolID, depositor, shareRecord.SharesOwned)
-		k.updateDepositorShares(ctx, depositor, poolID, shareRecord.SharesOwned.Add(shares))
-	} else {
-		k.updateDepositorShares(ctx, depositor, poolID, shares)
-		k.AfterPoolDepositCreated(ctx, poolID, depositor, shares)
+	k.beforeSharesModified(ctx, depositor, poolID)
+	if err := k.mintShares(ctx, depositor, poolID, shares); err != nil {
+		return err
 	}
+	k.afterSharesModified(ctx, depositor, poolID)
+	k.updateDepositPosition(ctx, position.AddShares(shares, costBasis, ctx.BlockTime()))
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, depositAmount)
-	if err != nil {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, depositAmount); err != nil {
 		return err
 	}
 
@@ -104,31 +159,50 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 	return nil
 }
 
-func (k Keeper) depositAllowed(ctx sdk.Context, poolID string) bool {
+// IsCuratedPool returns true if a pool is in the allowed pools of the swap module parameters
+func (k Keeper) IsCuratedPool(ctx sdk.Context, poolID string) bool {
+	_, found := k.getAllowedPool(ctx, poolID)
+	return found
+}
+
+func (k Keeper) getAllowedPool(ctx sdk.Context, poolID string) (types.AllowedPool, bool) {
 	params := k.GetParams(ctx)
 	for _, p := range params.AllowedPools {
 		if poolID == types.PoolID(p.TokenA, p.TokenB) {
-			return true
+			return p, true
 		}
 	}
-	return false
+	return types.AllowedPool{}, false
 }
 
 func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.AccAddress, reserves sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	if allowed := k.depositAllowed(ctx, poolID); !allowed {
-		return nil, sdk.Coins{}, sdk.ZeroInt(), errorsmod.Wrap(types.ErrNotAllowed, fmt.Sprintf("can not create pool '%s'", poolID))
+	params := k.GetParams(ctx)
+
+	allowedPool, curated := k.getAllowedPool(ctx, poolID)
+	if !curated {
+		if !params.PermissionlessPoolCreation {
+			return nil, sdk.Coins{}, sdk.ZeroInt(), errorsmod.Wrap(types.ErrNotAllowed, fmt.Sprintf("can not create pool '%s'", poolID))
+		}
+		// pools that are not curated are constant-product pools charged the global swap fee
+		allowedPool = types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	}
 
-	pool, err := types.NewDenominatedPool(reserves)
+	pool, err := types.NewDenominatedPoolFromAllowedPool(allowedPool, reserves)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
 
+	if !curated && pool.TotalShares().LT(params.MinInitialShares) {
+		return nil, sdk.Coins{}, sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInsufficientLiquidity, "initial shares %s < minimum %s", pool.TotalShares(), params.MinInitialShares,
+		)
+	}
+
 	return pool, pool.Reserves(), pool.TotalShares(), nil
 }
 
 func (k Keeper) addLiquidityToPool(ctx sdk.Context, record types.PoolRecord, depositor sdk.AccAddress, desiredAmount sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	pool, err := types.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(record)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
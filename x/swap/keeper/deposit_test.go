@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	communitytypes "github.com/kava-labs/kava/x/community/types"
 	"github.com/kava-labs/kava/x/swap/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -22,6 +23,100 @@ func (suite *keeperTestSuite) TestDeposit_CreatePool_PoolNotAllowed() {
 	suite.Require().EqualError(err, "can not create pool 'ukava:usdx': not allowed")
 }
 
+func (suite *keeperTestSuite) TestDeposit_CreatePool_Permissionless() {
+	params := types.NewParams(types.AllowedPools{}, types.DefaultSwapFee)
+	params.PermissionlessPoolCreation = true
+	params.PoolCreationFee = sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(5e6)))
+	params.MinInitialShares = sdkmath.NewInt(1e6)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	depositA := sdk.NewCoin("hard", sdkmath.NewInt(10e6))
+	depositB := sdk.NewCoin("ukava", sdkmath.NewInt(50e6))
+	balance := sdk.NewCoins(depositA, depositB.Add(params.PoolCreationFee[0]))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+
+	poolID := types.PoolID("hard", "ukava")
+	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), sdkmath.NewInt(22360679))))
+	suite.ModuleAccountBalanceEqual(sdk.NewCoins(depositA, depositB))
+	suite.PoolLiquidityEqual(sdk.NewCoins(depositA, depositB))
+
+	communityAddress := suite.AccountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+	suite.Equal(params.PoolCreationFee, suite.BankKeeper.GetAllBalances(suite.Ctx, communityAddress))
+
+	// pools that are not curated are constant-product pools charged the global swap fee
+	record, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(types.POOL_TYPE_CONSTANT_PRODUCT, record.PoolType)
+	suite.True(record.SwapFee.IsZero())
+	suite.False(suite.Keeper.IsCuratedPool(suite.Ctx, poolID))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapCreatePool,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyCreator, depositor.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyCurated, "false"),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, params.PoolCreationFee.String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestDeposit_CreatePool_Permissionless_MinInitialShares() {
+	params := types.NewParams(types.AllowedPools{}, types.DefaultSwapFee)
+	params.PermissionlessPoolCreation = true
+	params.MinInitialShares = sdkmath.NewInt(1e6)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	depositA := sdk.NewCoin("hard", sdkmath.NewInt(1e5))
+	depositB := sdk.NewCoin("ukava", sdkmath.NewInt(5e5))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), sdk.NewCoins(depositA, depositB))
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().EqualError(err, "initial shares 223606 < minimum 1000000: insufficient liquidity")
+	suite.PoolDeleted("hard", "ukava")
+}
+
+func (suite *keeperTestSuite) TestDeposit_CreatePool_Permissionless_InsufficientFee() {
+	params := types.NewParams(types.AllowedPools{}, types.DefaultSwapFee)
+	params.PermissionlessPoolCreation = true
+	params.PoolCreationFee = sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(5e6)))
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	depositA := sdk.NewCoin("hard", sdkmath.NewInt(10e6))
+	depositB := sdk.NewCoin("usdx", sdkmath.NewInt(50e6))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), sdk.NewCoins(depositA, depositB))
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (suite *keeperTestSuite) TestDeposit_CreatePool_Curated() {
+	pool := types.NewAllowedPool("ukava", "usdx")
+	params := types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee)
+	params.PermissionlessPoolCreation = true
+	params.PoolCreationFee = sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(5e6)))
+	params.MinInitialShares = sdkmath.NewInt(1e9)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	deposit := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), deposit)
+
+	// curated pools do not pay the pool creation fee or require the minimum initial shares
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), deposit[0], deposit[1], sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+	suite.PoolLiquidityEqual(deposit)
+	suite.True(suite.Keeper.IsCuratedPool(suite.Ctx, pool.Name()))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapCreatePool,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyCreator, depositor.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyCurated, "true"),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, ""),
+	))
+}
+
 func (suite *keeperTestSuite) TestDeposit_InsufficientFunds() {
 	testCases := []struct {
 		name     string
@@ -160,7 +255,11 @@ func (suite *keeperTestSuite) TestDeposit_CreatePool() {
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
 	suite.Require().NoError(err)
-	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(amountA.Sub(depositA), amountB.Sub(depositB)))
+	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(
+		amountA.Sub(depositA),
+		amountB.Sub(depositB),
+		sdk.NewCoin(types.ShareDenom(pool.Name()), sdkmath.NewInt(22360679)),
+	))
 	suite.ModuleAccountBalanceEqual(sdk.NewCoins(depositA, depositB))
 	suite.PoolLiquidityEqual(deposit)
 	suite.PoolShareValueEqual(depositor, pool, deposit)
@@ -174,6 +273,70 @@ func (suite *keeperTestSuite) TestDeposit_CreatePool() {
 	))
 }
 
+func (suite *keeperTestSuite) TestDeposit_CreatePool_StableSwap() {
+	pool := types.NewStableSwapAllowedPool("usdc", "usdx", 100)
+	suite.Require().NoError(pool.Validate())
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+
+	depositA := sdk.NewCoin(pool.TokenA, sdkmath.NewInt(50e6))
+	depositB := sdk.NewCoin(pool.TokenB, sdkmath.NewInt(50e6))
+	deposit := sdk.NewCoins(depositA, depositB)
+	depositor := suite.CreateAccount(deposit.Add(deposit...))
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+	suite.PoolLiquidityEqual(deposit)
+	suite.PoolShareValueEqual(depositor, pool, deposit)
+
+	// the pool type and amplification are recorded when the pool is created
+	record, found := suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(types.POOL_TYPE_STABLESWAP, record.PoolType)
+	suite.Equal(uint64(100), record.Amplification)
+
+	// and are not changed by later param changes
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(types.NewStableSwapAllowedPool("usdc", "usdx", 5)), types.DefaultSwapFee))
+	err = suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+	record, found = suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(uint64(100), record.Amplification)
+}
+
+func (suite *keeperTestSuite) TestDeposit_CreatePool_Weighted() {
+	pool := types.NewWeightedAllowedPool("ukava", "usdx", 80, 20)
+	suite.Require().NoError(pool.Validate())
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+
+	depositA := sdk.NewCoin(pool.TokenA, sdkmath.NewInt(40e6))
+	depositB := sdk.NewCoin(pool.TokenB, sdkmath.NewInt(10e6))
+	deposit := sdk.NewCoins(depositA, depositB)
+	depositor := suite.CreateAccount(deposit.Add(deposit...))
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+	suite.PoolLiquidityEqual(deposit)
+	suite.PoolShareValueEqual(depositor, pool, deposit)
+
+	// the pool type and weights are recorded when the pool is created
+	record, found := suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(types.POOL_TYPE_WEIGHTED, record.PoolType)
+	suite.Equal(uint64(80), record.WeightA)
+	suite.Equal(uint64(20), record.WeightB)
+	suite.Equal(sdkmath.NewInt(30314331), record.TotalShares)
+
+	// and are not changed by later param changes
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(types.NewWeightedAllowedPool("ukava", "usdx", 50, 50)), types.DefaultSwapFee))
+	err = suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+	record, found = suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(uint64(80), record.WeightA)
+	suite.Equal(uint64(20), record.WeightB)
+	suite.PoolLiquidityEqual(deposit.Add(deposit...))
+}
+
 func (suite *keeperTestSuite) TestDeposit_PoolExists() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	reserves := sdk.NewCoins(
@@ -207,7 +370,9 @@ func (suite *keeperTestSuite) TestDeposit_PoolExists() {
 		sdk.NewCoin("usdx", sdkmath.NewInt(4999998)),
 	)
 
-	suite.AccountBalanceEqual(depositor.GetAddress(), balance.Sub(expectedDeposit...))
+	suite.AccountBalanceEqual(depositor.GetAddress(), balance.Sub(expectedDeposit...).Add(
+		sdk.NewCoin(types.ShareDenom(pool.Name()), sdkmath.NewInt(2236067)),
+	))
 	suite.ModuleAccountBalanceEqual(reserves.Add(expectedDeposit...))
 	suite.PoolLiquidityEqual(reserves.Add(expectedDeposit...))
 	suite.PoolShareValueEqual(depositor, pool, expectedShareValue)
@@ -243,7 +408,7 @@ func (suite *keeperTestSuite) TestDeposit_MultipleDeposit() {
 	totalDeposit := reserves.Add(fundsToDeposit...)
 	totalShares := initialShares.Add(sdkmath.NewInt(15e6))
 
-	suite.AccountBalanceEqual(owner.GetAddress(), sdk.Coins{})
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), totalShares)))
 	suite.ModuleAccountBalanceEqual(totalDeposit)
 	suite.PoolLiquidityEqual(totalDeposit)
 	suite.PoolDepositorSharesEqual(owner.GetAddress(), poolID, totalShares)
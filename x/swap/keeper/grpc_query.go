@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -59,15 +60,22 @@ func (s queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*ty
 		}
 
 		if shouldAccumulate {
-			denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+			denominatedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 			if err != nil {
 				return true, types.ErrInvalidPool
 			}
+			s.keeper.setPoolSwapFee(ctx, poolRecord.PoolID, denominatedPool)
 			totalCoins := denominatedPool.ShareValue(denominatedPool.TotalShares())
 			queryResult := types.PoolResponse{
-				Name:        poolRecord.PoolID,
-				Coins:       totalCoins,
-				TotalShares: denominatedPool.TotalShares(),
+				Name:          poolRecord.PoolID,
+				Coins:         totalCoins,
+				TotalShares:   denominatedPool.TotalShares(),
+				PoolType:      denominatedPool.PoolType(),
+				Amplification: denominatedPool.Amplification(),
+				WeightA:       denominatedPool.WeightA(),
+				WeightB:       denominatedPool.WeightB(),
+				SwapFee:       denominatedPool.SwapFee(),
+				Curated:       s.keeper.IsCuratedPool(ctx, poolRecord.PoolID),
 			}
 			queryResults = append(queryResults, queryResult)
 		}
@@ -148,3 +156,224 @@ func (s queryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 		Pagination: pageRes,
 	}, nil
 }
+
+// PoolTWAP implements the Query/PoolTWAP gRPC method
+func (s queryServer) PoolTWAP(c context.Context, req *types.QueryPoolTWAPRequest) (*types.QueryPoolTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	endTime := req.EndTime
+	if endTime.IsZero() {
+		endTime = ctx.BlockTime()
+	}
+
+	priceA, priceB, err := s.keeper.GetTWAP(ctx, req.PoolId, req.StartTime, endTime)
+	if err != nil {
+		if errors.Is(err, types.ErrPriceHistoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPoolTWAPResponse{
+		PoolId:    req.PoolId,
+		PriceA:    priceA,
+		PriceB:    priceB,
+		StartTime: req.StartTime,
+		EndTime:   endTime,
+	}, nil
+}
+
+// QuoteSwapExactForTokens implements the Query/QuoteSwapExactForTokens gRPC method
+func (s queryServer) QuoteSwapExactForTokens(c context.Context, req *types.QueryQuoteSwapExactForTokensRequest) (*types.QueryQuoteSwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.ExactTokenA.Validate(); err != nil || !req.ExactTokenA.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid exact token a: %s", req.ExactTokenA)
+	}
+
+	route := req.SwapRoute
+	if len(route) == 0 {
+		route = []string{req.ExactTokenA.Denom, req.TokenBDenom}
+	}
+	if err := types.ValidateRoute(route, req.ExactTokenA.Denom, req.TokenBDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	hops, err := s.keeper.calculateExactInputHops(ctx, req.ExactTokenA, route)
+	if err != nil {
+		return nil, quoteError(err)
+	}
+
+	return newQuoteSwapResponse(hops), nil
+}
+
+// QuoteSwapForExactTokens implements the Query/QuoteSwapForExactTokens gRPC method
+func (s queryServer) QuoteSwapForExactTokens(c context.Context, req *types.QueryQuoteSwapForExactTokensRequest) (*types.QueryQuoteSwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.ExactTokenB.Validate(); err != nil || !req.ExactTokenB.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid exact token b: %s", req.ExactTokenB)
+	}
+
+	route := req.SwapRoute
+	if len(route) == 0 {
+		route = []string{req.TokenADenom, req.ExactTokenB.Denom}
+	}
+	if err := types.ValidateRoute(route, req.TokenADenom, req.ExactTokenB.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	hops, err := s.keeper.calculateExactOutputHops(ctx, route, req.ExactTokenB)
+	if err != nil {
+		return nil, quoteError(err)
+	}
+
+	return newQuoteSwapResponse(hops), nil
+}
+
+// quoteError converts an error calculating a swap quote to a gRPC status error
+func quoteError(err error) error {
+	if errors.Is(err, types.ErrInvalidPool) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.FailedPrecondition, err.Error())
+}
+
+// LimitOrders implements the Query/LimitOrders gRPC method
+func (s queryServer) LimitOrders(c context.Context, req *types.QueryLimitOrdersRequest) (*types.QueryLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.LimitOrderPrefix)
+
+	orders := types.LimitOrders{}
+	pageRes, err := query.FilteredPaginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var order types.LimitOrder
+			if err := s.keeper.cdc.Unmarshal(value, &order); err != nil {
+				return false, err
+			}
+
+			// Filter for results match the request's pool ID/owner params if given
+			matchOwner, matchPool := true, true
+			if len(req.Owner) > 0 {
+				matchOwner = order.Owner == req.Owner
+			}
+			if len(req.PoolId) > 0 {
+				matchPool = order.PoolID == req.PoolId
+			}
+			if !(matchOwner && matchPool) {
+				return false, nil
+			}
+			if accumulate {
+				orders = append(orders, order)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLimitOrdersResponse{
+		LimitOrders: orders,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// DepositPosition implements the Query/DepositPosition gRPC method
+func (s queryServer) DepositPosition(c context.Context, req *types.QueryDepositPositionRequest) (*types.QueryDepositPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, err := s.keeper.loadDenominatedPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "pool %s not found", req.PoolId)
+	}
+
+	quoteDenom := req.QuoteDenom
+	if quoteDenom == "" {
+		quoteDenom = pool.Reserves()[1].Denom
+	}
+	if pool.Reserves().AmountOf(quoteDenom).IsZero() {
+		return nil, status.Errorf(codes.InvalidArgument, "quote denom %s is not in pool %s", quoteDenom, req.PoolId)
+	}
+
+	position := s.keeper.GetCurrentDepositPosition(ctx, owner, req.PoolId, pool)
+	if position.Shares.IsZero() {
+		return nil, status.Errorf(codes.NotFound, "no deposit position for account %s and pool %s", owner, req.PoolId)
+	}
+
+	sharesValue := pool.ShareValue(position.Shares)
+	positionValue := pool.SpotValue(sharesValue, quoteDenom)
+	holdValue := pool.SpotValue(position.CostBasis, quoteDenom)
+
+	impermanentLoss := sdk.ZeroDec()
+	if holdValue.IsPositive() {
+		impermanentLoss = sdk.NewDecFromInt(positionValue.Amount).QuoInt(holdValue.Amount).Sub(sdk.OneDec())
+	}
+
+	return &types.QueryDepositPositionResponse{
+		Depositor:          owner.String(),
+		PoolId:             req.PoolId,
+		SharesOwned:        position.Shares,
+		SharesValue:        sharesValue,
+		CostBasis:          position.CostBasis,
+		AverageDepositTime: position.AverageDepositTime,
+		PositionValue:      positionValue,
+		HoldValue:          holdValue,
+		ImpermanentLoss:    impermanentLoss,
+	}, nil
+}
+
+// newQuoteSwapResponse returns a quote response for the trades against each pool in a route
+func newQuoteSwapResponse(hops []swapHop) *types.QueryQuoteSwapResponse {
+	quoteHops := make([]types.SwapQuoteHop, len(hops))
+	fees := sdk.NewCoins()
+	// the price impact of the route compounds the price impact of each pool
+	priceRatio := sdk.OneDec()
+	for i, hop := range hops {
+		priceImpact := hop.priceImpact()
+
+		quoteHops[i] = types.SwapQuoteHop{
+			PoolID:        hop.poolID,
+			TokenIn:       hop.input,
+			TokenOut:      hop.output,
+			Fee:           hop.fee,
+			PriceImpact:   priceImpact,
+			ReservesAfter: hop.pool.Reserves(),
+			ProtocolFee:   hop.protocolFee,
+		}
+		fees = fees.Add(hop.fee)
+		priceRatio = priceRatio.Mul(sdk.OneDec().Sub(priceImpact))
+	}
+
+	return &types.QueryQuoteSwapResponse{
+		TokenA:      hops[0].input,
+		TokenB:      hops[len(hops)-1].output,
+		Fees:        fees,
+		PriceImpact: sdk.OneDec().Sub(priceRatio),
+		Hops:        quoteHops,
+	}
+}
@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *keeperTestSuite) setupQuotePools() {
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("quote-depositor"), sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(100e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(100e6)),
+		sdk.NewCoin("hard", sdkmath.NewInt(100e6)),
+	))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(
+			types.NewAllowedPool("ukava", "usdx"),
+			types.NewAllowedPool("hard", "usdx"),
+		),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)), sdk.MustNewDecFromStr("1"))
+	suite.Require().NoError(err)
+	err = suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("hard", sdkmath.NewInt(40e6)), sdk.NewCoin("usdx", sdkmath.NewInt(20e6)), sdk.MustNewDecFromStr("1"))
+	suite.Require().NoError(err)
+}
+
+func (suite *keeperTestSuite) TestQuoteSwapExactForTokens() {
+	suite.setupQuotePools()
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	poolsBefore := suite.Keeper.GetAllPools(suite.Ctx)
+
+	res, err := queryServer.QuoteSwapExactForTokens(sdk.WrapSDKContext(suite.Ctx), &types.QueryQuoteSwapExactForTokensRequest{
+		ExactTokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		TokenBDenom: "usdx",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(poolsBefore, suite.Keeper.GetAllPools(suite.Ctx), "expected quote to not change pools")
+
+	suite.Equal(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), res.TokenA)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(4533054)), res.TokenB)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(3000))), res.Fees)
+	suite.Require().Len(res.Hops, 1)
+	suite.Equal("ukava:usdx", res.Hops[0].PoolID)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(11e6)), sdk.NewCoin("usdx", sdkmath.NewInt(45466946))), res.Hops[0].ReservesAfter)
+	// 1 - 4533054 / (997000 * 5)
+	suite.Equal(sdk.MustNewDecFromStr("0.090661183550651956"), res.PriceImpact)
+	suite.Equal(res.PriceImpact, res.Hops[0].PriceImpact)
+	suite.Equal(sdk.NewCoin("ukava", sdkmath.ZeroInt()), res.Hops[0].ProtocolFee)
+
+	// the quote matches the result of the swap
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), res.TokenA, res.TokenB, sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(requester.GetAddress(), sdk.NewCoins(res.TokenB))
+	suite.PoolReservesEqual("ukava:usdx", res.Hops[0].ReservesAfter)
+}
+
+func (suite *keeperTestSuite) TestQuoteSwapExactForTokens_MultiHop() {
+	suite.setupQuotePools()
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	res, err := queryServer.QuoteSwapExactForTokens(sdk.WrapSDKContext(suite.Ctx), &types.QueryQuoteSwapExactForTokensRequest{
+		ExactTokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		TokenBDenom: "hard",
+		SwapRoute:   []string{"ukava", "usdx", "hard"},
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(res.Hops, 2)
+	suite.Equal(res.Hops[0].TokenOut, res.Hops[1].TokenIn)
+	suite.Equal(res.Hops[1].TokenOut, res.TokenB)
+	suite.Equal(sdk.NewCoins(res.Hops[0].Fee, res.Hops[1].Fee), res.Fees)
+
+	expectedImpact := sdk.OneDec().Sub(
+		sdk.OneDec().Sub(res.Hops[0].PriceImpact).Mul(sdk.OneDec().Sub(res.Hops[1].PriceImpact)),
+	)
+	suite.Equal(expectedImpact, res.PriceImpact)
+	suite.True(res.PriceImpact.GT(res.Hops[0].PriceImpact))
+
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), res.TokenA, []string{"ukava", "usdx", "hard"}, res.TokenB, sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(requester.GetAddress(), sdk.NewCoins(res.TokenB))
+	suite.PoolReservesEqual("ukava:usdx", res.Hops[0].ReservesAfter)
+	suite.PoolReservesEqual("hard:usdx", res.Hops[1].ReservesAfter)
+}
+
+func (suite *keeperTestSuite) TestQuoteSwapExactForTokens_ProtocolFee() {
+	suite.setupQuotePools()
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.ProtocolFee = sdk.MustNewDecFromStr("0.5")
+	suite.Keeper.SetParams(suite.Ctx, params)
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	res, err := queryServer.QuoteSwapExactForTokens(sdk.WrapSDKContext(suite.Ctx), &types.QueryQuoteSwapExactForTokensRequest{
+		ExactTokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		TokenBDenom: "hard",
+		SwapRoute:   []string{"ukava", "usdx", "hard"},
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(res.Hops, 2)
+	suite.Equal(sdk.NewCoin("ukava", sdkmath.NewInt(1500)), res.Hops[0].ProtocolFee)
+	suite.Equal(res.Hops[1].Fee.Amount.QuoRaw(2), res.Hops[1].ProtocolFee.Amount)
+
+	communityAddr := suite.AccountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+	communityBalance := suite.BankKeeper.GetAllBalances(suite.Ctx, communityAddr)
+
+	// the quote matches the result of the swap, with protocol fees sent to the community pool
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), res.TokenA, []string{"ukava", "usdx", "hard"}, res.TokenB, sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(requester.GetAddress(), sdk.NewCoins(res.TokenB))
+	suite.AccountBalanceEqual(communityAddr, communityBalance.Add(res.Hops[0].ProtocolFee, res.Hops[1].ProtocolFee))
+	suite.PoolReservesEqual("ukava:usdx", res.Hops[0].ReservesAfter)
+	suite.PoolReservesEqual("hard:usdx", res.Hops[1].ReservesAfter)
+}
+
+func (suite *keeperTestSuite) TestQuoteSwapForExactTokens_MultiHop() {
+	suite.setupQuotePools()
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	poolsBefore := suite.Keeper.GetAllPools(suite.Ctx)
+
+	res, err := queryServer.QuoteSwapForExactTokens(sdk.WrapSDKContext(suite.Ctx), &types.QueryQuoteSwapForExactTokensRequest{
+		TokenADenom: "ukava",
+		ExactTokenB: sdk.NewCoin("hard", sdkmath.NewInt(1e6)),
+		SwapRoute:   []string{"ukava", "usdx", "hard"},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(poolsBefore, suite.Keeper.GetAllPools(suite.Ctx), "expected quote to not change pools")
+
+	suite.Require().Len(res.Hops, 2)
+	suite.Equal(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), res.TokenB)
+	suite.Equal(res.Hops[0].TokenIn, res.TokenA)
+	suite.Equal(res.Hops[0].TokenOut, res.Hops[1].TokenIn)
+	suite.True(res.PriceImpact.IsPositive())
+
+	requester := suite.CreateAccount(sdk.NewCoins(res.TokenA))
+	err = suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), res.TokenA, []string{"ukava", "usdx", "hard"}, res.TokenB, sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(requester.GetAddress(), sdk.NewCoins(res.TokenB))
+	suite.PoolReservesEqual("ukava:usdx", res.Hops[0].ReservesAfter)
+	suite.PoolReservesEqual("hard:usdx", res.Hops[1].ReservesAfter)
+}
+
+func (suite *keeperTestSuite) TestQuoteSwap_Errors() {
+	suite.setupQuotePools()
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	testCases := []struct {
+		name     string
+		query    func() error
+		expected codes.Code
+	}{
+		{
+			name: "zero exact input",
+			query: func() error {
+				_, err := queryServer.QuoteSwapExactForTokens(ctx, &types.QueryQuoteSwapExactForTokensRequest{
+					ExactTokenA: sdk.NewCoin("ukava", sdkmath.ZeroInt()),
+					TokenBDenom: "usdx",
+				})
+				return err
+			},
+			expected: codes.InvalidArgument,
+		},
+		{
+			name: "invalid route",
+			query: func() error {
+				_, err := queryServer.QuoteSwapExactForTokens(ctx, &types.QueryQuoteSwapExactForTokensRequest{
+					ExactTokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+					TokenBDenom: "hard",
+					SwapRoute:   []string{"ukava", "hard", "usdx"},
+				})
+				return err
+			},
+			expected: codes.InvalidArgument,
+		},
+		{
+			name: "pool not found",
+			query: func() error {
+				_, err := queryServer.QuoteSwapExactForTokens(ctx, &types.QueryQuoteSwapExactForTokensRequest{
+					ExactTokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+					TokenBDenom: "hard",
+				})
+				return err
+			},
+			expected: codes.NotFound,
+		},
+		{
+			name: "output rounds to zero",
+			query: func() error {
+				_, err := queryServer.QuoteSwapExactForTokens(ctx, &types.QueryQuoteSwapExactForTokensRequest{
+					ExactTokenA: sdk.NewCoin("usdx", sdkmath.NewInt(1)),
+					TokenBDenom: "ukava",
+				})
+				return err
+			},
+			expected: codes.FailedPrecondition,
+		},
+		{
+			name: "output exceeds reserves",
+			query: func() error {
+				_, err := queryServer.QuoteSwapForExactTokens(ctx, &types.QueryQuoteSwapForExactTokensRequest{
+					TokenADenom: "usdx",
+					ExactTokenB: sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+				})
+				return err
+			},
+			expected: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.query()
+			suite.Require().Error(err)
+			suite.Equal(tc.expected, status.Code(err))
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestPools_Curated() {
+	params := types.NewParams(types.NewAllowedPools(types.NewAllowedPool("ukava", "usdx")), types.DefaultSwapFee)
+	params.PermissionlessPoolCreation = true
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(100e6)),
+	))
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)), sdk.OneDec())
+	suite.Require().NoError(err)
+	err = suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("hard", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)), sdk.OneDec())
+	suite.Require().NoError(err)
+
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	res, err := queryServer.Pools(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolsRequest{})
+	suite.Require().NoError(err)
+
+	curated := make(map[string]bool)
+	for _, pool := range res.Pools {
+		curated[pool.Name] = pool.Curated
+	}
+	suite.Equal(map[string]bool{"hard:usdx": false, "ukava:usdx": true}, curated)
+}
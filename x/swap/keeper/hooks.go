@@ -23,3 +23,10 @@ func (k Keeper) BeforePoolDepositModified(ctx sdk.Context, poolID string, deposi
 		k.hooks.BeforePoolDepositModified(ctx, poolID, depositor, sharesOwned)
 	}
 }
+
+// AfterPoolSwap - call hook if registered
+func (k Keeper) AfterPoolSwap(ctx sdk.Context, poolID string, requester sdk.AccAddress, swapInput sdk.Coin, swapOutput sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterPoolSwap(ctx, poolID, requester, swapInput, swapOutput)
+	}
+}
@@ -196,3 +196,37 @@ func (suite *keeperTestSuite) TestHooks_HookOrdering() {
 	err = suite.Keeper.Withdraw(suite.Ctx, depositor.GetAddress(), existingShareRecord.SharesOwned.Quo(sdkmath.NewInt(2)), sdk.NewCoin("ukava", sdkmath.NewInt(1)), sdk.NewCoin("usdx", sdkmath.NewInt(1)))
 	suite.Require().NoError(err)
 }
+
+func (suite *keeperTestSuite) TestHooks_MultiHopSwap() {
+	suite.Keeper.ClearHooks()
+	swapHooks := &mocks.SwapHooks{}
+	suite.Keeper.SetHooks(swapHooks)
+
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	poolIDA := suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	), sdkmath.NewInt(30e6), owner.GetAddress())
+	poolIDB := suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	), sdkmath.NewInt(30e6), owner.GetAddress())
+
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6))))
+	swapInput := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	intermediate := sdk.NewCoin("usdx", sdkmath.NewInt(4982529))
+	swapOutput := sdk.NewCoin("hard", sdkmath.NewInt(9890985))
+
+	// hooks are called once for every pool along the route, with that pool's input and output
+	swapHooks.On("AfterPoolSwap", suite.Ctx, poolIDA, requester.GetAddress(), swapInput, intermediate).Once()
+	swapHooks.On("AfterPoolSwap", suite.Ctx, poolIDB, requester.GetAddress(), intermediate, swapOutput).Once()
+	err := suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), swapInput, []string{"ukava", "usdx", "hard"}, swapOutput, sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	swapHooks.AssertExpectations(suite.T())
+}
@@ -3,7 +3,6 @@ package keeper
 import (
 	"github.com/kava-labs/kava/x/swap/types"
 
-	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -71,7 +70,8 @@ func ShareRecordsInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// PoolReservesInvariant iterates all pools and ensures the total reserves matches the module account coins
+// PoolReservesInvariant iterates all pools and limit orders and ensures the total reserves and limit order escrow
+// matches the module account coins
 func PoolReservesInvariant(k Keeper) sdk.Invariant {
 	message := sdk.FormatInvariant(types.ModuleName, "pool reserves broken", "pool reserves do not match module account")
 
@@ -85,55 +85,45 @@ func PoolReservesInvariant(k Keeper) sdk.Invariant {
 			}
 			return false
 		})
+		k.IterateLimitOrders(ctx, func(order types.LimitOrder) bool {
+			reserves = reserves.Add(order.Sell)
+			return false
+		})
 
 		broken := !reserves.IsEqual(balance)
 		return message, broken
 	}
 }
 
-type poolShares struct {
-	totalShares      sdkmath.Int
-	totalSharesOwned sdkmath.Int
-}
-
-// PoolSharesInvariant iterates all pools and shares and ensures the total pool shares match the sum of depositor shares
+// PoolSharesInvariant iterates all pools and shares and ensures the total pool shares match the share token supply
+// and that every share record belongs to a pool
 func PoolSharesInvariant(k Keeper) sdk.Invariant {
 	broken := false
-	message := sdk.FormatInvariant(types.ModuleName, "pool shares broken", "pool shares do not match depositor shares")
+	message := sdk.FormatInvariant(types.ModuleName, "pool shares broken", "pool shares do not match share token supply")
 
 	return func(ctx sdk.Context) (string, bool) {
-		totalShares := make(map[string]poolShares)
+		pools := make(map[string]bool)
 
 		k.IteratePools(ctx, func(pr types.PoolRecord) bool {
-			totalShares[pr.PoolID] = poolShares{
-				totalShares:      pr.TotalShares,
-				totalSharesOwned: sdk.ZeroInt(),
+			pools[pr.PoolID] = true
+
+			if !pr.TotalShares.Equal(k.GetShareSupply(ctx, pr.PoolID)) {
+				broken = true
+				return true
 			}
 
 			return false
 		})
 
 		k.IterateDepositorShares(ctx, func(sr types.ShareRecord) bool {
-			if shares, found := totalShares[sr.PoolID]; found {
-				shares.totalSharesOwned = shares.totalSharesOwned.Add(sr.SharesOwned)
-				totalShares[sr.PoolID] = shares
-			} else {
-				totalShares[sr.PoolID] = poolShares{
-					totalShares:      sdk.ZeroInt(),
-					totalSharesOwned: sr.SharesOwned,
-				}
+			if !pools[sr.PoolID] {
+				broken = true
+				return true
 			}
 
 			return false
 		})
 
-		for _, ps := range totalShares {
-			if !ps.totalShares.Equal(ps.totalSharesOwned) {
-				broken = true
-				break
-			}
-		}
-
 		return message, broken
 	}
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/keeper"
 	"github.com/kava-labs/kava/x/swap/testutil"
@@ -66,6 +67,13 @@ func (suite *invariantTestSuite) SetupValidState() {
 		types.PoolID("hard", "usdx"),
 		sdkmath.NewInt(1e6),
 	))
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, sdk.AccAddress("depositor 1---------"), sdk.NewCoins(
+		sdk.NewCoin(types.ShareDenom(types.PoolID("ukava", "usdx")), sdkmath.NewInt(2e6)),
+		sdk.NewCoin(types.ShareDenom(types.PoolID("hard", "usdx")), sdkmath.NewInt(1e6)),
+	)))
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, sdk.AccAddress("depositor 2---------"), sdk.NewCoins(
+		sdk.NewCoin(types.ShareDenom(types.PoolID("ukava", "usdx")), sdkmath.NewInt(1e6)),
+	)))
 }
 
 func (suite *invariantTestSuite) RegisterRoute(moduleName string, route string, invariant sdk.Invariant) {
@@ -127,6 +135,25 @@ func (suite *invariantTestSuite) TestPoolRecordsInvariant() {
 	suite.Equal(true, broken)
 }
 
+func (suite *invariantTestSuite) TestPoolRecordsInvariant_StableSwap() {
+	suite.SetupValidState()
+
+	record, found := suite.Keeper.GetPool(suite.Ctx, types.PoolID("ukava", "usdx"))
+	suite.Require().True(found)
+	record.PoolType = types.POOL_TYPE_STABLESWAP
+	record.Amplification = 100
+	suite.Keeper.SetPool_Raw(suite.Ctx, record)
+
+	_, broken := suite.runInvariant("pool-records", keeper.PoolRecordsInvariant)
+	suite.Equal(false, broken)
+
+	// broken with a stableswap pool without an amplification coefficient
+	record.Amplification = 0
+	suite.Keeper.SetPool_Raw(suite.Ctx, record)
+	_, broken = suite.runInvariant("pool-records", keeper.PoolRecordsInvariant)
+	suite.Equal(true, broken)
+}
+
 func (suite *invariantTestSuite) TestShareRecordsInvariant() {
 	message, broken := suite.runInvariant("share-records", keeper.ShareRecordsInvariant)
 	suite.Equal("swap: validate share records broken invariant\nshare record invalid\n", message)
@@ -183,17 +210,43 @@ func (suite *invariantTestSuite) TestPoolReservesInvariant() {
 	suite.Equal(true, broken)
 }
 
+func (suite *invariantTestSuite) TestPoolReservesInvariant_LimitOrders() {
+	suite.SetupValidState()
+
+	// valid when the module balance includes the limit order escrow
+	order := types.NewLimitOrder(
+		1,
+		sdk.AccAddress("owner---------------"),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		"usdx",
+		sdk.MustNewDecFromStr("5"),
+		suite.Ctx.BlockTime().Add(time.Hour),
+	)
+	suite.Keeper.SetLimitOrder(suite.Ctx, order)
+	suite.AddCoinsToModule(sdk.NewCoins(order.Sell))
+	message, broken := suite.runInvariant("pool-reserves", keeper.PoolReservesInvariant)
+	suite.Equal("swap: pool reserves broken invariant\npool reserves do not match module account\n", message)
+	suite.Equal(false, broken)
+
+	// broken when the limit order escrow is greater than the module balance
+	order.ID = 2
+	suite.Keeper.SetLimitOrder(suite.Ctx, order)
+	message, broken = suite.runInvariant("pool-reserves", keeper.PoolReservesInvariant)
+	suite.Equal("swap: pool reserves broken invariant\npool reserves do not match module account\n", message)
+	suite.Equal(true, broken)
+}
+
 func (suite *invariantTestSuite) TestPoolSharesInvariant() {
 	message, broken := suite.runInvariant("pool-shares", keeper.PoolSharesInvariant)
-	suite.Equal("swap: pool shares broken invariant\npool shares do not match depositor shares\n", message)
+	suite.Equal("swap: pool shares broken invariant\npool shares do not match share token supply\n", message)
 	suite.Equal(false, broken)
 
 	suite.SetupValidState()
 	message, broken = suite.runInvariant("pool-shares", keeper.PoolSharesInvariant)
-	suite.Equal("swap: pool shares broken invariant\npool shares do not match depositor shares\n", message)
+	suite.Equal("swap: pool shares broken invariant\npool shares do not match share token supply\n", message)
 	suite.Equal(false, broken)
 
-	// broken when total shares are greater than depositor shares
+	// broken when total shares are greater than the share token supply
 	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(
 		sdk.NewCoins(
 			sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
@@ -202,10 +255,10 @@ func (suite *invariantTestSuite) TestPoolSharesInvariant() {
 		sdkmath.NewInt(5e6),
 	))
 	message, broken = suite.runInvariant("pool-shares", keeper.PoolSharesInvariant)
-	suite.Equal("swap: pool shares broken invariant\npool shares do not match depositor shares\n", message)
+	suite.Equal("swap: pool shares broken invariant\npool shares do not match share token supply\n", message)
 	suite.Equal(true, broken)
 
-	// broken when total shares are less than the depositor shares
+	// broken when total shares are less than the share token supply
 	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(
 		sdk.NewCoins(
 			sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
@@ -214,10 +267,10 @@ func (suite *invariantTestSuite) TestPoolSharesInvariant() {
 		sdkmath.NewInt(1e5),
 	))
 	message, broken = suite.runInvariant("pool-shares", keeper.PoolSharesInvariant)
-	suite.Equal("swap: pool shares broken invariant\npool shares do not match depositor shares\n", message)
+	suite.Equal("swap: pool shares broken invariant\npool shares do not match share token supply\n", message)
 	suite.Equal(true, broken)
 
-	// broken when pool record is missing
+	// broken when a share record does not have a pool record
 	suite.Keeper.DeletePool(suite.Ctx, types.PoolID("ukava", "usdx"))
 	suite.RemoveCoinsFromModule(
 		sdk.NewCoins(
@@ -226,7 +279,7 @@ func (suite *invariantTestSuite) TestPoolSharesInvariant() {
 		),
 	)
 	message, broken = suite.runInvariant("pool-shares", keeper.PoolSharesInvariant)
-	suite.Equal("swap: pool shares broken invariant\npool shares do not match depositor shares\n", message)
+	suite.Equal("swap: pool shares broken invariant\npool shares do not match share token supply\n", message)
 	suite.Equal(true, broken)
 }
 
@@ -76,6 +76,27 @@ func (k Keeper) GetSwapFee(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).SwapFee
 }
 
+// GetProtocolFee returns the protocol fee set in the module parameters
+func (k Keeper) GetProtocolFee(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ProtocolFee
+}
+
+// GetPoolSwapFee returns the swap fee charged by a pool, using the global swap fee if the pool does not have a fee tier
+func (k Keeper) GetPoolSwapFee(ctx sdk.Context, pool *types.DenominatedPool) sdk.Dec {
+	if pool.SwapFee().IsPositive() {
+		return pool.SwapFee()
+	}
+	return k.GetSwapFee(ctx)
+}
+
+// setPoolSwapFee updates the fee tier of a pool to the fee tier of its allowed pool, so fee tiers changed by
+// governance apply to existing pools.  Pools that are no longer allowed keep their last fee tier.
+func (k Keeper) setPoolSwapFee(ctx sdk.Context, poolID string, pool *types.DenominatedPool) {
+	if allowedPool, found := k.getAllowedPool(ctx, poolID); found {
+		pool.SetSwapFee(allowedPool.SwapFee)
+	}
+}
+
 // GetSwapModuleAccount returns the swap ModuleAccount
 func (k Keeper) GetSwapModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
@@ -230,21 +251,24 @@ func (k Keeper) GetAllDepositorSharesByOwner(ctx sdk.Context, owner sdk.AccAddre
 	return
 }
 
-// GetDepositorSharesAmount gets a depositor's shares in a pool from the store
+// GetDepositorSharesAmount gets the shares a depositor earns rewards on in a pool, which is the lesser of the
+// shares recorded when it was last synced and its current share token balance
 func (k Keeper) GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddress, poolID string) (sdkmath.Int, bool) {
 	record, found := k.GetDepositorShares(ctx, depositor, poolID)
 	if !found {
 		return sdkmath.Int{}, false
 	}
-	return record.SharesOwned, true
+	return sdkmath.MinInt(record.SharesOwned, k.GetShareBalance(ctx, depositor, poolID)), true
 }
 
-// updatePool updates a pool, deleting the pool record if the shares are zero
+// updatePool updates a pool and records its new prices, deleting the pool record and price history if the shares are zero
 func (k Keeper) updatePool(ctx sdk.Context, poolID string, pool *types.DenominatedPool) {
 	if pool.TotalShares().IsZero() {
 		k.DeletePool(ctx, poolID)
+		k.deletePriceObservations(ctx, poolID)
 	} else {
 		k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+		k.updatePriceObservation(ctx, poolID, pool)
 	}
 }
 
@@ -263,7 +287,7 @@ func (k Keeper) loadDenominatedPool(ctx sdk.Context, poolID string) (*types.Deno
 	if !found {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
-	denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	denominatedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
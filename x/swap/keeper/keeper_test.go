@@ -53,6 +53,9 @@ func (suite *keeperTestSuite) setupPool(reserves sdk.Coins, totalShares sdkmath.
 	}
 	suite.Keeper.SetDepositorShares(suite.Ctx, shareRecord)
 
+	err := suite.App.FundAccount(suite.Ctx, depositor, sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), totalShares)))
+	suite.Require().NoError(err)
+
 	return poolID
 }
 
@@ -63,7 +66,9 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("ukava", "usdx"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.03"),
+		SwapFee:          sdk.MustNewDecFromStr("0.03"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.Equal(keeper.GetParams(suite.Ctx), params)
@@ -73,7 +78,9 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("hard", "ukava"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.01"),
+		SwapFee:          sdk.MustNewDecFromStr("0.01"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.NotEqual(keeper.GetParams(suite.Ctx), oldParams)
@@ -84,7 +91,9 @@ func (suite keeperTestSuite) TestParams_GetSwapFee() {
 	keeper := suite.Keeper
 
 	params := types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.00333"),
+		SwapFee:          sdk.MustNewDecFromStr("0.00333"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
 	}
 	keeper.SetParams(suite.Ctx, params)
 
@@ -141,6 +150,9 @@ func (suite *keeperTestSuite) TestShare_Persistance() {
 	suite.True(ok)
 	suite.Equal(record, savedRecord)
 
+	err = suite.App.FundAccount(suite.Ctx, depositor, sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), shares)))
+	suite.Require().NoError(err)
+
 	savedShares, ok := suite.Keeper.GetDepositorSharesAmount(suite.Ctx, depositor, poolID)
 	suite.True(ok)
 	suite.Equal(record.SharesOwned, savedShares)
@@ -0,0 +1,425 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// GetNextLimitOrderID returns the id of the next limit order placed
+func (k Keeper) GetNextLimitOrderID(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.NextLimitOrderIDKey)
+	bz := store.Get(types.NextLimitOrderIDKey)
+	if bz == nil {
+		return types.DefaultNextLimitOrderID
+	}
+	return types.Uint64FromBytes(bz)
+}
+
+// SetNextLimitOrderID stores the id of the next limit order placed
+func (k Keeper) SetNextLimitOrderID(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.NextLimitOrderIDKey)
+	store.Set(types.NextLimitOrderIDKey, types.Uint64ToBytes(id))
+}
+
+// GetLimitOrder retrieves a limit order from the store
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id uint64) (types.LimitOrder, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderPrefix)
+
+	bz := store.Get(types.LimitOrderKey(id))
+	if bz == nil {
+		return types.LimitOrder{}, false
+	}
+
+	var order types.LimitOrder
+	k.cdc.MustUnmarshal(bz, &order)
+
+	return order, true
+}
+
+// SetLimitOrder saves a limit order to the store, indexed by price and expiration
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderPrefix)
+	bz := k.cdc.MustMarshal(&order)
+	store.Set(types.LimitOrderKey(order.ID), bz)
+
+	idBytes := types.Uint64ToBytes(order.ID)
+	priceStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByPricePrefix)
+	priceStore.Set(types.LimitOrderByPriceKey(order.PoolID, order.Sell.Denom, order.Price, order.ID), idBytes)
+	timeStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByTimePrefix)
+	timeStore.Set(types.LimitOrderByTimeKey(order.Expiration, order.ID), idBytes)
+}
+
+// DeleteLimitOrder deletes a limit order and its indexes from the store
+func (k Keeper) DeleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderPrefix)
+	store.Delete(types.LimitOrderKey(order.ID))
+
+	priceStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByPricePrefix)
+	priceStore.Delete(types.LimitOrderByPriceKey(order.PoolID, order.Sell.Denom, order.Price, order.ID))
+	timeStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByTimePrefix)
+	timeStore.Delete(types.LimitOrderByTimeKey(order.Expiration, order.ID))
+}
+
+// IterateLimitOrders iterates over all limit orders in the store and performs a callback function
+func (k Keeper) IterateLimitOrders(ctx sdk.Context, cb func(order types.LimitOrder) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		if cb(order) {
+			break
+		}
+	}
+}
+
+// GetAllLimitOrders returns all limit orders from the store
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) (orders types.LimitOrders) {
+	k.IterateLimitOrders(ctx, func(order types.LimitOrder) bool {
+		orders = append(orders, order)
+		return false
+	})
+	return
+}
+
+// IterateLimitOrdersByPrice iterates over the limit orders of a pool selling a denom in order of ascending
+// price, and performs a callback function
+func (k Keeper) IterateLimitOrdersByPrice(ctx sdk.Context, poolID string, sellDenom string, cb func(order types.LimitOrder) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByPricePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.LimitOrdersByPriceKeyPrefix(poolID, sellDenom))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, types.Uint64FromBytes(iterator.Value()))
+		if !found {
+			panic(fmt.Sprintf("limit order %d in price index not found", types.Uint64FromBytes(iterator.Value())))
+		}
+		if cb(order) {
+			break
+		}
+	}
+}
+
+// IterateLimitOrdersByTime iterates over the limit orders with an expiration at or before a cutoff time
+// in order of expiration, and performs a callback function
+func (k Keeper) IterateLimitOrdersByTime(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(order types.LimitOrder) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByTimePrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoffTime)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, types.Uint64FromBytes(iterator.Value()))
+		if !found {
+			panic(fmt.Sprintf("limit order %d in time index not found", types.Uint64FromBytes(iterator.Value())))
+		}
+		if cb(order) {
+			break
+		}
+	}
+}
+
+// PlaceLimitOrder places an order to sell a coin to an existing pool for the other denom of the pool at or above
+// a limit price, in units of the buy denom.  The coin is held in escrow until the order is filled, cancelled,
+// or expires.
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, owner sdk.AccAddress, sell sdk.Coin, buyDenom string, price sdk.Dec, expiration time.Time) (uint64, error) {
+	poolID := types.PoolID(sell.Denom, buyDenom)
+	if _, found := k.GetPool(ctx, poolID); !found {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	if !expiration.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidLimitOrder, "expiration %s must be after block time %s", expiration.UTC(), ctx.BlockTime().UTC())
+	}
+
+	if maxExpiration := ctx.BlockTime().Add(types.MaxLimitOrderDuration); expiration.After(maxExpiration) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidLimitOrder, "expiration %s must not be after %s", expiration.UTC(), maxExpiration.UTC())
+	}
+
+	id := k.GetNextLimitOrderID(ctx)
+	order := types.NewLimitOrder(id, owner, sell, buyDenom, price, expiration)
+	if err := order.Validate(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidLimitOrder, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleAccountName, sdk.NewCoins(sell)); err != nil {
+		return 0, err
+	}
+
+	k.SetLimitOrder(ctx, order)
+	k.SetNextLimitOrderID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapLimitOrderPlace,
+			sdk.NewAttribute(types.AttributeKeyLimitOrderID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sell.String()),
+			sdk.NewAttribute(types.AttributeKeyLimitOrderBuyDenom, buyDenom),
+			sdk.NewAttribute(types.AttributeKeyLimitOrderPrice, price.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// CancelLimitOrder cancels a limit order, returning the unfilled amount to the owner
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	order, found := k.GetLimitOrder(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLimitOrderNotFound, "order %d", id)
+	}
+
+	if order.Owner != owner.String() {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "order %d is not owned by %s", id, owner)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, sdk.NewCoins(order.Sell)); err != nil {
+		return err
+	}
+
+	k.DeleteLimitOrder(ctx, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapLimitOrderCancel,
+			sdk.NewAttribute(types.AttributeKeyLimitOrderID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, order.Sell.String()),
+		),
+	)
+
+	return nil
+}
+
+// ExpireLimitOrders returns the unfilled amount of at most limit orders expired at the current block time to
+// their owners, in order of expiration, and returns the number of orders expired.  Orders that are not expired
+// due to the limit remain first in the expiration index and are expired in later blocks.
+func (k Keeper) ExpireLimitOrders(ctx sdk.Context, limit int) int {
+	if limit <= 0 {
+		return 0
+	}
+
+	var expired types.LimitOrders
+	k.IterateLimitOrdersByTime(ctx, ctx.BlockTime(), func(order types.LimitOrder) bool {
+		expired = append(expired, order)
+		return len(expired) >= limit
+	})
+
+	for _, order := range expired {
+		k.refundLimitOrder(ctx, order, types.EventTypeSwapLimitOrderExpire)
+	}
+	return len(expired)
+}
+
+// refundLimitOrder returns the unfilled amount of an order to its owner, deletes the order, then emits an event of
+// the given type
+func (k Keeper) refundLimitOrder(ctx sdk.Context, order types.LimitOrder, eventType string) {
+	owner := sdk.MustAccAddressFromBech32(order.Owner)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, sdk.NewCoins(order.Sell)); err != nil {
+		panic(err)
+	}
+
+	k.DeleteLimitOrder(ctx, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyLimitOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, order.Sell.String()),
+		),
+	)
+}
+
+// FillLimitOrders fills the limit orders of every pool whose limit price is crossed by the spot price of the
+// pool.  At most limit orders are examined across all pools, starting from a pool and sell denom that rotates
+// each block so the orders of one pool can not use the budget of every block.
+func (k Keeper) FillLimitOrders(ctx sdk.Context, limit int) {
+	type orderBook struct {
+		poolID    string
+		sellDenom string
+	}
+
+	var books []orderBook
+	for _, record := range k.GetAllPools(ctx) {
+		for _, reserve := range record.Reserves() {
+			books = append(books, orderBook{poolID: record.PoolID, sellDenom: reserve.Denom})
+		}
+	}
+	if len(books) == 0 || limit <= 0 {
+		return
+	}
+
+	remaining := limit
+	start := int(ctx.BlockHeight() % int64(len(books)))
+
+	for i := range books {
+		book := books[(start+i)%len(books)]
+		remaining -= k.fillPoolLimitOrders(ctx, book.poolID, book.sellDenom, remaining)
+		if remaining <= 0 {
+			return
+		}
+	}
+}
+
+// fillPoolLimitOrders fills the orders of a pool selling a denom in order of ascending price, stopping at the
+// first order whose limit price is not crossed by the spot price of the pool, net of the swap fee.  Orders are
+// filled by trading against the pool up to the largest amount that is received at or above the limit price, and
+// crossed orders that can not be filled at their limit price due to rounding are refunded.  It returns the number
+// of orders examined.
+func (k Keeper) fillPoolLimitOrders(ctx sdk.Context, poolID string, sellDenom string, limit int) int {
+	var orders types.LimitOrders
+	k.IterateLimitOrdersByPrice(ctx, poolID, sellDenom, func(order types.LimitOrder) bool {
+		orders = append(orders, order)
+		return len(orders) >= limit
+	})
+	if len(orders) == 0 {
+		return 0
+	}
+
+	// the record is loaded after the orders of the other denom of the pool have been filled
+	record, found := k.GetPool(ctx, poolID)
+	if !found {
+		panic(fmt.Sprintf("pool %s not found", poolID))
+	}
+	pool, err := types.NewDenominatedPoolFromRecord(record)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
+	}
+	k.setPoolSwapFee(ctx, poolID, pool)
+	swapFee := k.GetPoolSwapFee(ctx, pool)
+
+	examined := 0
+	for _, order := range orders {
+		examined++
+
+		if pool.SpotPrice(sellDenom).Mul(sdk.OneDec().Sub(swapFee)).LT(order.Price) {
+			break
+		}
+
+		amount := limitOrderFillAmount(pool, order, swapFee)
+		if amount.IsZero() {
+			k.refundLimitOrder(ctx, order, types.EventTypeSwapLimitOrderRefund)
+			continue
+		}
+
+		hop := k.swapExactInput(ctx, poolID, pool, sdk.NewCoin(sellDenom, amount))
+		k.commitLimitOrderFill(ctx, order, hop)
+	}
+
+	return examined
+}
+
+// limitOrderFillAmount returns the largest amount of an order that is received by the pool at or above the
+// limit price of the order, including swap fees.  The amount is bounded by a closed-form amount for constant
+// product pools, while other pools are searched with at most MaxLimitOrderFillIterations simulated swaps.
+func limitOrderFillAmount(pool *types.DenominatedPool, order types.LimitOrder, swapFee sdk.Dec) sdkmath.Int {
+	// receivedAtPrice simulates a swap of the amount against a copy of the pool, and reports if the output is
+	// at or above the limit price
+	receivedAtPrice := func(amount sdkmath.Int) bool {
+		simulated, err := types.NewDenominatedPoolFromRecord(types.NewPoolRecordFromPool(pool))
+		if err != nil {
+			panic(err)
+		}
+
+		output, _ := simulated.SwapWithExactInput(sdk.NewCoin(order.Sell.Denom, amount), swapFee)
+		return sdk.NewDecFromInt(output.Amount).GTE(sdk.NewDecFromInt(amount).Mul(order.Price))
+	}
+
+	high := order.Sell.Amount
+	if pool.PoolType() == types.POOL_TYPE_CONSTANT_PRODUCT {
+		high = sdkmath.MinInt(high, constantProductFillAmount(pool, order, swapFee))
+	}
+	if !high.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	if receivedAtPrice(high) {
+		return high
+	}
+
+	// the result is the largest amount found that is received at or above the limit price, which is smaller
+	// than the exact fill amount when the iterations run out
+	low, high := sdk.ZeroInt(), high.SubRaw(1)
+	for i := 0; i < types.MaxLimitOrderFillIterations && low.LT(high); i++ {
+		mid := low.Add(high).AddRaw(1).QuoRaw(2)
+		if receivedAtPrice(mid) {
+			low = mid
+		} else {
+			high = mid.SubRaw(1)
+		}
+	}
+
+	return low
+}
+
+// constantProductFillAmount returns the amount of an order for which the output of a constant product pool,
+// before rounding, is exactly the limit price.  For input reserves x, output reserves y, fee f and price p, the
+// output of an input a is y * a(1-f) / (x + a(1-f)), which is at least a * p for all a <= y/p - x/(1-f).
+func constantProductFillAmount(pool *types.DenominatedPool, order types.LimitOrder, swapFee sdk.Dec) sdkmath.Int {
+	feeMultiplier := sdk.OneDec().Sub(swapFee)
+	if !feeMultiplier.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	reserves := pool.Reserves()
+	amount := sdk.NewDecFromInt(reserves.AmountOf(order.BuyDenom)).Quo(order.Price).Sub(
+		sdk.NewDecFromInt(reserves.AmountOf(order.Sell.Denom)).Quo(feeMultiplier),
+	)
+	if !amount.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	return amount.TruncateInt()
+}
+
+// commitLimitOrderFill stores the updated pool and order, transfers the swap output to the order owner and the
+// protocol fee to the community pool, then emits an event and calls hooks
+func (k Keeper) commitLimitOrderFill(ctx sdk.Context, order types.LimitOrder, hop swapHop) {
+	k.updatePool(ctx, hop.poolID, hop.pool)
+
+	owner := sdk.MustAccAddressFromBech32(order.Owner)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, sdk.NewCoins(hop.output)); err != nil {
+		panic(err)
+	}
+
+	if hop.protocolFee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, communitytypes.ModuleAccountName, sdk.NewCoins(hop.protocolFee)); err != nil {
+			panic(err)
+		}
+	}
+
+	order.Sell = order.Sell.Sub(hop.input)
+	if order.Sell.IsZero() {
+		k.DeleteLimitOrder(ctx, order)
+	} else {
+		k.SetLimitOrder(ctx, order)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapLimitOrderFill,
+			sdk.NewAttribute(types.AttributeKeyLimitOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, hop.poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner),
+			sdk.NewAttribute(types.AttributeKeySwapInput, hop.input.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, hop.output.String()),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, hop.fee.String()),
+			sdk.NewAttribute(types.AttributeKeyProtocolFee, hop.protocolFee.String()),
+			sdk.NewAttribute(types.AttributeKeyLimitOrderRemaining, order.Sell.String()),
+		),
+	)
+
+	k.AfterPoolSwap(ctx, hop.poolID, owner, hop.input, hop.output)
+}
@@ -0,0 +1,510 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (suite *keeperTestSuite) setupLimitOrderPool() sdk.Coins {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+	return reserves
+}
+
+func (suite *keeperTestSuite) assertPoolReservesInvariant() {
+	_, broken := keeper.PoolReservesInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken, "expected pool reserves invariant to hold")
+}
+
+func (suite *keeperTestSuite) TestPlaceLimitOrder() {
+	reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(4e6))
+	price := sdk.MustNewDecFromStr("5.5")
+	expiration := suite.Ctx.BlockTime().Add(time.Hour)
+
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "usdx", price, expiration)
+	suite.Require().NoError(err)
+	suite.Equal(types.DefaultNextLimitOrderID, id)
+	suite.Equal(id+1, suite.Keeper.GetNextLimitOrderID(suite.Ctx))
+
+	order, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(types.NewLimitOrder(id, owner.GetAddress(), sell, "usdx", price, expiration), order)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(sell))
+	suite.ModuleAccountBalanceEqual(reserves.Add(sell))
+	suite.PoolLiquidityEqual(reserves)
+	suite.assertPoolReservesInvariant()
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapLimitOrderPlace,
+		sdk.NewAttribute(types.AttributeKeyLimitOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ukava:usdx"),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sell.String()),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderBuyDenom, "usdx"),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderPrice, price.String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestPlaceLimitOrder_Errors() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("hard", sdkmath.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	blockTime := suite.Ctx.BlockTime()
+
+	testCases := []struct {
+		name        string
+		sell        sdk.Coin
+		buyDenom    string
+		expiration  time.Time
+		expectedErr error
+	}{
+		{
+			name:        "pool not found",
+			sell:        sdk.NewCoin("hard", sdkmath.NewInt(1e6)),
+			buyDenom:    "usdx",
+			expiration:  blockTime.Add(time.Hour),
+			expectedErr: types.ErrInvalidPool,
+		},
+		{
+			name:        "expiration at block time",
+			sell:        sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			buyDenom:    "usdx",
+			expiration:  blockTime,
+			expectedErr: types.ErrInvalidLimitOrder,
+		},
+		{
+			name:        "expiration after max duration",
+			sell:        sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			buyDenom:    "usdx",
+			expiration:  blockTime.Add(types.MaxLimitOrderDuration).Add(time.Second),
+			expectedErr: types.ErrInvalidLimitOrder,
+		},
+		{
+			name:        "insufficient funds",
+			sell:        sdk.NewCoin("ukava", sdkmath.NewInt(11e6)),
+			buyDenom:    "usdx",
+			expiration:  blockTime.Add(time.Hour),
+			expectedErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tc.sell, tc.buyDenom, sdk.OneDec(), tc.expiration)
+			suite.Require().ErrorIs(err, tc.expectedErr)
+			suite.AccountBalanceEqual(owner.GetAddress(), balance)
+			suite.Empty(suite.Keeper.GetAllLimitOrders(suite.Ctx))
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestCancelLimitOrder() {
+	reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	other := suite.NewAccountFromAddr(sdk.AccAddress("other---------------"), sdk.NewCoins())
+
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(4e6))
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "usdx", sdk.MustNewDecFromStr("5.5"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, other.GetAddress(), id)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, owner.GetAddress(), id+1)
+	suite.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, owner.GetAddress(), id)
+	suite.Require().NoError(err)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.False(found)
+	suite.AccountBalanceEqual(owner.GetAddress(), balance)
+	suite.ModuleAccountBalanceEqual(reserves)
+	suite.assertPoolReservesInvariant()
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapLimitOrderCancel,
+		sdk.NewAttribute(types.AttributeKeyLimitOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ukava:usdx"),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sell.String()),
+	))
+
+	// an order can not be cancelled twice
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, owner.GetAddress(), id)
+	suite.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+}
+
+func (suite *keeperTestSuite) TestExpireLimitOrders() {
+	reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	blockTime := suite.Ctx.BlockTime()
+	for _, expiration := range []time.Time{blockTime.Add(2 * time.Hour), blockTime.Add(time.Hour), blockTime.Add(3 * time.Hour)} {
+		_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "usdx", sdk.MustNewDecFromStr("10"), expiration)
+		suite.Require().NoError(err)
+	}
+
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+	suite.Equal(2, suite.Keeper.ExpireLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock))
+
+	orders := suite.Keeper.GetAllLimitOrders(suite.Ctx)
+	suite.Require().Len(orders, 1)
+	suite.Equal(uint64(3), orders[0].ID)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(sell))
+	suite.ModuleAccountBalanceEqual(reserves.Add(sell))
+	suite.assertPoolReservesInvariant()
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapLimitOrderExpire,
+		sdk.NewAttribute(types.AttributeKeyLimitOrderID, "2"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ukava:usdx"),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sell.String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestExpireLimitOrders_Limit() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	blockTime := suite.Ctx.BlockTime()
+	for i := 5; i > 0; i-- {
+		_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "usdx", sdk.MustNewDecFromStr("10"), blockTime.Add(time.Duration(i)*time.Minute))
+		suite.Require().NoError(err)
+	}
+
+	// orders are expired in order of expiration up to the limit, and the rest are expired in later blocks
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime.Add(time.Hour))
+	suite.Equal(3, suite.Keeper.ExpireLimitOrders(suite.Ctx, 3))
+	orders := suite.Keeper.GetAllLimitOrders(suite.Ctx)
+	suite.Require().Len(orders, 2)
+	suite.Equal(uint64(1), orders[0].ID)
+	suite.Equal(uint64(2), orders[1].ID)
+
+	suite.Equal(0, suite.Keeper.ExpireLimitOrders(suite.Ctx, 0))
+	suite.Equal(2, suite.Keeper.ExpireLimitOrders(suite.Ctx, 3))
+	suite.Empty(suite.Keeper.GetAllLimitOrders(suite.Ctx))
+	suite.AccountBalanceEqual(owner.GetAddress(), balance)
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_NotCrossed() {
+	reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	expiration := suite.Ctx.BlockTime().Add(time.Hour)
+
+	// the spot price of ukava is 5 usdx, so neither order is crossed after the swap fee
+	_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("4.99"), expiration)
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("usdx", sdkmath.NewInt(1e6)), "ukava", sdk.MustNewDecFromStr("0.2"), expiration)
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+
+	suite.Len(suite.Keeper.GetAllLimitOrders(suite.Ctx), 2)
+	suite.PoolLiquidityEqual(reserves)
+	suite.assertPoolReservesInvariant()
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_FullFill() {
+	reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	price := sdk.MustNewDecFromStr("4.9")
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "usdx", price, suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.False(found)
+
+	output := sdk.NewCoin("usdx", sdkmath.NewInt(4980034))
+	suite.True(sdk.NewDecFromInt(output.Amount).GTE(sdk.NewDecFromInt(sell.Amount).Mul(price)))
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(sell).Add(output))
+	suite.PoolLiquidityEqual(reserves.Add(sell).Sub(output))
+	suite.assertPoolReservesInvariant()
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapLimitOrderFill,
+		sdk.NewAttribute(types.AttributeKeyLimitOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ukava:usdx"),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, sell.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, output.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "3000ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderRemaining, "0ukava"),
+	))
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_PartialFill() {
+	reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(100e6))
+	price := sdk.MustNewDecFromStr("4.95")
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "usdx", price, suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+
+	order, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.Require().True(found)
+	filled := sell.Sub(order.Sell)
+	suite.True(filled.IsPositive())
+	suite.True(order.Sell.IsPositive())
+
+	// the order is filled at or above the limit price, until the price of the pool reaches the limit price
+	output := suite.BankKeeper.GetBalance(suite.Ctx, owner.GetAddress(), "usdx")
+	suite.True(sdk.NewDecFromInt(output.Amount).GTE(sdk.NewDecFromInt(filled.Amount).Mul(price)))
+	suite.PoolLiquidityEqual(reserves.Add(filled).Sub(output))
+	suite.assertPoolReservesInvariant()
+
+	pool, err := types.NewDenominatedPoolFromRecord(types.NewPoolRecord(reserves.Add(filled).Sub(output), sdkmath.NewInt(1)))
+	suite.Require().NoError(err)
+	suite.True(pool.SpotPrice("ukava").LT(price), "expected spot price %s to be less than %s", pool.SpotPrice("ukava"), price)
+
+	// the fill is the largest amount received at or above the limit price
+	pool, err = types.NewDenominatedPoolFromRecord(types.NewPoolRecord(reserves, sdkmath.NewInt(1)))
+	suite.Require().NoError(err)
+	larger := filled.AddAmount(sdk.OneInt())
+	largerOutput, _ := pool.SwapWithExactInput(larger, sdk.MustNewDecFromStr("0.003"))
+	suite.True(sdk.NewDecFromInt(largerOutput.Amount).LT(sdk.NewDecFromInt(larger.Amount).Mul(price)))
+
+	// the remainder is not filled until the pool price crosses the limit price again
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+	unchanged, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(order, unchanged)
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_PriceOrder() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(200e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	expiration := suite.Ctx.BlockTime().Add(time.Hour)
+
+	// the higher priced order is placed first, but the lower priced order is filled first
+	highID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(100e6)), "usdx", sdk.MustNewDecFromStr("4.97"), expiration)
+	suite.Require().NoError(err)
+	lowID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(100e6)), "usdx", sdk.MustNewDecFromStr("4.95"), expiration)
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+
+	low, found := suite.Keeper.GetLimitOrder(suite.Ctx, lowID)
+	suite.Require().True(found)
+	suite.True(low.Sell.Amount.LT(sdkmath.NewInt(100e6)), "expected lower priced order to be filled")
+
+	high, found := suite.Keeper.GetLimitOrder(suite.Ctx, highID)
+	suite.Require().True(found)
+	suite.Equal(sdkmath.NewInt(100e6), high.Sell.Amount, "expected higher priced order to not be filled")
+	suite.assertPoolReservesInvariant()
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_AfterSwap() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+
+	// sell usdx for ukava once the price of ukava drops below 4.5 usdx
+	sell := sdk.NewCoin("usdx", sdkmath.NewInt(10e6))
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "ukava", sdk.MustNewDecFromStr("0.22"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.Require().True(found)
+
+	trader := suite.NewAccountFromAddr(sdk.AccAddress("trader--------------"), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, trader.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(100e6)), sdk.NewCoin("usdx", sdkmath.NewInt(450e6)), sdk.OneDec())
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.False(found)
+
+	output := suite.BankKeeper.GetBalance(suite.Ctx, owner.GetAddress(), "ukava")
+	suite.True(sdk.NewDecFromInt(output.Amount).GTE(sdk.NewDecFromInt(sell.Amount).Mul(sdk.MustNewDecFromStr("0.22"))))
+	suite.assertPoolReservesInvariant()
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_MaxFillsPerBlock() {
+	suite.setupLimitOrderPool()
+
+	orderCount := types.MaxLimitOrdersPerBlock + 5
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(int64(orderCount)*1e3)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+
+	for i := 0; i < orderCount; i++ {
+		_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e3)), "usdx", sdk.MustNewDecFromStr("4.5"), suite.Ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+	}
+
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+	suite.Len(suite.Keeper.GetAllLimitOrders(suite.Ctx), 5)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+	suite.Empty(suite.Keeper.GetAllLimitOrders(suite.Ctx))
+	suite.assertPoolReservesInvariant()
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_RefundsUnfillable() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+
+	// the spot price of ukava net of the swap fee is exactly 4.985 usdx, but every fill of the order is received
+	// below the limit price once rounded
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sell, "usdx", sdk.MustNewDecFromStr("4.985"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.False(found)
+	suite.AccountBalanceEqual(owner.GetAddress(), balance)
+	suite.assertPoolReservesInvariant()
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapLimitOrderRefund,
+		sdk.NewAttribute(types.AttributeKeyLimitOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ukava:usdx"),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sell.String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_RotatesStartingPool() {
+	suite.setupLimitOrderPool()
+	suite.Require().NoError(suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(2000e6)),
+	)))
+
+	orderCount := 2 * types.MaxLimitOrdersPerBlock
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(int64(orderCount)*1e3)), sdk.NewCoin("hard", sdkmath.NewInt(1e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	expiration := suite.Ctx.BlockTime().Add(time.Hour)
+
+	for i := 0; i < orderCount; i++ {
+		_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e3)), "usdx", sdk.MustNewDecFromStr("4.5"), expiration)
+		suite.Require().NoError(err)
+	}
+	hardID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("hard", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("1.5"), expiration)
+	suite.Require().NoError(err)
+
+	// the order books are hard:usdx selling hard, hard:usdx selling usdx, ukava:usdx selling ukava, then
+	// ukava:usdx selling usdx, and the ukava orders use the whole budget when the block starts with their book
+	suite.Ctx = suite.Ctx.WithBlockHeight(2)
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+	suite.Len(suite.Keeper.GetAllLimitOrders(suite.Ctx), orderCount-types.MaxLimitOrdersPerBlock+1)
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, hardID)
+	suite.True(found)
+
+	// the next block starts after the ukava orders, so the hard order is filled
+	suite.Ctx = suite.Ctx.WithBlockHeight(3)
+	suite.Keeper.FillLimitOrders(suite.Ctx, types.MaxLimitOrdersPerBlock)
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, hardID)
+	suite.False(found)
+	suite.assertPoolReservesInvariant()
+}
+
+func (suite *keeperTestSuite) TestQueryLimitOrders() {
+	suite.setupQuotePools()
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("hard", sdkmath.NewInt(10e6)))
+	owner_1 := suite.NewAccountFromAddr(sdk.AccAddress("owner-1-------------"), balance)
+	owner_2 := suite.NewAccountFromAddr(sdk.AccAddress("owner-2-------------"), balance)
+	expiration := suite.Ctx.BlockTime().Add(time.Hour)
+
+	_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner_1.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("6"), expiration)
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner_1.GetAddress(), sdk.NewCoin("hard", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("1"), expiration)
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner_2.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(2e6)), "usdx", sdk.MustNewDecFromStr("7"), expiration)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		req         *types.QueryLimitOrdersRequest
+		expectedIDs []uint64
+	}{
+		{
+			name:        "all orders",
+			req:         &types.QueryLimitOrdersRequest{},
+			expectedIDs: []uint64{1, 2, 3},
+		},
+		{
+			name:        "filter by owner",
+			req:         &types.QueryLimitOrdersRequest{Owner: owner_1.GetAddress().String()},
+			expectedIDs: []uint64{1, 2},
+		},
+		{
+			name:        "filter by pool",
+			req:         &types.QueryLimitOrdersRequest{PoolId: "ukava:usdx"},
+			expectedIDs: []uint64{1, 3},
+		},
+		{
+			name:        "filter by owner and pool",
+			req:         &types.QueryLimitOrdersRequest{Owner: owner_2.GetAddress().String(), PoolId: "hard:usdx"},
+			expectedIDs: []uint64{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := queryServer.LimitOrders(sdk.WrapSDKContext(suite.Ctx), tc.req)
+			suite.Require().NoError(err)
+
+			ids := []uint64{}
+			for _, order := range res.LimitOrders {
+				ids = append(ids, order.ID)
+			}
+			suite.Equal(tc.expectedIDs, ids)
+		})
+	}
+
+	_, err = queryServer.LimitOrders(sdk.WrapSDKContext(suite.Ctx), nil)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	v3 "github.com/kava-labs/kava/x/swap/migrations/v3"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2, granting the swap module account permission to mint and burn
+// share tokens, and minting share tokens for all existing deposits.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	macc := m.keeper.GetSwapModuleAccount(ctx)
+	baseAcc := authtypes.NewBaseAccount(macc.GetAddress(), macc.GetPubKey(), macc.GetAccountNumber(), macc.GetSequence())
+	m.keeper.accountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(baseAcc, types.ModuleAccountName, authtypes.Minter, authtypes.Burner))
+
+	return m.keeper.InitializeShareTokens(ctx)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.paramSubspace)
+}
@@ -133,6 +133,62 @@ func (m msgServer) SwapForExactTokens(goCtx context.Context, msg *types.MsgSwapF
 	return &types.MsgSwapForExactTokensResponse{}, nil
 }
 
+// SwapExactForTokensMultiHop handles MsgSwapExactForTokensMultiHop messages
+func (m msgServer) SwapExactForTokensMultiHop(goCtx context.Context, msg *types.MsgSwapExactForTokensMultiHop) (*types.MsgSwapExactForTokensMultiHopResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapExactForTokensMultiHop(ctx, requester, msg.ExactTokenA, msg.SwapRoute, msg.TokenB, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapExactForTokensMultiHopResponse{}, nil
+}
+
+// SwapForExactTokensMultiHop handles MsgSwapForExactTokensMultiHop messages
+func (m msgServer) SwapForExactTokensMultiHop(goCtx context.Context, msg *types.MsgSwapForExactTokensMultiHop) (*types.MsgSwapForExactTokensMultiHopResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapForExactTokensMultiHop(ctx, requester, msg.TokenA, msg.SwapRoute, msg.ExactTokenB, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapForExactTokensMultiHopResponse{}, nil
+}
+
 // checkDeadline returns an error if block time exceeds an included deadline
 func checkDeadline(ctx sdk.Context, msg sdk.Msg) error {
 	deadlineMsg, ok := msg.(types.MsgWithDeadline)
@@ -151,3 +207,105 @@ func checkDeadline(ctx sdk.Context, msg sdk.Msg) error {
 
 	return nil
 }
+
+// ZapDeposit handles MsgZapDeposit messages
+func (m msgServer) ZapDeposit(goCtx context.Context, msg *types.MsgZapDeposit) (*types.MsgZapDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := m.keeper.ZapDeposit(ctx, depositor, msg.TokenA, msg.TokenBDenom, msg.Slippage)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, depositor.String()),
+		),
+	)
+
+	return &types.MsgZapDepositResponse{Shares: shares}, nil
+}
+
+// PlaceLimitOrder handles MsgPlaceLimitOrder messages
+func (m msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := m.keeper.PlaceLimitOrder(ctx, owner, msg.Sell, msg.BuyDenom, msg.Price, msg.GetExpiration())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgPlaceLimitOrderResponse{ID: id}, nil
+}
+
+// CancelLimitOrder handles MsgCancelLimitOrder messages
+func (m msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.CancelLimitOrder(ctx, owner, msg.ID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgCancelLimitOrderResponse{}, nil
+}
+
+// SyncShares handles MsgSyncShares messages
+func (m msgServer) SyncShares(goCtx context.Context, msg *types.MsgSyncShares) (*types.MsgSyncSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SyncDepositorShares(ctx, owner, msg.PoolID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgSyncSharesResponse{}, nil
+}
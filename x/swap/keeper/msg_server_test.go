@@ -53,7 +53,7 @@ func (suite *msgServerTestSuite) TestDeposit_CreatePool() {
 	suite.Require().Equal(&types.MsgDepositResponse{}, res)
 	suite.Require().NoError(err)
 
-	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.Coins{})
+	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(sdk.NewCoin(types.ShareDenom(pool.Name()), sdkmath.NewInt(22360679))))
 	suite.ModuleAccountBalanceEqual(balance)
 	suite.PoolLiquidityEqual(balance)
 	suite.PoolShareValueEqual(depositor, pool, balance)
@@ -143,7 +143,9 @@ func (suite *msgServerTestSuite) TestDeposit_ExistingPool() {
 	)
 
 	// Use sdk.NewCoins to remove zero coins, otherwise it will compare sdk.Coins(nil) with sdk.Coins{}
-	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(balance.Sub(expectedDeposit...)...))
+	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(balance.Sub(expectedDeposit...)...).Add(
+		sdk.NewCoin(types.ShareDenom(pool.Name()), sdkmath.NewInt(2236067)),
+	))
 	suite.ModuleAccountBalanceEqual(reserves.Add(expectedDeposit...))
 	suite.PoolLiquidityEqual(reserves.Add(expectedDeposit...))
 	suite.PoolShareValueEqual(depositor, pool, expectedShareValue)
@@ -282,7 +284,9 @@ func (suite *msgServerTestSuite) TestWithdraw_PartialShares() {
 
 	expectedCoinsReceived := sdk.NewCoins(minTokenA, minTokenB)
 
-	suite.AccountBalanceEqual(depositor.GetAddress(), expectedCoinsReceived)
+	suite.AccountBalanceEqual(depositor.GetAddress(), expectedCoinsReceived.Add(
+		sdk.NewCoin(types.ShareDenom(pool.Name()), sdkmath.NewInt(11180340)),
+	))
 	suite.ModuleAccountBalanceEqual(reserves.Sub(expectedCoinsReceived...))
 	suite.PoolLiquidityEqual(reserves.Sub(expectedCoinsReceived...))
 	suite.PoolShareValueEqual(depositor, types.NewAllowedPool("ukava", "usdx"), reserves.Sub(expectedCoinsReceived...))
@@ -420,6 +424,7 @@ func (suite *msgServerTestSuite) TestSwapExactForTokens() {
 		sdk.NewAttribute(types.AttributeKeySwapInput, swapInput.String()),
 		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedSwapOutput.String()),
 		sdk.NewAttribute(types.AttributeKeyFeePaid, "3000ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
 		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
 	))
 }
@@ -533,6 +538,7 @@ func (suite *msgServerTestSuite) TestSwapForExactTokens() {
 		sdk.NewAttribute(types.AttributeKeySwapInput, expectedSwapInput.String()),
 		sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
 		sdk.NewAttribute(types.AttributeKeyFeePaid, "3013ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
 		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
 	))
 }
@@ -586,6 +592,349 @@ func (suite *msgServerTestSuite) TestSwapForExactTokens_DeadlineExceeded() {
 	suite.Nil(res)
 }
 
+func (suite *msgServerTestSuite) TestSwapExactForTokensMultiHop() {
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reservesA))
+	suite.Require().NoError(suite.CreatePool(reservesB))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapInput := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	swapMsg := types.NewMsgSwapExactForTokensMultiHop(
+		requester.GetAddress().String(),
+		swapInput,
+		[]string{"ukava", "usdx", "hard"},
+		sdk.NewCoin("hard", sdkmath.NewInt(10e6)),
+		sdk.MustNewDecFromStr("0.02"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapExactForTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Equal(&types.MsgSwapExactForTokensMultiHopResponse{}, res)
+	suite.Require().NoError(err)
+
+	intermediate := sdk.NewCoin("usdx", sdkmath.NewInt(4980034))
+	expectedSwapOutput := sdk.NewCoin("hard", sdkmath.NewInt(9881125))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(swapInput).Add(expectedSwapOutput))
+	suite.PoolLiquidityEqual(reservesA.Add(swapInput).Sub(intermediate))
+	suite.PoolLiquidityEqual(reservesB.Add(intermediate).Sub(expectedSwapOutput))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, requester.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		bank.EventTypeTransfer,
+		sdk.NewAttribute(bank.AttributeKeyRecipient, swapModuleAccountAddress.String()),
+		sdk.NewAttribute(bank.AttributeKeySender, requester.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, swapInput.String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		bank.EventTypeTransfer,
+		sdk.NewAttribute(bank.AttributeKeyRecipient, requester.GetAddress().String()),
+		sdk.NewAttribute(bank.AttributeKeySender, swapModuleAccountAddress.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedSwapOutput.String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSwapExactForTokensMultiHop_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapMsg := types.NewMsgSwapExactForTokensMultiHop(
+		requester.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(5e6)),
+		[]string{"ukava", "usdx", "hard"},
+		sdk.NewCoin("hard", sdkmath.NewInt(25e5)),
+		sdk.MustNewDecFromStr("0.01"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.SwapExactForTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Nil(res)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), swapMsg.GetDeadline().Unix()))
+}
+
+func (suite *msgServerTestSuite) TestSwapForExactTokensMultiHop() {
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reservesA))
+	suite.Require().NoError(suite.CreatePool(reservesB))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapOutput := sdk.NewCoin("hard", sdkmath.NewInt(10e6))
+	swapMsg := types.NewMsgSwapForExactTokensMultiHop(
+		requester.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		[]string{"ukava", "usdx", "hard"},
+		swapOutput,
+		sdk.MustNewDecFromStr("0.02"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapForExactTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Equal(&types.MsgSwapForExactTokensMultiHopResponse{}, res)
+	suite.Require().NoError(err)
+
+	expectedSwapInput := sdk.NewCoin("ukava", sdkmath.NewInt(1012104))
+	intermediate := sdk.NewCoin("usdx", sdkmath.NewInt(5040247))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(expectedSwapInput).Add(swapOutput))
+	suite.PoolLiquidityEqual(reservesA.Add(expectedSwapInput).Sub(intermediate))
+	suite.PoolLiquidityEqual(reservesB.Add(intermediate).Sub(swapOutput))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, requester.GetAddress().String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSwapForExactTokensMultiHop_SlippageFailure() {
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reservesA))
+	suite.Require().NoError(suite.CreatePool(reservesB))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapMsg := types.NewMsgSwapForExactTokensMultiHop(
+		requester.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(990000)),
+		[]string{"ukava", "usdx", "hard"},
+		sdk.NewCoin("hard", sdkmath.NewInt(10e6)),
+		sdk.MustNewDecFromStr("0.01"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapForExactTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Nil(res)
+	suite.Require().ErrorIs(err, types.ErrSlippageExceeded)
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+}
+
+func (suite *msgServerTestSuite) TestZapDeposit() {
+	pool := types.NewAllowedPool("ukava", "usdx")
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	zap := types.NewMsgZapDeposit(
+		depositor.GetAddress().String(),
+		balance[0],
+		"usdx",
+		sdk.MustNewDecFromStr("0.01"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.ZapDeposit(sdk.WrapSDKContext(suite.Ctx), zap)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgZapDepositResponse{Shares: sdkmath.NewInt(11135771)}, res)
+
+	expectedDeposit := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(5004944)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(24776954)),
+	)
+
+	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.OneInt()),
+		sdk.NewCoin(types.ShareDenom(pool.Name()), res.Shares),
+	))
+	suite.PoolDepositorSharesEqual(depositor.GetAddress(), pool.Name(), res.Shares)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, depositor.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapDeposit,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedDeposit.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, res.Shares.String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestZapDeposit_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	zap := types.NewMsgZapDeposit(
+		depositor.GetAddress().String(),
+		balance[0],
+		"usdx",
+		sdk.MustNewDecFromStr("0.01"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.ZapDeposit(sdk.WrapSDKContext(suite.Ctx), zap)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), zap.GetDeadline().Unix()))
+	suite.Nil(res)
+}
+
+func (suite *msgServerTestSuite) TestPlaceLimitOrder() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("new owner-----------"), balance)
+
+	place := types.NewMsgPlaceLimitOrder(
+		owner.GetAddress().String(),
+		balance[0],
+		"usdx",
+		sdk.MustNewDecFromStr("5.5"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.PlaceLimitOrder(sdk.WrapSDKContext(suite.Ctx), place)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgPlaceLimitOrderResponse{ID: types.DefaultNextLimitOrderID}, res)
+
+	order, found := suite.Keeper.GetLimitOrder(suite.Ctx, res.ID)
+	suite.Require().True(found)
+	suite.Equal(place.Sell, order.Sell)
+	suite.Equal(place.GetExpiration().UTC(), order.Expiration)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins())
+	suite.ModuleAccountBalanceEqual(reserves.Add(balance...))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.GetAddress().String()),
+	))
+
+	cancel := types.NewMsgCancelLimitOrder(owner.GetAddress().String(), res.ID)
+	_, err = suite.msgServer.CancelLimitOrder(sdk.WrapSDKContext(suite.Ctx), cancel)
+	suite.Require().NoError(err)
+
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, res.ID)
+	suite.False(found)
+	suite.AccountBalanceEqual(owner.GetAddress(), balance)
+	suite.ModuleAccountBalanceEqual(reserves)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapLimitOrderCancel,
+		sdk.NewAttribute(types.AttributeKeyLimitOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ukava:usdx"),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, balance[0].String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestCancelLimitOrder_NotFound() {
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("new owner-----------"), sdk.NewCoins())
+
+	cancel := types.NewMsgCancelLimitOrder(owner.GetAddress().String(), 1)
+	res, err := suite.msgServer.CancelLimitOrder(sdk.WrapSDKContext(suite.Ctx), cancel)
+	suite.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+	suite.Nil(res)
+}
+
+func (suite *msgServerTestSuite) TestSyncShares() {
+	pool := types.NewAllowedPool("ukava", "usdx")
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
+	suite.Require().NoError(err)
+
+	recipient := suite.NewAccountFromAddr(sdk.AccAddress("new recipient-------"), sdk.NewCoins())
+	transfer := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(pool.Name()), sdkmath.NewInt(1e6)))
+	suite.Require().NoError(suite.BankKeeper.SendCoins(suite.Ctx, depositor.GetAddress(), recipient.GetAddress(), transfer))
+
+	sync := types.NewMsgSyncShares(recipient.GetAddress().String(), pool.Name())
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SyncShares(sdk.WrapSDKContext(suite.Ctx), sync)
+	suite.Require().Equal(&types.MsgSyncSharesResponse{}, res)
+	suite.Require().NoError(err)
+
+	suite.PoolDepositorSharesEqual(recipient.GetAddress(), pool.Name(), sdkmath.NewInt(1e6))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, recipient.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapSyncShares,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyOwner, recipient.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "1000000"),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSyncShares_NotFound() {
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("new owner-----------"), sdk.NewCoins())
+
+	sync := types.NewMsgSyncShares(owner.GetAddress().String(), types.PoolID("ukava", "usdx"))
+	res, err := suite.msgServer.SyncShares(sdk.WrapSDKContext(suite.Ctx), sync)
+	suite.Require().ErrorIs(err, types.ErrInvalidPool)
+	suite.Nil(res)
+}
+
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(msgServerTestSuite))
 }
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// GetDepositPosition returns a deposit position from the store for a depositor and pool
+func (k Keeper) GetDepositPosition(ctx sdk.Context, depositor sdk.AccAddress, poolID string) (types.DepositPosition, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositPositionPrefix)
+
+	bz := store.Get(types.DepositPositionKey(depositor, poolID))
+	if bz == nil {
+		return types.DepositPosition{}, false
+	}
+
+	var position types.DepositPosition
+	k.cdc.MustUnmarshal(bz, &position)
+	return position, true
+}
+
+// SetDepositPosition saves a deposit position to the store
+func (k Keeper) SetDepositPosition(ctx sdk.Context, position types.DepositPosition) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositPositionPrefix)
+	bz := k.cdc.MustMarshal(&position)
+	store.Set(types.DepositPositionKey(position.Depositor, position.PoolID), bz)
+}
+
+// DeleteDepositPosition deletes a deposit position from the store
+func (k Keeper) DeleteDepositPosition(ctx sdk.Context, depositor sdk.AccAddress, poolID string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositPositionPrefix)
+	store.Delete(types.DepositPositionKey(depositor, poolID))
+}
+
+// IterateDepositPositions iterates over all deposit positions in the store and performs a callback function
+func (k Keeper) IterateDepositPositions(ctx sdk.Context, cb func(position types.DepositPosition) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositPositionPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var position types.DepositPosition
+		k.cdc.MustUnmarshal(iterator.Value(), &position)
+		if cb(position) {
+			break
+		}
+	}
+}
+
+// GetAllDepositPositions returns all deposit positions from the store
+func (k Keeper) GetAllDepositPositions(ctx sdk.Context) (positions types.DepositPositions) {
+	k.IterateDepositPositions(ctx, func(position types.DepositPosition) bool {
+		positions = append(positions, position)
+		return false
+	})
+	return
+}
+
+// GetCurrentDepositPosition returns the deposit position of a holder of a pool's share token, brought up to
+// date with its current share token balance without being stored.
+//
+// Shares transferred away since the position was last updated reduce the cost basis in proportion to the
+// shares removed.  Shares received by transfer, or held from before positions were recorded, are added to the
+// cost basis at their current value in the pool.
+func (k Keeper) GetCurrentDepositPosition(ctx sdk.Context, owner sdk.AccAddress, poolID string, pool *types.DenominatedPool) types.DepositPosition {
+	position, found := k.GetDepositPosition(ctx, owner, poolID)
+	if !found {
+		position = types.NewDepositPosition(owner, poolID, sdkmath.ZeroInt(), sdk.NewCoins(), ctx.BlockTime())
+	}
+
+	balance := k.GetShareBalance(ctx, owner, poolID)
+	switch {
+	case balance.LT(position.Shares):
+		position = position.RemoveShares(position.Shares.Sub(balance))
+	case balance.GT(position.Shares):
+		received := balance.Sub(position.Shares)
+		position = position.AddShares(received, pool.ShareValue(received), ctx.BlockTime())
+	}
+
+	return position
+}
+
+// updateDepositPosition stores a deposit position, deleting the position if its shares are zero
+func (k Keeper) updateDepositPosition(ctx sdk.Context, position types.DepositPosition) {
+	if position.Shares.IsZero() {
+		k.DeleteDepositPosition(ctx, position.Depositor, position.PoolID)
+	} else {
+		k.SetDepositPosition(ctx, position)
+	}
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *keeperTestSuite) setupPositionPool() sdk.AccAddress {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(types.NewAllowedPool("ukava", "usdx")),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("position depositor--"), sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(100e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(500e6)),
+	))
+	return depositor.GetAddress()
+}
+
+func (suite *keeperTestSuite) TestDepositPosition_DepositAndWithdraw() {
+	depositor := suite.setupPositionPool()
+	poolID := types.PoolID("ukava", "usdx")
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(start)
+
+	deposit := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	err := suite.Keeper.Deposit(suite.Ctx, depositor, deposit[0], deposit[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	position, found := suite.Keeper.GetDepositPosition(suite.Ctx, depositor, poolID)
+	suite.Require().True(found)
+	suite.Equal(types.NewDepositPosition(depositor, poolID, sdkmath.NewInt(22360679), deposit, start), position)
+
+	// a second deposit of equal shares moves the average deposit time halfway
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(10 * time.Hour))
+	err = suite.Keeper.Deposit(suite.Ctx, depositor, deposit[0], deposit[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	position, found = suite.Keeper.GetDepositPosition(suite.Ctx, depositor, poolID)
+	suite.Require().True(found)
+	suite.Equal(types.NewDepositPosition(depositor, poolID, sdkmath.NewInt(44721358), deposit.Add(deposit...), start.Add(5*time.Hour)), position)
+
+	// withdrawing half of the shares reduces the cost basis by half
+	err = suite.Keeper.Withdraw(suite.Ctx, depositor, sdkmath.NewInt(22360679), deposit[0].SubAmount(sdkmath.OneInt()), deposit[1].SubAmount(sdkmath.OneInt()))
+	suite.Require().NoError(err)
+
+	position, found = suite.Keeper.GetDepositPosition(suite.Ctx, depositor, poolID)
+	suite.Require().True(found)
+	suite.Equal(types.NewDepositPosition(depositor, poolID, sdkmath.NewInt(22360679), deposit, start.Add(5*time.Hour)), position)
+
+	// withdrawing all shares deletes the position
+	err = suite.Keeper.Withdraw(suite.Ctx, depositor, sdkmath.NewInt(22360679), deposit[0].SubAmount(sdkmath.OneInt()), deposit[1].SubAmount(sdkmath.OneInt()))
+	suite.Require().NoError(err)
+
+	_, found = suite.Keeper.GetDepositPosition(suite.Ctx, depositor, poolID)
+	suite.False(found)
+}
+
+func (suite *keeperTestSuite) TestDepositPosition_ZapDeposit() {
+	depositor := suite.setupPositionPool()
+	poolID := types.PoolID("ukava", "usdx")
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(start)
+
+	deposit := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(30e6)), sdk.NewCoin("usdx", sdkmath.NewInt(150e6)))
+	err := suite.Keeper.Deposit(suite.Ctx, depositor, deposit[0], deposit[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+	position, found := suite.Keeper.GetDepositPosition(suite.Ctx, depositor, poolID)
+	suite.Require().True(found)
+
+	// the cost basis of a zap is the single asset paid, not the deposit of both assets after the swap
+	zap := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	shares, err := suite.Keeper.ZapDeposit(suite.Ctx, depositor, zap, "usdx", sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	expected := types.NewDepositPosition(depositor, poolID, position.Shares.Add(shares), deposit.Add(zap), position.AverageDepositTime)
+	position, found = suite.Keeper.GetDepositPosition(suite.Ctx, depositor, poolID)
+	suite.Require().True(found)
+	suite.Equal(expected, position)
+}
+
+func (suite *keeperTestSuite) TestDepositPosition_TransferredShares() {
+	depositor := suite.setupPositionPool()
+	poolID := types.PoolID("ukava", "usdx")
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(start)
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor, sdk.NewCoin("ukava", sdkmath.NewInt(30e6)), sdk.NewCoin("usdx", sdkmath.NewInt(150e6)), sdk.ZeroDec())
+	suite.Require().NoError(err)
+	shares := suite.Keeper.GetShareBalance(suite.Ctx, depositor, poolID)
+
+	// the price of ukava increases after the deposit
+	trader := suite.NewAccountFromAddr(sdk.AccAddress("position trader-----"), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, trader.GetAddress(), sdk.NewCoin("usdx", sdkmath.NewInt(100e6)), sdk.NewCoin("ukava", sdkmath.NewInt(1)), sdk.OneDec())
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(time.Hour))
+	recipient := sdk.AccAddress("position recipient--")
+	transfer := shares.QuoRaw(3)
+	err = suite.BankKeeper.SendCoins(suite.Ctx, depositor, recipient, sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), transfer)))
+	suite.Require().NoError(err)
+
+	// the sender's cost basis is reduced in proportion to the shares transferred once synced
+	suite.Require().NoError(suite.Keeper.SyncDepositorShares(suite.Ctx, depositor, poolID))
+	position, found := suite.Keeper.GetDepositPosition(suite.Ctx, depositor, poolID)
+	suite.Require().True(found)
+	suite.Equal(shares.Sub(transfer), position.Shares)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(20e6)), sdk.NewCoin("usdx", sdkmath.NewInt(100000001))), position.CostBasis)
+	suite.Equal(start, position.AverageDepositTime)
+
+	// the recipient's cost basis is the value of the shares when they were synced
+	suite.Require().NoError(suite.Keeper.SyncDepositorShares(suite.Ctx, recipient, poolID))
+	record, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	pool, err := types.NewDenominatedPoolFromRecord(record)
+	suite.Require().NoError(err)
+	position, found = suite.Keeper.GetDepositPosition(suite.Ctx, recipient, poolID)
+	suite.Require().True(found)
+	suite.Equal(types.NewDepositPosition(recipient, poolID, transfer, pool.ShareValue(transfer), start.Add(time.Hour)), position)
+}
+
+func (suite *keeperTestSuite) TestQueryDepositPosition() {
+	depositor := suite.setupPositionPool()
+	poolID := types.PoolID("ukava", "usdx")
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(start)
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	deposit := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	err := suite.Keeper.Deposit(suite.Ctx, depositor, deposit[0], deposit[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	res, err := queryServer.DepositPosition(sdk.WrapSDKContext(suite.Ctx), &types.QueryDepositPositionRequest{
+		Owner:  depositor.String(),
+		PoolId: poolID,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdkmath.NewInt(22360679), res.SharesOwned)
+	suite.Equal(deposit, res.CostBasis)
+	suite.Equal(start, res.AverageDepositTime)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(100e6)), res.HoldValue)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(100e6)), res.PositionValue)
+	suite.True(res.ImpermanentLoss.IsZero())
+
+	// the price of ukava increases about 4x after the deposit
+	trader := suite.NewAccountFromAddr(sdk.AccAddress("position trader-----"), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, trader.GetAddress(), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)), sdk.NewCoin("ukava", sdkmath.NewInt(1)), sdk.OneDec())
+	suite.Require().NoError(err)
+
+	res, err = queryServer.DepositPosition(sdk.WrapSDKContext(suite.Ctx), &types.QueryDepositPositionRequest{
+		Owner:  depositor.String(),
+		PoolId: poolID,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(deposit, res.CostBasis)
+	suite.PoolReservesEqual(poolID, res.SharesValue)
+	// the position is worth 2 * ~100e6 usdx, and holding is worth 50e6 usdx + 10e6 ukava * ~20 usdx
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(199999999)), res.PositionValue)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(249699970)), res.HoldValue)
+	// close to 2 * sqrt(4) / (1 + 4) - 1 = -0.2, less the swap fee earned
+	suite.Equal(sdk.MustNewDecFromStr("-0.199038754389918429"), res.ImpermanentLoss)
+
+	// the position can be valued in either pool token
+	res, err = queryServer.DepositPosition(sdk.WrapSDKContext(suite.Ctx), &types.QueryDepositPositionRequest{
+		Owner:      depositor.String(),
+		PoolId:     poolID,
+		QuoteDenom: "ukava",
+	})
+	suite.Require().NoError(err)
+	suite.Equal("ukava", res.PositionValue.Denom)
+	suite.Equal("ukava", res.HoldValue.Denom)
+	suite.True(res.ImpermanentLoss.IsNegative())
+}
+
+func (suite *keeperTestSuite) TestQueryDepositPosition_Errors() {
+	depositor := suite.setupPositionPool()
+	poolID := types.PoolID("ukava", "usdx")
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor, sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)), sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+		req  *types.QueryDepositPositionRequest
+		code codes.Code
+		msg  string
+	}{
+		{"empty request", nil, codes.InvalidArgument, "empty request"},
+		{"invalid owner", &types.QueryDepositPositionRequest{Owner: "invalid", PoolId: poolID}, codes.InvalidArgument, "invalid owner address: decoding bech32 failed: invalid bech32 string length 7"},
+		{"pool not found", &types.QueryDepositPositionRequest{Owner: depositor.String(), PoolId: "hard:usdx"}, codes.NotFound, "pool hard:usdx not found"},
+		{"invalid quote denom", &types.QueryDepositPositionRequest{Owner: depositor.String(), PoolId: poolID, QuoteDenom: "hard"}, codes.InvalidArgument, "quote denom hard is not in pool ukava:usdx"},
+		{
+			"position not found",
+			&types.QueryDepositPositionRequest{Owner: sdk.AccAddress("no position---------").String(), PoolId: poolID},
+			codes.NotFound,
+			fmt.Sprintf("no deposit position for account %s and pool ukava:usdx", sdk.AccAddress("no position---------")),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := queryServer.DepositPosition(sdk.WrapSDKContext(suite.Ctx), tc.req)
+			suite.Equal(status.Error(tc.code, tc.msg), err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// PricefeedAdapter provides pool time-weighted average prices to the pricefeed module
+type PricefeedAdapter struct {
+	keeper Keeper
+}
+
+var _ pricefeedtypes.PoolTWAPSource = PricefeedAdapter{}
+
+// NewPricefeedAdapter returns a new pricefeed adapter for the swap keeper
+func NewPricefeedAdapter(keeper Keeper) PricefeedAdapter {
+	return PricefeedAdapter{keeper: keeper}
+}
+
+// GetPoolTWAP returns the time-weighted average price of a base unit of the base denom of a pool, in
+// base units of the other pool denom, over the window ending at the current block time
+func (a PricefeedAdapter) GetPoolTWAP(ctx sdk.Context, poolID string, baseDenom string, window time.Duration) (sdk.Dec, error) {
+	pool, found := a.keeper.GetPool(ctx, poolID)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	priceA, priceB, err := a.keeper.GetTWAP(ctx, poolID, ctx.BlockTime().Add(-window), ctx.BlockTime())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	switch baseDenom {
+	case pool.ReservesA.Denom:
+		return priceA, nil
+	case pool.ReservesB.Denom:
+		return priceB, nil
+	default:
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidCoin, "denom %s does not match pool %s", baseDenom, poolID)
+	}
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// GetShareBalance returns the balance of a pool's share token held by an account
+func (k Keeper) GetShareBalance(ctx sdk.Context, owner sdk.AccAddress, poolID string) sdkmath.Int {
+	return k.bankKeeper.GetBalance(ctx, owner, types.ShareDenom(poolID)).Amount
+}
+
+// GetShareSupply returns the total supply of a pool's share token
+func (k Keeper) GetShareSupply(ctx sdk.Context, poolID string) sdkmath.Int {
+	return k.bankKeeper.GetSupply(ctx, types.ShareDenom(poolID)).Amount
+}
+
+// SyncDepositorShares updates the shares and deposit position recorded for a holder of a pool's share token to
+// its current balance.
+//
+// Share tokens can be transferred without the swap module being notified, so the shares a holder earns
+// rewards on are only updated when it deposits, withdraws, or syncs. Until then a holder earns on the
+// lesser of its recorded shares and its balance.
+func (k Keeper) SyncDepositorShares(ctx sdk.Context, owner sdk.AccAddress, poolID string) error {
+	pool, err := k.loadDenominatedPool(ctx, poolID)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	_, hasRecord := k.GetDepositorShares(ctx, owner, poolID)
+	balance := k.GetShareBalance(ctx, owner, poolID)
+	if !hasRecord && balance.IsZero() {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "no shares for account %s and pool %s", owner, poolID)
+	}
+
+	k.beforeSharesModified(ctx, owner, poolID)
+	k.afterSharesModified(ctx, owner, poolID)
+	k.updateDepositPosition(ctx, k.GetCurrentDepositPosition(ctx, owner, poolID, pool))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapSyncShares,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, balance.String()),
+		),
+	)
+
+	return nil
+}
+
+// InitializeShareTokens mints share tokens to the holders of each share record for pools that have no share
+// token supply. It is used to issue share tokens for deposits made before shares were tokenized, and records
+// the cost basis of each deposit at its current value.
+func (k Keeper) InitializeShareTokens(ctx sdk.Context) error {
+	unminted := make(map[string]*types.DenominatedPool)
+	for _, record := range k.GetAllPools(ctx) {
+		if !k.GetShareSupply(ctx, record.PoolID).IsZero() {
+			continue
+		}
+		pool, err := types.NewDenominatedPoolFromRecord(record)
+		if err != nil {
+			return err
+		}
+		unminted[record.PoolID] = pool
+	}
+
+	for _, record := range k.GetAllDepositorShares(ctx) {
+		pool, found := unminted[record.PoolID]
+		if !found {
+			continue
+		}
+		if err := k.mintShares(ctx, record.Depositor, record.PoolID, record.SharesOwned); err != nil {
+			return err
+		}
+		k.updateDepositPosition(ctx, k.GetCurrentDepositPosition(ctx, record.Depositor, record.PoolID, pool))
+	}
+
+	return nil
+}
+
+// mintShares mints a pool's share tokens to an account
+func (k Keeper) mintShares(ctx sdk.Context, to sdk.AccAddress, poolID string, shares sdkmath.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), shares))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleAccountName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, to, coins)
+}
+
+// burnShares burns a pool's share tokens from an account
+func (k Keeper) burnShares(ctx sdk.Context, from sdk.AccAddress, poolID string, shares sdkmath.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), shares))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleAccountName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleAccountName, coins)
+}
+
+// beforeSharesModified calls the deposit modified hook with the shares a holder has earned on since it was
+// last synced, and must be called before its share token balance changes
+func (k Keeper) beforeSharesModified(ctx sdk.Context, owner sdk.AccAddress, poolID string) {
+	if shares, found := k.GetDepositorSharesAmount(ctx, owner, poolID); found {
+		k.BeforePoolDepositModified(ctx, poolID, owner, shares)
+	}
+}
+
+// afterSharesModified records the share token balance of a holder, calling the deposit created hook
+// if the holder had no recorded shares
+func (k Keeper) afterSharesModified(ctx sdk.Context, owner sdk.AccAddress, poolID string) {
+	_, hasRecord := k.GetDepositorShares(ctx, owner, poolID)
+	balance := k.GetShareBalance(ctx, owner, poolID)
+
+	k.updateDepositorShares(ctx, owner, poolID, balance)
+	if !hasRecord && balance.IsPositive() {
+		k.AfterPoolDepositCreated(ctx, poolID, owner, balance)
+	}
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+	"github.com/kava-labs/kava/x/swap/types/mocks"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *keeperTestSuite) TestShares_Transfer() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolID := suite.setupPool(reserves, totalShares, owner.GetAddress())
+
+	recipient := sdk.AccAddress("recipient-----------")
+	transfer := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), sdkmath.NewInt(10e6)))
+	suite.Require().NoError(suite.BankKeeper.SendCoins(suite.Ctx, owner.GetAddress(), recipient, transfer))
+
+	// the sender earns on its remaining balance until it is synced
+	shares, found := suite.Keeper.GetDepositorSharesAmount(suite.Ctx, owner.GetAddress(), poolID)
+	suite.Require().True(found)
+	suite.Equal(sdkmath.NewInt(20e6), shares)
+	suite.PoolDepositorSharesEqual(owner.GetAddress(), poolID, totalShares)
+
+	// the recipient does not earn until it is synced
+	_, found = suite.Keeper.GetDepositorSharesAmount(suite.Ctx, recipient, poolID)
+	suite.False(found)
+	suite.Equal(sdkmath.NewInt(10e6), suite.Keeper.GetShareBalance(suite.Ctx, recipient, poolID))
+	suite.Equal(totalShares, suite.Keeper.GetShareSupply(suite.Ctx, poolID))
+}
+
+func (suite *keeperTestSuite) TestSyncDepositorShares() {
+	suite.Keeper.ClearHooks()
+	swapHooks := &mocks.SwapHooks{}
+	suite.Keeper.SetHooks(swapHooks)
+
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	poolID := suite.setupPool(reserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	recipient := sdk.AccAddress("recipient-----------")
+	transfer := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), sdkmath.NewInt(10e6)))
+	suite.Require().NoError(suite.BankKeeper.SendCoins(suite.Ctx, owner.GetAddress(), recipient, transfer))
+
+	// syncing the recipient creates its deposit
+	swapHooks.On("AfterPoolDepositCreated", suite.Ctx, poolID, recipient, sdkmath.NewInt(10e6)).Once()
+	suite.Require().NoError(suite.Keeper.SyncDepositorShares(suite.Ctx, recipient, poolID))
+	suite.PoolDepositorSharesEqual(recipient, poolID, sdkmath.NewInt(10e6))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapSyncShares,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "10000000"),
+	))
+
+	// syncing the sender updates its deposit to its remaining balance
+	swapHooks.On("BeforePoolDepositModified", suite.Ctx, poolID, owner.GetAddress(), sdkmath.NewInt(20e6)).Once()
+	suite.Require().NoError(suite.Keeper.SyncDepositorShares(suite.Ctx, owner.GetAddress(), poolID))
+	suite.PoolDepositorSharesEqual(owner.GetAddress(), poolID, sdkmath.NewInt(20e6))
+
+	// syncing a holder that transferred all of its shares deletes its deposit
+	transfer = sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), sdkmath.NewInt(10e6)))
+	suite.Require().NoError(suite.BankKeeper.SendCoins(suite.Ctx, recipient, owner.GetAddress(), transfer))
+	swapHooks.On("BeforePoolDepositModified", suite.Ctx, poolID, recipient, sdkmath.ZeroInt()).Once()
+	suite.Require().NoError(suite.Keeper.SyncDepositorShares(suite.Ctx, recipient, poolID))
+	suite.PoolSharesDeleted(recipient, "ukava", "usdx")
+
+	swapHooks.AssertExpectations(suite.T())
+}
+
+func (suite *keeperTestSuite) TestSyncDepositorShares_Errors() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	poolID := suite.setupPool(reserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	err := suite.Keeper.SyncDepositorShares(suite.Ctx, owner.GetAddress(), "hard:usdx")
+	suite.EqualError(err, "pool hard:usdx not found: invalid pool")
+
+	holder := sdk.AccAddress("holder--------------")
+	err = suite.Keeper.SyncDepositorShares(suite.Ctx, holder, poolID)
+	suite.EqualError(err, "no shares for account "+holder.String()+" and pool ukava:usdx: deposit not found")
+}
+
+func (suite *keeperTestSuite) TestWithdraw_TransferredShares() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	poolID := suite.setupPool(reserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	recipient := suite.NewAccountFromAddr(sdk.AccAddress("recipient-----------"), sdk.Coins{})
+	transfer := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), sdkmath.NewInt(15e6)))
+	suite.Require().NoError(suite.BankKeeper.SendCoins(suite.Ctx, owner.GetAddress(), recipient.GetAddress(), transfer))
+
+	// the recipient can withdraw without syncing its shares
+	minCoinA := sdk.NewCoin("usdx", sdkmath.NewInt(25e6))
+	minCoinB := sdk.NewCoin("ukava", sdkmath.NewInt(5e6))
+	err := suite.Keeper.Withdraw(suite.Ctx, recipient.GetAddress(), sdkmath.NewInt(15e6), minCoinA, minCoinB)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(recipient.GetAddress(), sdk.NewCoins(minCoinA, minCoinB))
+	suite.PoolShareTotalEqual(poolID, sdkmath.NewInt(15e6))
+	suite.PoolSharesDeleted(recipient.GetAddress(), "ukava", "usdx")
+	suite.Equal(sdkmath.NewInt(15e6), suite.Keeper.GetShareSupply(suite.Ctx, poolID))
+
+	// the sender can not withdraw the shares it transferred
+	err = suite.Keeper.Withdraw(suite.Ctx, owner.GetAddress(), sdkmath.NewInt(30e6), minCoinA, minCoinB)
+	suite.ErrorIs(err, types.ErrInvalidShares)
+}
+
+func (suite *keeperTestSuite) TestInitializeShareTokens() {
+	depositor_1 := sdk.AccAddress("depositor 1---------")
+	depositor_2 := sdk.AccAddress("depositor 2---------")
+	poolID := types.PoolID("ukava", "usdx")
+
+	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(
+		sdk.NewCoins(
+			sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+		),
+		sdkmath.NewInt(3e6),
+	))
+	suite.Keeper.SetDepositorShares(suite.Ctx, types.NewShareRecord(depositor_1, poolID, sdkmath.NewInt(2e6)))
+	suite.Keeper.SetDepositorShares(suite.Ctx, types.NewShareRecord(depositor_2, poolID, sdkmath.NewInt(1e6)))
+
+	suite.Require().NoError(suite.Keeper.InitializeShareTokens(suite.Ctx))
+	suite.AccountBalanceEqual(depositor_1, sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), sdkmath.NewInt(2e6))))
+	suite.AccountBalanceEqual(depositor_2, sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), sdkmath.NewInt(1e6))))
+
+	// the cost basis of the deposits is recorded at their current value
+	position, found := suite.Keeper.GetDepositPosition(suite.Ctx, depositor_1, poolID)
+	suite.Require().True(found)
+	suite.Equal(sdkmath.NewInt(2e6), position.Shares)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(666666)), sdk.NewCoin("usdx", sdkmath.NewInt(3333333))), position.CostBasis)
+
+	// pools that already have share tokens are not minted again
+	suite.Require().NoError(suite.Keeper.InitializeShareTokens(suite.Ctx))
+	suite.Equal(sdkmath.NewInt(3e6), suite.Keeper.GetShareSupply(suite.Ctx, poolID))
+}
+
+func (suite *keeperTestSuite) TestMigrate1to2() {
+	// remove the mint and burn permissions from the module account
+	macc := suite.Keeper.GetSwapModuleAccount(suite.Ctx)
+	baseAcc := authtypes.NewBaseAccount(macc.GetAddress(), macc.GetPubKey(), macc.GetAccountNumber(), macc.GetSequence())
+	suite.AccountKeeper.SetModuleAccount(suite.Ctx, authtypes.NewModuleAccount(baseAcc, types.ModuleAccountName))
+
+	depositor := sdk.AccAddress("depositor 1---------")
+	poolID := types.PoolID("ukava", "usdx")
+	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(
+		sdk.NewCoins(
+			sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+		),
+		sdkmath.NewInt(3e6),
+	))
+	suite.Keeper.SetDepositorShares(suite.Ctx, types.NewShareRecord(depositor, poolID, sdkmath.NewInt(3e6)))
+
+	suite.Require().NoError(keeper.NewMigrator(suite.Keeper).Migrate1to2(suite.Ctx))
+
+	macc = suite.Keeper.GetSwapModuleAccount(suite.Ctx)
+	suite.True(macc.HasPermission(authtypes.Minter))
+	suite.True(macc.HasPermission(authtypes.Burner))
+	suite.AccountBalanceEqual(depositor, sdk.NewCoins(sdk.NewCoin(types.ShareDenom(poolID), sdkmath.NewInt(3e6))))
+}
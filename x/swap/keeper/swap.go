@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	communitytypes "github.com/kava-labs/kava/x/community/types"
 	"github.com/kava-labs/kava/x/swap/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -16,17 +17,17 @@ func (k *Keeper) SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, e
 		return err
 	}
 
-	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetSwapFee(ctx))
-	if swapOutput.IsZero() {
+	hop := k.swapExactInput(ctx, poolID, pool, exactCoinA)
+	if hop.output.IsZero() {
 		return errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
 	}
 
-	priceChange := sdk.NewDecFromInt(swapOutput.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
+	priceChange := sdk.NewDecFromInt(hop.output.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
 	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
 		return err
 	}
 
-	if err := k.commitSwap(ctx, poolID, pool, requester, exactCoinA, swapOutput, feePaid, "input"); err != nil {
+	if err := k.commitSwap(ctx, requester, []swapHop{hop}, "input"); err != nil {
 		return err
 	}
 
@@ -47,20 +48,157 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 		)
 	}
 
-	swapInput, feePaid := pool.SwapWithExactOutput(exactCoinB, k.GetSwapFee(ctx))
+	hop := k.swapExactOutput(ctx, poolID, pool, coinA.Denom, exactCoinB)
 
-	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(sdk.NewDecFromInt(swapInput.Sub(feePaid).Amount))
+	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(sdk.NewDecFromInt(hop.input.Sub(hop.fee).Amount))
 	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
 		return err
 	}
 
-	if err := k.commitSwap(ctx, poolID, pool, requester, swapInput, exactCoinB, feePaid, "output"); err != nil {
+	if err := k.commitSwap(ctx, requester, []swapHop{hop}, "output"); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// SwapExactForTokensMultiHop swaps an exact coin a input for a coin b output through each pool in the route.
+// The slippage limit applies to the final output of the route.
+func (k *Keeper) SwapExactForTokensMultiHop(
+	ctx sdk.Context, requester sdk.AccAddress, exactCoinA sdk.Coin, route []string, coinB sdk.Coin, slippageLimit sdk.Dec,
+) error {
+	if err := types.ValidateRoute(route, exactCoinA.Denom, coinB.Denom); err != nil {
+		return err
+	}
+
+	hops, err := k.calculateExactInputHops(ctx, exactCoinA, route)
+	if err != nil {
+		return err
+	}
+
+	finalOutput := hops[len(hops)-1].output
+	priceChange := sdk.NewDecFromInt(finalOutput.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	return k.commitSwap(ctx, requester, hops, "input")
+}
+
+// SwapForExactTokensMultiHop swaps a coin a input for an exact coin b output through each pool in the route.
+// The slippage limit applies to the total input of the route excluding the fees paid to each pool, matching
+// SwapForExactTokens for a route through a single pool.
+func (k *Keeper) SwapForExactTokensMultiHop(
+	ctx sdk.Context, requester sdk.AccAddress, coinA sdk.Coin, route []string, exactCoinB sdk.Coin, slippageLimit sdk.Dec,
+) error {
+	if err := types.ValidateRoute(route, coinA.Denom, exactCoinB.Denom); err != nil {
+		return err
+	}
+
+	hops, err := k.calculateExactOutputHops(ctx, route, exactCoinB)
+	if err != nil {
+		return err
+	}
+
+	// each hop's fee is removed from the input in proportion to the share of the hop's input it makes up, since
+	// the fees of later hops are paid in other denoms
+	inputWithoutFees := sdk.NewDecFromInt(hops[0].input.Amount)
+	for _, hop := range hops {
+		inputWithoutFees = inputWithoutFees.MulInt(hop.input.Sub(hop.fee).Amount).QuoInt(hop.input.Amount)
+	}
+	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(inputWithoutFees)
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	return k.commitSwap(ctx, requester, hops, "output")
+}
+
+// calculateExactInputHops returns the trade against each pool in the route for an exact coin a input.  The pools
+// of the returned hops are loaded from the store and are not saved.
+func (k Keeper) calculateExactInputHops(ctx sdk.Context, exactCoinA sdk.Coin, route []string) ([]swapHop, error) {
+	hops := make([]swapHop, len(route)-1)
+	swapInput := exactCoinA
+	for i := range hops {
+		poolID, pool, err := k.loadPool(ctx, route[i], route[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		hops[i] = k.swapExactInput(ctx, poolID, pool, swapInput)
+		if hops[i].output.IsZero() {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output from pool %s rounds to zero, increase input amount", poolID)
+		}
+
+		swapInput = hops[i].output
+	}
+
+	return hops, nil
+}
+
+// calculateExactOutputHops returns the trade against each pool in the route for an exact coin b output, walking
+// the route backwards to find the input required by each pool.  The pools of the returned hops are loaded from
+// the store and are not saved.
+func (k Keeper) calculateExactOutputHops(ctx sdk.Context, route []string, exactCoinB sdk.Coin) ([]swapHop, error) {
+	hops := make([]swapHop, len(route)-1)
+	swapOutput := exactCoinB
+	for i := len(hops) - 1; i >= 0; i-- {
+		poolID, pool, err := k.loadPool(ctx, route[i], route[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		if swapOutput.Amount.GTE(pool.Reserves().AmountOf(swapOutput.Denom)) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInsufficientLiquidity,
+				"output %s >= pool %s reserves %s", swapOutput.Amount.String(), poolID, pool.Reserves().AmountOf(swapOutput.Denom).String(),
+			)
+		}
+
+		hops[i] = k.swapExactOutput(ctx, poolID, pool, route[i], swapOutput)
+		swapOutput = hops[i].input
+	}
+
+	return hops, nil
+}
+
+// swapExactInput trades an exact input against a pool at the pool's swap fee, withdrawing the protocol's share
+// of the fee from the pool reserves
+func (k Keeper) swapExactInput(ctx sdk.Context, poolID string, pool *types.DenominatedPool, swapInput sdk.Coin) swapHop {
+	spotPrice := pool.SpotPrice(swapInput.Denom)
+	swapOutput, feePaid := pool.SwapWithExactInput(swapInput, k.GetPoolSwapFee(ctx, pool))
+	protocolFee := k.withdrawProtocolFee(ctx, pool, feePaid)
+
+	return swapHop{
+		poolID: poolID, pool: pool, input: swapInput, output: swapOutput, fee: feePaid, protocolFee: protocolFee, spotPrice: spotPrice,
+	}
+}
+
+// swapExactOutput trades an input of the provided denom against a pool for an exact output at the pool's swap fee,
+// withdrawing the protocol's share of the fee from the pool reserves
+func (k Keeper) swapExactOutput(ctx sdk.Context, poolID string, pool *types.DenominatedPool, inputDenom string, swapOutput sdk.Coin) swapHop {
+	spotPrice := pool.SpotPrice(inputDenom)
+	swapInput, feePaid := pool.SwapWithExactOutput(swapOutput, k.GetPoolSwapFee(ctx, pool))
+	protocolFee := k.withdrawProtocolFee(ctx, pool, feePaid)
+
+	return swapHop{
+		poolID: poolID, pool: pool, input: swapInput, output: swapOutput, fee: feePaid, protocolFee: protocolFee, spotPrice: spotPrice,
+	}
+}
+
+// withdrawProtocolFee removes the protocol's share of a swap fee from the pool reserves so it is not earned by
+// liquidity providers.  The protocol fee is truncated, rounding in favor of liquidity providers.
+func (k Keeper) withdrawProtocolFee(ctx sdk.Context, pool *types.DenominatedPool, feePaid sdk.Coin) sdk.Coin {
+	amount := sdk.NewDecFromInt(feePaid.Amount).Mul(k.GetProtocolFee(ctx)).TruncateInt()
+	protocolFee := sdk.NewCoin(feePaid.Denom, amount)
+
+	if protocolFee.IsPositive() {
+		pool.WithdrawFee(protocolFee)
+	}
+
+	return protocolFee
+}
+
 func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string, *types.DenominatedPool, error) {
 	poolID := types.PoolID(denomA, denomB)
 
@@ -69,10 +207,11 @@ func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string,
 		return poolID, nil, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
 	}
 
-	pool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
 	}
+	k.setPoolSwapFee(ctx, poolID, pool)
 
 	return poolID, pool, nil
 }
@@ -86,17 +225,41 @@ func (k Keeper) assertSlippageWithinLimit(priceChange sdk.Dec, slippageLimit sdk
 	return nil
 }
 
+// swapHop represents a trade against a single pool
+type swapHop struct {
+	poolID string
+	pool   *types.DenominatedPool
+	input  sdk.Coin
+	output sdk.Coin
+	fee    sdk.Coin
+	// protocolFee is the portion of the fee sent to the community pool
+	protocolFee sdk.Coin
+	// spotPrice is the price of the input in units of the output before the trade
+	spotPrice sdk.Dec
+}
+
+// priceImpact returns the decimal percentage difference between the execution price of the trade, excluding
+// fees, and the spot price of the pool before the trade
+func (h swapHop) priceImpact() sdk.Dec {
+	expectedOutput := sdk.NewDecFromInt(h.input.Sub(h.fee).Amount).Mul(h.spotPrice)
+	return sdk.OneDec().Sub(sdk.NewDecFromInt(h.output.Amount).Quo(expectedOutput))
+}
+
+// commitSwap stores the updated pools and their prices, transfers the first hop's input from the requester, the
+// last hop's output to the requester, and the protocol fees to the community pool, then emits an event and calls
+// hooks for every pool traded against.
 func (k Keeper) commitSwap(
 	ctx sdk.Context,
-	poolID string,
-	pool *types.DenominatedPool,
 	requester sdk.AccAddress,
-	swapInput sdk.Coin,
-	swapOutput sdk.Coin,
-	feePaid sdk.Coin,
+	hops []swapHop,
 	exactDirection string,
 ) error {
-	k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+	for _, hop := range hops {
+		k.updatePool(ctx, hop.poolID, hop.pool)
+	}
+
+	swapInput := hops[0].input
+	swapOutput := hops[len(hops)-1].output
 
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
 		return err
@@ -106,17 +269,32 @@ func (k Keeper) commitSwap(
 		panic(err)
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSwapTrade,
-			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
-			sdk.NewAttribute(types.AttributeKeyRequester, requester.String()),
-			sdk.NewAttribute(types.AttributeKeySwapInput, swapInput.String()),
-			sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
-			sdk.NewAttribute(types.AttributeKeyFeePaid, feePaid.String()),
-			sdk.NewAttribute(types.AttributeKeyExactDirection, exactDirection),
-		),
-	)
+	protocolFees := sdk.NewCoins()
+	for _, hop := range hops {
+		protocolFees = protocolFees.Add(hop.protocolFee)
+	}
+	if !protocolFees.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, communitytypes.ModuleAccountName, protocolFees); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, hop := range hops {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapTrade,
+				sdk.NewAttribute(types.AttributeKeyPoolID, hop.poolID),
+				sdk.NewAttribute(types.AttributeKeyRequester, requester.String()),
+				sdk.NewAttribute(types.AttributeKeySwapInput, hop.input.String()),
+				sdk.NewAttribute(types.AttributeKeySwapOutput, hop.output.String()),
+				sdk.NewAttribute(types.AttributeKeyFeePaid, hop.fee.String()),
+				sdk.NewAttribute(types.AttributeKeyProtocolFee, hop.protocolFee.String()),
+				sdk.NewAttribute(types.AttributeKeyExactDirection, exactDirection),
+			),
+		)
+
+		k.AfterPoolSwap(ctx, hop.poolID, requester, hop.input, hop.output)
+	}
 
 	return nil
 }
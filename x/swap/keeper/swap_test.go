@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
 	"github.com/kava-labs/kava/x/swap/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -14,7 +15,9 @@ import (
 
 func (suite *keeperTestSuite) TestSwapExactForTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -47,6 +50,178 @@ func (suite *keeperTestSuite) TestSwapExactForTokens() {
 		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
 		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
 		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokens_StableSwap() {
+	pool := types.NewStableSwapAllowedPool("usdc", "usdx", 100)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), sdk.ZeroDec()))
+
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("usdc", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	depositor := suite.CreateAccount(reserves)
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("usdc", sdkmath.NewInt(100e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("usdc", sdkmath.NewInt(100e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(100e6))
+
+	// a constant product pool would return 90909090usdx with 9% slippage
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.001"))
+	suite.Require().NoError(err)
+
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(99949776))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokens_Weighted() {
+	pool := types.NewWeightedAllowedPool("ukava", "usdx", 80, 20)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), sdk.ZeroDec()))
+
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(250e6)),
+	)
+	depositor := suite.CreateAccount(reserves)
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(100e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(100e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(100e6))
+
+	// the 80/20 pool prices ukava at 1usdx, where a constant product pool would return 22727272usdx
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.25"))
+	suite.Require().NoError(err)
+
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(79246636))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokens_PoolSwapFee() {
+	pool := types.NewAllowedPool("ukava", "usdx").WithSwapFee(sdk.MustNewDecFromStr("0.01"))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), sdk.MustNewDecFromStr("0.003")))
+
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	depositor := suite.CreateAccount(reserves)
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	record, found := suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(sdk.MustNewDecFromStr("0.01"), record.SwapFee, "expected pool to be created with the fee tier of the allowed pool")
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	// the pool fee tier of 1% is used instead of the global swap fee
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(4945104))
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "10000ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+
+	// removing the fee tier through a param change applies the global swap fee to the existing pool
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool.WithSwapFee(sdk.ZeroDec())), sdk.MustNewDecFromStr("0.003")))
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, "4970144usdx"),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "3000ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+
+	record, found = suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.True(record.SwapFee.IsZero(), "expected pool record to be updated with the fee tier of the allowed pool")
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokens_ProtocolFee() {
+	params := types.NewParams(types.NewAllowedPools(types.NewAllowedPool("ukava", "usdx")), sdk.MustNewDecFromStr("0.003"))
+	params.ProtocolFee = sdk.MustNewDecFromStr("0.25")
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	depositor := suite.CreateAccount(reserves)
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	communityAddr := suite.AccountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+	communityBalance := suite.BankKeeper.GetAllBalances(suite.Ctx, communityAddr)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	// a quarter of the 3000ukava fee is sent to the community pool instead of the pool reserves
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(4980034))
+	protocolFee := sdk.NewCoin("ukava", sdkmath.NewInt(750))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.AccountBalanceEqual(communityAddr, communityBalance.Add(protocolFee))
+	suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput).Sub(protocolFee))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput).Sub(protocolFee))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ukava:usdx"),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "3000ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, protocolFee.String()),
 		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
 	))
 }
@@ -131,7 +306,9 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:          tc.fee,
+				ProtocolFee:      sdk.ZeroDec(),
+				MinInitialShares: sdk.ZeroInt(),
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -321,7 +498,9 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_PanicOnInsufficientModuleAc
 
 func (suite *keeperTestSuite) TestSwapForExactTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -354,10 +533,47 @@ func (suite *keeperTestSuite) TestSwapForExactTokens() {
 		sdk.NewAttribute(types.AttributeKeySwapInput, expectedInput.String()),
 		sdk.NewAttribute(types.AttributeKeySwapOutput, coinB.String()),
 		sdk.NewAttribute(types.AttributeKeyFeePaid, "2509ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
 		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
 	))
 }
 
+func (suite *keeperTestSuite) TestSwapForExactTokens_ProtocolFee() {
+	params := types.NewParams(types.NewAllowedPools(types.NewAllowedPool("ukava", "usdx")), sdk.MustNewDecFromStr("0.003"))
+	params.ProtocolFee = sdk.OneDec()
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	depositor := suite.CreateAccount(reserves)
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	communityAddr := suite.AccountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+	communityBalance := suite.BankKeeper.GetAllBalances(suite.Ctx, communityAddr)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	err = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	// the entire fee is sent to the community pool, so liquidity providers only receive the input without fees
+	expectedInput := sdk.NewCoin("ukava", sdkmath.NewInt(1004015))
+	protocolFee := sdk.NewCoin("ukava", sdkmath.NewInt(3013))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(expectedInput).Add(coinB))
+	suite.AccountBalanceEqual(communityAddr, communityBalance.Add(protocolFee))
+	suite.PoolLiquidityEqual(reserves.Add(expectedInput).Sub(coinB).Sub(protocolFee))
+	suite.ModuleAccountBalanceEqual(reserves.Add(expectedInput).Sub(coinB).Sub(protocolFee))
+}
+
 func (suite *keeperTestSuite) TestSwapForExactTokens_OutputLessThanPoolReserves() {
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -442,7 +658,9 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:          tc.fee,
+				ProtocolFee:      sdk.ZeroDec(),
+				MinInitialShares: sdk.ZeroInt(),
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -629,3 +847,287 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_PanicOnInsufficientModuleAc
 		_ = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
 	}, "expected panic when module account does not have enough funds")
 }
+
+func (suite *keeperTestSuite) TestSwapExactForTokensMultiHop() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(10e6))
+	route := []string{"ukava", "usdx", "hard"}
+
+	err := suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, route, coinB, sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+
+	intermediate := sdk.NewCoin("usdx", sdkmath.NewInt(4982529))
+	expectedOutput := sdk.NewCoin("hard", sdkmath.NewInt(9890985))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reservesA.Add(reservesB...).Add(coinA).Sub(expectedOutput))
+	suite.PoolReservesEqual(poolIDA, reservesA.Add(coinA).Sub(intermediate))
+	suite.PoolReservesEqual(poolIDB, reservesB.Add(intermediate).Sub(expectedOutput))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDA),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDB),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "12457usdx"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0usdx"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensMultiHop_Slippage() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	route := []string{"ukava", "usdx", "hard"}
+
+	// exact output is accepted with zero slippage
+	ctx, _ := suite.Ctx.CacheContext()
+	err := suite.Keeper.SwapExactForTokensMultiHop(ctx, requester.GetAddress(), coinA, route, sdk.NewCoin("hard", sdkmath.NewInt(9890985)), sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	// one more unit exceeds zero slippage and leaves state untouched
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, route, sdk.NewCoin("hard", sdkmath.NewInt(9890986)), sdk.ZeroDec())
+	suite.Require().ErrorIs(err, types.ErrSlippageExceeded)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.PoolReservesEqual(poolIDA, reservesA)
+	suite.PoolReservesEqual(poolIDB, reservesB)
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensMultiHop_InvalidRoute() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.setupPool(reserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(1e6))
+
+	err := suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, []string{"usdx", "hard"}, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrInvalidRoute)
+
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, []string{"ukava", "usdx", "hard"}, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrInvalidPool)
+	suite.Contains(err.Error(), "pool hard:usdx not found")
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.PoolLiquidityEqual(reserves)
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensMultiHop() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(10e6))
+	route := []string{"ukava", "usdx", "hard"}
+
+	err := suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, route, coinB, sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+
+	expectedInput := sdk.NewCoin("ukava", sdkmath.NewInt(1011089))
+	intermediate := sdk.NewCoin("usdx", sdkmath.NewInt(5037721))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(expectedInput).Add(coinB))
+	suite.ModuleAccountBalanceEqual(reservesA.Add(reservesB...).Add(expectedInput).Sub(coinB))
+	suite.PoolReservesEqual(poolIDA, reservesA.Add(expectedInput).Sub(intermediate))
+	suite.PoolReservesEqual(poolIDB, reservesB.Add(intermediate).Sub(coinB))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDA),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, expectedInput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2528ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDB),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, coinB.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "12595usdx"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0usdx"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensMultiHop_Slippage() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(10e6))
+	route := []string{"ukava", "usdx", "hard"}
+
+	// the required input excluding the fees of both hops is 1006039.457... ukava, which is accepted with zero slippage
+	ctx, _ := suite.Ctx.CacheContext()
+	err := suite.Keeper.SwapForExactTokensMultiHop(ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1006040)), route, coinB, sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	// one less unit exceeds zero slippage and leaves state untouched
+	err = suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1006039)), route, coinB, sdk.ZeroDec())
+	suite.Require().ErrorIs(err, types.ErrSlippageExceeded)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.PoolReservesEqual(poolIDA, reservesA)
+	suite.PoolReservesEqual(poolIDB, reservesB)
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensMultiHop_SinglePoolSlippageMatchesSwapForExactTokens() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.setupPool(reserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6))))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	for amount := int64(995000); amount <= 1005000; amount += 250 {
+		for _, slippage := range []sdk.Dec{sdk.ZeroDec(), sdk.MustNewDecFromStr("0.005")} {
+			coinA := sdk.NewCoin("ukava", sdkmath.NewInt(amount))
+
+			ctx, _ := suite.Ctx.CacheContext()
+			singleErr := suite.Keeper.SwapForExactTokens(ctx, requester.GetAddress(), coinA, coinB, slippage)
+
+			ctx, _ = suite.Ctx.CacheContext()
+			multiErr := suite.Keeper.SwapForExactTokensMultiHop(ctx, requester.GetAddress(), coinA, []string{"ukava", "usdx"}, coinB, slippage)
+
+			suite.Require().Equal(singleErr == nil, multiErr == nil, "coin a %s, slippage %s", coinA, slippage)
+		}
+	}
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensMultiHop_OutputLessThanPoolReserves() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10000e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1000e6))
+	// requires more usdx from the first pool than it holds
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(1990e6))
+	route := []string{"ukava", "usdx", "hard"}
+
+	err := suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, route, coinB, sdk.MustNewDecFromStr("1"))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLiquidity)
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// SetPriceObservation saves a price observation to the store
+func (k Keeper) SetPriceObservation(ctx sdk.Context, observation types.PriceObservation) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationPrefix)
+	bz := k.cdc.MustMarshal(&observation)
+	store.Set(types.PriceObservationKey(observation.PoolID, observation.Timestamp), bz)
+}
+
+// DeletePriceObservation deletes a price observation from the store
+func (k Keeper) DeletePriceObservation(ctx sdk.Context, poolID string, timestamp time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationPrefix)
+	store.Delete(types.PriceObservationKey(poolID, timestamp))
+}
+
+// IteratePriceObservations iterates over all price observations in the store and performs a callback function
+func (k Keeper) IteratePriceObservations(ctx sdk.Context, cb func(observation types.PriceObservation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		if cb(observation) {
+			break
+		}
+	}
+}
+
+// GetAllPriceObservations returns all price observations from the store
+func (k Keeper) GetAllPriceObservations(ctx sdk.Context) (observations types.PriceObservations) {
+	k.IteratePriceObservations(ctx, func(observation types.PriceObservation) bool {
+		observations = append(observations, observation)
+		return false
+	})
+	return
+}
+
+// IteratePriceObservationsByPool iterates over the price observations of a pool in time order
+// and performs a callback function
+func (k Keeper) IteratePriceObservationsByPool(ctx sdk.Context, poolID string, cb func(observation types.PriceObservation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.PriceObservationsKeyPrefix(poolID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		if cb(observation) {
+			break
+		}
+	}
+}
+
+// GetPriceObservationAt returns the most recent price observation of a pool at or before a time
+func (k Keeper) GetPriceObservationAt(ctx sdk.Context, poolID string, t time.Time) (types.PriceObservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationPrefix)
+	start := types.PriceObservationsKeyPrefix(poolID)
+	end := sdk.PrefixEndBytes(types.PriceObservationKey(poolID, t))
+
+	iterator := store.ReverseIterator(start, end)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceObservation{}, false
+	}
+
+	var observation types.PriceObservation
+	k.cdc.MustUnmarshal(iterator.Value(), &observation)
+	return observation, true
+}
+
+// GetTWAP returns the time-weighted average prices of token a and token b of a pool between the start
+// and end time.  The window must be within the stored price history of the pool.
+func (k Keeper) GetTWAP(ctx sdk.Context, poolID string, start, end time.Time) (sdk.Dec, sdk.Dec, error) {
+	if !start.Before(end) {
+		return sdk.Dec{}, sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidWindow, "start time %s must be before end time %s", start.UTC(), end.UTC())
+	}
+	if end.After(ctx.BlockTime()) {
+		return sdk.Dec{}, sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidWindow, "end time %s is after block time %s", end.UTC(), ctx.BlockTime().UTC())
+	}
+
+	startObservation, found := k.GetPriceObservationAt(ctx, poolID, start)
+	if !found {
+		return sdk.Dec{}, sdk.Dec{}, errorsmod.Wrapf(types.ErrPriceHistoryNotFound, "no price history for pool %s at %s", poolID, start.UTC())
+	}
+	endObservation, found := k.GetPriceObservationAt(ctx, poolID, end)
+	if !found {
+		panic("price history must exist after start observation")
+	}
+
+	startA, startB := startObservation.CumulativePricesAt(start)
+	endA, endB := endObservation.CumulativePricesAt(end)
+	elapsed := sdk.NewDecWithPrec(end.Sub(start).Nanoseconds(), 9)
+
+	return endA.Sub(startA).Quo(elapsed), endB.Sub(startB).Quo(elapsed), nil
+}
+
+// updatePriceObservation records the spot prices of a pool after its reserves change, accumulating the
+// previous spot prices for the time they were held.  Observations older than the retention period are pruned.
+func (k Keeper) updatePriceObservation(ctx sdk.Context, poolID string, pool *types.DenominatedPool) {
+	cumulativeA, cumulativeB := sdk.ZeroDec(), sdk.ZeroDec()
+	if previous, found := k.GetPriceObservationAt(ctx, poolID, ctx.BlockTime()); found {
+		cumulativeA, cumulativeB = previous.CumulativePricesAt(ctx.BlockTime())
+	}
+
+	reserves := pool.Reserves()
+	k.SetPriceObservation(ctx, types.NewPriceObservation(
+		poolID,
+		ctx.BlockTime(),
+		cumulativeA,
+		cumulativeB,
+		pool.SpotPrice(reserves[0].Denom),
+		pool.SpotPrice(reserves[1].Denom),
+	))
+
+	k.prunePriceObservations(ctx, poolID)
+}
+
+// prunePriceObservations deletes the observations of a pool older than the retention period, keeping
+// the most recent observation before the retention period
+func (k Keeper) prunePriceObservations(ctx sdk.Context, poolID string) {
+	cutoff := ctx.BlockTime().Add(-types.PriceObservationRetention)
+
+	var expired []types.PriceObservation
+	k.IteratePriceObservationsByPool(ctx, poolID, func(observation types.PriceObservation) bool {
+		if observation.Timestamp.After(cutoff) {
+			return true
+		}
+		expired = append(expired, observation)
+		return false
+	})
+
+	for i := 0; i < len(expired)-1; i++ {
+		k.DeletePriceObservation(ctx, poolID, expired[i].Timestamp)
+	}
+}
+
+// deletePriceObservations deletes all observations of a pool
+func (k Keeper) deletePriceObservations(ctx sdk.Context, poolID string) {
+	var observations []types.PriceObservation
+	k.IteratePriceObservationsByPool(ctx, poolID, func(observation types.PriceObservation) bool {
+		observations = append(observations, observation)
+		return false
+	})
+
+	for _, observation := range observations {
+		k.DeletePriceObservation(ctx, poolID, observation.Timestamp)
+	}
+}
@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *keeperTestSuite) TestPriceObservation_RecordedOnPoolUpdates() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	err := suite.CreatePool(reserves)
+	suite.Require().NoError(err)
+	poolID := types.PoolIDFromCoins(reserves)
+	start := suite.Ctx.BlockTime()
+
+	observations := suite.Keeper.GetAllPriceObservations(suite.Ctx)
+	suite.Require().Len(observations, 1)
+	suite.Equal(types.NewPriceObservation(poolID, start, sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewDec(5), sdk.MustNewDecFromStr("0.2")), observations[0])
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(60 * time.Second))
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(4e6)), sdk.MustNewDecFromStr("0.5"))
+	suite.Require().NoError(err)
+
+	pool, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	priceA := sdk.NewDecFromInt(pool.ReservesB.Amount).Quo(sdk.NewDecFromInt(pool.ReservesA.Amount))
+	priceB := sdk.NewDecFromInt(pool.ReservesA.Amount).Quo(sdk.NewDecFromInt(pool.ReservesB.Amount))
+
+	observations = suite.Keeper.GetAllPriceObservations(suite.Ctx)
+	suite.Require().Len(observations, 2)
+	suite.Equal(types.NewPriceObservation(poolID, start.Add(60*time.Second), sdk.NewDec(300), sdk.NewDec(12), priceA, priceB), observations[1])
+
+	// a second update in the same block replaces the observation of the block
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("usdx", sdkmath.NewInt(1e6)), sdk.NewCoin("ukava", sdkmath.NewInt(1e5)), sdk.MustNewDecFromStr("0.5"))
+	suite.Require().NoError(err)
+
+	observations = suite.Keeper.GetAllPriceObservations(suite.Ctx)
+	suite.Require().Len(observations, 2)
+	suite.Equal(sdk.NewDec(300), observations[1].CumulativePriceA)
+	suite.Equal(sdk.NewDec(12), observations[1].CumulativePriceB)
+	suite.NotEqual(priceA, observations[1].PriceA)
+}
+
+func (suite *keeperTestSuite) TestPriceObservation_DeletedWithPool() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+	suite.Require().Len(suite.Keeper.GetAllPriceObservations(suite.Ctx), 1)
+
+	poolID := types.PoolIDFromCoins(reserves)
+	shareRecords := suite.Keeper.GetAllDepositorShares(suite.Ctx)
+	suite.Require().Len(shareRecords, 1)
+	shares := shareRecords[0]
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	err := suite.Keeper.Withdraw(suite.Ctx, shares.Depositor, shares.SharesOwned, sdk.NewCoin("ukava", sdkmath.NewInt(1)), sdk.NewCoin("usdx", sdkmath.NewInt(1)))
+	suite.Require().NoError(err)
+
+	suite.PoolDeleted("ukava", "usdx")
+	suite.Empty(suite.Keeper.GetAllPriceObservations(suite.Ctx))
+
+	_, _, err = suite.Keeper.GetTWAP(suite.Ctx, poolID, suite.Ctx.BlockTime().Add(-time.Minute), suite.Ctx.BlockTime())
+	suite.ErrorIs(err, types.ErrPriceHistoryNotFound)
+}
+
+func (suite *keeperTestSuite) TestPriceObservation_Pruning() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+	start := suite.Ctx.BlockTime()
+
+	depositor := suite.CreateAccount(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	))
+	deposit := func(at time.Time) {
+		suite.Ctx = suite.Ctx.WithBlockTime(at)
+		err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6)), sdk.MustNewDecFromStr("0.01"))
+		suite.Require().NoError(err)
+	}
+
+	deposit(start.Add(24 * time.Hour))
+	deposit(start.Add(8*24*time.Hour - time.Minute))
+	suite.Len(suite.Keeper.GetAllPriceObservations(suite.Ctx), 3, "expected observation before retention period to be kept")
+
+	deposit(start.Add(9 * 24 * time.Hour))
+	observations := suite.Keeper.GetAllPriceObservations(suite.Ctx)
+	suite.Require().Len(observations, 3)
+	suite.Equal(start.Add(24*time.Hour), observations[0].Timestamp)
+
+	// the window starting at the retention cutoff is still available
+	_, _, err := suite.Keeper.GetTWAP(suite.Ctx, poolID, suite.Ctx.BlockTime().Add(-types.PriceObservationRetention), suite.Ctx.BlockTime())
+	suite.NoError(err)
+}
+
+func (suite *keeperTestSuite) TestGetTWAP() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+	start := suite.Ctx.BlockTime()
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(60 * time.Second))
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(4e6)), sdk.MustNewDecFromStr("0.5"))
+	suite.Require().NoError(err)
+
+	pool, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	priceA := sdk.NewDecFromInt(pool.ReservesB.Amount).Quo(sdk.NewDecFromInt(pool.ReservesA.Amount))
+	priceB := sdk.NewDecFromInt(pool.ReservesA.Amount).Quo(sdk.NewDecFromInt(pool.ReservesB.Amount))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(120 * time.Second))
+
+	testCases := []struct {
+		name   string
+		start  time.Time
+		end    time.Time
+		priceA sdk.Dec
+		priceB sdk.Dec
+	}{
+		{
+			name:   "window before swap",
+			start:  start,
+			end:    start.Add(60 * time.Second),
+			priceA: sdk.NewDec(5),
+			priceB: sdk.MustNewDecFromStr("0.2"),
+		},
+		{
+			name:   "window after swap",
+			start:  start.Add(60 * time.Second),
+			end:    start.Add(120 * time.Second),
+			priceA: priceA,
+			priceB: priceB,
+		},
+		{
+			name:   "full window",
+			start:  start,
+			end:    start.Add(120 * time.Second),
+			priceA: priceA.MulInt64(60).Add(sdk.NewDec(300)).QuoInt64(120),
+			priceB: priceB.MulInt64(60).Add(sdk.NewDec(12)).QuoInt64(120),
+		},
+		{
+			name:   "partial window",
+			start:  start.Add(30 * time.Second),
+			end:    start.Add(120 * time.Second),
+			priceA: priceA.MulInt64(60).Add(sdk.NewDec(150)).QuoInt64(90),
+			priceB: priceB.MulInt64(60).Add(sdk.NewDec(6)).QuoInt64(90),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			twapA, twapB, err := suite.Keeper.GetTWAP(suite.Ctx, poolID, tc.start, tc.end)
+			suite.Require().NoError(err)
+			suite.Equal(tc.priceA, twapA)
+			suite.Equal(tc.priceB, twapB)
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestGetTWAP_Errors() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+	start := suite.Ctx.BlockTime()
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(time.Hour))
+
+	_, _, err := suite.Keeper.GetTWAP(suite.Ctx, poolID, start.Add(time.Minute), start.Add(time.Minute))
+	suite.ErrorIs(err, types.ErrInvalidWindow)
+
+	_, _, err = suite.Keeper.GetTWAP(suite.Ctx, poolID, start, start.Add(2*time.Hour))
+	suite.ErrorIs(err, types.ErrInvalidWindow)
+
+	_, _, err = suite.Keeper.GetTWAP(suite.Ctx, poolID, start.Add(-time.Second), start.Add(time.Minute))
+	suite.ErrorIs(err, types.ErrPriceHistoryNotFound)
+
+	_, _, err = suite.Keeper.GetTWAP(suite.Ctx, "hard:usdx", start, start.Add(time.Minute))
+	suite.ErrorIs(err, types.ErrPriceHistoryNotFound)
+}
+
+func (suite *keeperTestSuite) TestPricefeedAdapter_GetPoolTWAP() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+
+	adapter := keeper.NewPricefeedAdapter(suite.Keeper)
+
+	price, err := adapter.GetPoolTWAP(suite.Ctx, poolID, "ukava", 30*time.Minute)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(5), price)
+
+	price, err = adapter.GetPoolTWAP(suite.Ctx, poolID, "usdx", 30*time.Minute)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("0.2"), price)
+
+	_, err = adapter.GetPoolTWAP(suite.Ctx, poolID, "hard", 30*time.Minute)
+	suite.ErrorIs(err, types.ErrInvalidCoin)
+
+	_, err = adapter.GetPoolTWAP(suite.Ctx, poolID, "ukava", 2*time.Hour)
+	suite.ErrorIs(err, types.ErrPriceHistoryNotFound)
+
+	_, err = adapter.GetPoolTWAP(suite.Ctx, "hard:usdx", "hard", 30*time.Minute)
+	suite.ErrorIs(err, types.ErrInvalidPool)
+}
@@ -13,7 +13,9 @@ import (
 // Withdraw removes liquidity from an existing pool from an owners deposit, converting the provided shares for
 // the returned pool liquidity.
 //
-// If 100% of the owners shares are removed, then the deposit is deleted.  In addition, if all the pool shares
+// The shares withdrawn are burned from the owner's share token balance, and the cost basis of the owner's position
+// is reduced in proportion to the shares withdrawn. If 100% of the owners shares are removed, then the deposit
+// is deleted.  In addition, if all the pool shares
 // are removed then the pool is deleted.
 //
 // The number of shares must be large enough to result in at least 1 unit of the smallest reserve in the pool.
@@ -25,13 +27,13 @@ import (
 func (k Keeper) Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.Int, minCoinA, minCoinB sdk.Coin) error {
 	poolID := types.PoolID(minCoinA.Denom, minCoinB.Denom)
 
-	shareRecord, found := k.GetDepositorShares(ctx, owner, poolID)
-	if !found {
+	sharesOwned := k.GetShareBalance(ctx, owner, poolID)
+	if sharesOwned.IsZero() {
 		return errorsmod.Wrapf(types.ErrDepositNotFound, "no deposit for account %s and pool %s", owner, poolID)
 	}
 
-	if shares.GT(shareRecord.SharesOwned) {
-		return errorsmod.Wrapf(types.ErrInvalidShares, "withdraw of %s shares greater than %s shares owned", shares, shareRecord.SharesOwned)
+	if shares.GT(sharesOwned) {
+		return errorsmod.Wrapf(types.ErrInvalidShares, "withdraw of %s shares greater than %s shares owned", shares, sharesOwned)
 	}
 
 	poolRecord, found := k.GetPool(ctx, poolID)
@@ -39,11 +41,13 @@ func (k Keeper) Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.I
 		panic(fmt.Sprintf("pool %s not found", poolID))
 	}
 
-	pool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
 	}
 
+	position := k.GetCurrentDepositPosition(ctx, owner, poolID, pool)
+
 	withdrawnAmount := pool.RemoveLiquidity(shares)
 	if withdrawnAmount.AmountOf(minCoinA.Denom).IsZero() || withdrawnAmount.AmountOf(minCoinB.Denom).IsZero() {
 		return errorsmod.Wrap(types.ErrInsufficientLiquidity, "shares must be increased")
@@ -53,8 +57,12 @@ func (k Keeper) Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.I
 	}
 
 	k.updatePool(ctx, poolID, pool)
-	k.BeforePoolDepositModified(ctx, poolID, owner, shareRecord.SharesOwned)
-	k.updateDepositorShares(ctx, owner, poolID, shareRecord.SharesOwned.Sub(shares))
+	k.beforeSharesModified(ctx, owner, poolID)
+	if err := k.burnShares(ctx, owner, poolID, shares); err != nil {
+		return err
+	}
+	k.afterSharesModified(ctx, owner, poolID)
+	k.updateDepositPosition(ctx, position.RemoveShares(shares))
 
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, withdrawnAmount)
 	if err != nil {
@@ -56,7 +56,7 @@ func (suite *keeperTestSuite) TestWithdraw_PartialShares() {
 	suite.PoolShareTotalEqual(poolID, sharesLeft)
 	suite.PoolDepositorSharesEqual(owner.GetAddress(), poolID, sharesLeft)
 	suite.PoolReservesEqual(poolID, reservesLeft)
-	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins(minCoinA, minCoinB))
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins(minCoinA, minCoinB, sdk.NewCoin(types.ShareDenom(poolID), sharesLeft)))
 	suite.ModuleAccountBalanceEqual(reservesLeft)
 
 	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// ZapDeposit adds liquidity to an existing pool from a single asset.  A portion of coinA is swapped against the
+// pool for the other asset, and the remaining coinA is deposited along with the swap output.
+//
+// The swap amount is the largest amount for which the remaining coinA covers the swap output at the ratio of the
+// pool reserves after the swap, leaving the smallest possible remainder of either asset with the depositor.
+//
+// Slippage is measured against the shares that coinA would have been worth at the spot price of the pool before
+// the zap, and accounts for swap fees, price impact, and any remainder not deposited.  An error is returned when
+// the slippage is greater than the slippageLimit.
+func (k Keeper) ZapDeposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coin, denomB string, slippageLimit sdk.Dec) (sdkmath.Int, error) {
+	poolID, pool, err := k.loadPool(ctx, coinA.Denom, denomB)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if coinA.Amount.LT(sdkmath.NewInt(2)) {
+		return sdk.ZeroInt(), errorsmod.Wrap(types.ErrInsufficientLiquidity, "deposit must be increased")
+	}
+
+	reserves := pool.Reserves()
+	poolValue := sdk.NewDecFromInt(reserves.AmountOf(coinA.Denom)).Add(
+		sdk.NewDecFromInt(reserves.AmountOf(denomB)).Mul(pool.SpotPrice(denomB)),
+	)
+	idealShares := sdk.NewDecFromInt(pool.TotalShares()).Mul(sdk.NewDecFromInt(coinA.Amount)).Quo(poolValue)
+
+	swapAmount, err := k.calculateZapSwapAmount(ctx, poolID, coinA, denomB)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	hop := k.swapExactInput(cacheCtx, poolID, pool, sdk.NewCoin(coinA.Denom, swapAmount))
+	if hop.output.IsZero() {
+		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
+	}
+	if err := k.commitSwap(cacheCtx, depositor, []swapHop{hop}, "input"); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	desiredAmount := sdk.NewCoins(coinA.SubAmount(swapAmount), hop.output)
+	depositAmount, shares := hop.pool.AddLiquidity(desiredAmount)
+
+	if depositAmount.AmountOf(coinA.Denom).IsZero() || depositAmount.AmountOf(denomB).IsZero() || shares.IsZero() {
+		return sdk.ZeroInt(), errorsmod.Wrap(types.ErrInsufficientLiquidity, "deposit must be increased")
+	}
+
+	slippage := sdk.OneDec().Sub(sdk.NewDecFromInt(shares).Quo(idealShares))
+	if slippage.GT(slippageLimit) {
+		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrSlippageExceeded, "slippage %s > limit %s", slippage, slippageLimit)
+	}
+
+	// the cost basis is the coinA paid for the shares, rather than the deposit that includes the swap output
+	if err := k.commitDeposit(cacheCtx, depositor, poolID, hop.pool, depositAmount, shares, sdk.NewCoins(coinA)); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	writeCache()
+
+	return shares, nil
+}
+
+// calculateZapSwapAmount returns the largest amount of coinA that can be swapped against the pool such that the
+// remaining coinA is at least the value of the swap output at the ratio of the pool reserves after the swap.
+func (k Keeper) calculateZapSwapAmount(ctx sdk.Context, poolID string, coinA sdk.Coin, denomB string) (sdkmath.Int, error) {
+	record, found := k.GetPool(ctx, poolID)
+	if !found {
+		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	// coversOutput simulates a swap of the amount and reports if the remainder is sufficient to deposit
+	// the full swap output
+	coversOutput := func(amount sdkmath.Int) bool {
+		pool, err := types.NewDenominatedPoolFromRecord(record)
+		if err != nil {
+			panic(err)
+		}
+		k.setPoolSwapFee(ctx, poolID, pool)
+
+		hop := k.swapExactInput(ctx, poolID, pool, sdk.NewCoin(coinA.Denom, amount))
+		reserves := pool.Reserves()
+
+		remaining := coinA.Amount.Sub(amount)
+		return remaining.Mul(reserves.AmountOf(denomB)).GTE(hop.output.Amount.Mul(reserves.AmountOf(coinA.Denom)))
+	}
+
+	low, high := sdk.OneInt(), coinA.Amount.SubRaw(1)
+	if !coversOutput(low) {
+		return low, nil
+	}
+
+	for low.LT(high) {
+		mid := low.Add(high).AddRaw(1).QuoRaw(2)
+		if coversOutput(mid) {
+			low = mid
+		} else {
+			high = mid.SubRaw(1)
+		}
+	}
+
+	return low, nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+func (suite *keeperTestSuite) TestZapDeposit_PoolNotFound() {
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	depositor := suite.CreateAccount(balance)
+
+	shares, err := suite.Keeper.ZapDeposit(suite.Ctx, depositor.GetAddress(), balance[0], "usdx", sdk.MustNewDecFromStr("0.01"))
+	suite.Require().EqualError(err, "pool ukava:usdx not found: invalid pool")
+	suite.True(shares.IsZero())
+	suite.AccountBalanceEqual(depositor.GetAddress(), balance)
+}
+
+func (suite *keeperTestSuite) TestZapDeposit_PoolExists() {
+	pool := types.NewAllowedPool("ukava", "usdx")
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	ctx := suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	shares, err := suite.Keeper.ZapDeposit(ctx, depositor.GetAddress(), balance[0], "usdx", sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+	suite.Equal(sdkmath.NewInt(11135771), shares)
+
+	swapInput := sdk.NewCoin("ukava", sdkmath.NewInt(4995055))
+	swapOutput := sdk.NewCoin("usdx", sdkmath.NewInt(24776954))
+	expectedDeposit := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(5004944)),
+		swapOutput,
+	)
+
+	// only a single unit of the input remains after the deposit
+	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.OneInt()),
+		sdk.NewCoin(types.ShareDenom(pool.Name()), shares),
+	))
+	suite.ModuleAccountBalanceEqual(reserves.Add(balance...).Sub(sdk.NewCoin("ukava", sdk.OneInt())))
+	suite.PoolLiquidityEqual(reserves.Add(balance...).Sub(sdk.NewCoin("ukava", sdk.OneInt())))
+	suite.PoolDepositorSharesEqual(depositor.GetAddress(), pool.Name(), shares)
+
+	suite.EventsContains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyRequester, depositor.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, swapInput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "14986ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+
+	suite.EventsContains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapDeposit,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedDeposit.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestZapDeposit_TokenB() {
+	pool := types.NewAllowedPool("ukava", "usdx")
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	shares, err := suite.Keeper.ZapDeposit(suite.Ctx, depositor.GetAddress(), balance[0], "ukava", sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+	suite.PoolDepositorSharesEqual(depositor.GetAddress(), pool.Name(), shares)
+
+	// the remainder of the deposit is less than the value of a single share
+	shareCoin := sdk.NewCoin(types.ShareDenom(pool.Name()), shares)
+	remaining := suite.BankKeeper.GetAllBalances(suite.Ctx, depositor.GetAddress()).Sub(shareCoin)
+	suite.True(remaining.AmountOf("ukava").IsZero())
+	suite.True(remaining.AmountOf("usdx").LTE(sdkmath.NewInt(5)), "remaining %s", remaining)
+	suite.PoolLiquidityEqual(reserves.Add(balance...).Sub(remaining...))
+}
+
+func (suite *keeperTestSuite) TestZapDeposit_Slippage() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100e6)))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	shares, err := suite.Keeper.ZapDeposit(suite.Ctx, depositor.GetAddress(), balance[0], "usdx", sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrSlippageExceeded)
+	suite.True(shares.IsZero())
+
+	suite.AccountBalanceEqual(depositor.GetAddress(), balance)
+	suite.ModuleAccountBalanceEqual(reserves)
+	suite.PoolLiquidityEqual(reserves)
+	suite.PoolSharesDeleted(depositor.GetAddress(), "ukava", "usdx")
+}
+
+func (suite *keeperTestSuite) TestZapDeposit_InsufficientLiquidity() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+
+	testCases := []sdk.Coin{
+		sdk.NewCoin("ukava", sdkmath.NewInt(1)),
+		sdk.NewCoin("ukava", sdkmath.NewInt(2)),
+	}
+
+	for _, deposit := range testCases {
+		suite.Run(deposit.String(), func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.CreatePool(reserves))
+
+			depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), sdk.NewCoins(deposit))
+
+			_, err := suite.Keeper.ZapDeposit(suite.Ctx, depositor.GetAddress(), deposit, "usdx", sdk.OneDec())
+			suite.Require().ErrorIs(err, types.ErrInsufficientLiquidity)
+			suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(deposit))
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestZapDeposit_InsufficientFunds() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	_, err := suite.Keeper.ZapDeposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), "usdx", sdk.MustNewDecFromStr("0.01"))
+	suite.Require().Error(err)
+	suite.AccountBalanceEqual(depositor.GetAddress(), balance)
+	suite.PoolLiquidityEqual(reserves)
+}
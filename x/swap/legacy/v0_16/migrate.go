@@ -1,6 +1,8 @@
 package v0_16
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	v015swap "github.com/kava-labs/kava/x/swap/legacy/v0_15"
 	v016swap "github.com/kava-labs/kava/x/swap/types"
 )
@@ -9,13 +11,18 @@ func migrateParams(params v015swap.Params) v016swap.Params {
 	allowedPools := make(v016swap.AllowedPools, len(params.AllowedPools))
 	for i, pool := range params.AllowedPools {
 		allowedPools[i] = v016swap.AllowedPool{
-			TokenA: pool.TokenA,
-			TokenB: pool.TokenB,
+			TokenA:  pool.TokenA,
+			TokenB:  pool.TokenB,
+			SwapFee: sdk.ZeroDec(),
 		}
 	}
 	return v016swap.Params{
-		AllowedPools: allowedPools,
-		SwapFee:      params.SwapFee,
+		AllowedPools:               allowedPools,
+		SwapFee:                    params.SwapFee,
+		ProtocolFee:                v016swap.DefaultProtocolFee,
+		PermissionlessPoolCreation: v016swap.DefaultPermissionlessPoolCreation,
+		PoolCreationFee:            v016swap.DefaultPoolCreationFee,
+		MinInitialShares:           v016swap.DefaultMinInitialShares,
 	}
 }
 
@@ -27,6 +34,7 @@ func migratePoolRecords(oldRecords v015swap.PoolRecords) v016swap.PoolRecords {
 			ReservesA:   oldRecord.ReservesA,
 			ReservesB:   oldRecord.ReservesB,
 			TotalShares: oldRecord.TotalShares,
+			SwapFee:     sdk.ZeroDec(),
 		}
 	}
 	return newRecords
@@ -50,5 +58,7 @@ func Migrate(oldState v015swap.GenesisState) *v016swap.GenesisState {
 		Params:       migrateParams(oldState.Params),
 		PoolRecords:  migratePoolRecords(oldState.PoolRecords),
 		ShareRecords: migrateShareRecords(oldState.ShareRecords),
+		// limit orders are not present in v0.15, so the first order placed uses the default id
+		NextLimitOrderID: v016swap.DefaultNextLimitOrderID,
 	}
 }
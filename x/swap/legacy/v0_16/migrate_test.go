@@ -69,10 +69,13 @@ func (s *migrateTestSuite) TestMigrate_Params() {
 		},
 	}
 	expectedParams := v016swap.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.33"),
+		SwapFee:          sdk.MustNewDecFromStr("0.33"),
+		ProtocolFee:      sdk.ZeroDec(),
+		PoolCreationFee:  sdk.Coins{},
+		MinInitialShares: sdk.ZeroInt(),
 		AllowedPools: v016swap.AllowedPools{
-			{TokenA: "A", TokenB: "B"},
-			{TokenA: "C", TokenB: "D"},
+			{TokenA: "A", TokenB: "B", SwapFee: sdk.ZeroDec()},
+			{TokenA: "C", TokenB: "D", SwapFee: sdk.ZeroDec()},
 		},
 	}
 	s.v15genstate.Params = params
@@ -101,12 +104,14 @@ func (s *migrateTestSuite) TestMigrate_PoolRecords() {
 			ReservesA:   sdk.NewCoin("usdx", sdkmath.NewInt(100)),
 			ReservesB:   sdk.NewCoin("xrpb", sdkmath.NewInt(200)),
 			TotalShares: sdkmath.NewInt(300),
+			SwapFee:     sdk.ZeroDec(),
 		},
 		{
 			PoolID:      "pool-2",
 			ReservesA:   sdk.NewCoin("usdx", sdkmath.NewInt(500)),
 			ReservesB:   sdk.NewCoin("ukava", sdkmath.NewInt(500)),
 			TotalShares: sdkmath.NewInt(1000),
+			SwapFee:     sdk.ZeroDec(),
 		},
 	}
 	genState := Migrate(s.v15genstate)
@@ -0,0 +1,26 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 3
+// V3 adds the protocol_fee, permissionless_pool_creation, pool_creation_fee and min_initial_shares params to parameters.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore ensures the param key table exists and has the protocol_fee and pool creation properties
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyProtocolFee, types.DefaultProtocolFee)
+	paramstore.Set(ctx, types.KeyPermissionlessPoolCreation, types.DefaultPermissionlessPoolCreation)
+	paramstore.Set(ctx, types.KeyPoolCreationFee, types.DefaultPoolCreationFee)
+	paramstore.Set(ctx, types.KeyMinInitialShares, types.DefaultMinInitialShares)
+}
@@ -0,0 +1,72 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v3swap "github.com/kava-labs/kava/x/swap/migrations/v3"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+var newParamKeys = [][]byte{
+	types.KeyProtocolFee,
+	types.KeyPermissionlessPoolCreation,
+	types.KeyPoolCreationFee,
+	types.KeyMinInitialShares,
+}
+
+func TestStoreMigrationAddsKeyTableIncludingNewParam(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	swapKey := sdk.NewKVStoreKey(types.ModuleName)
+	tSwapKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(swapKey, tSwapKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, swapKey, tSwapKey, types.ModuleName)
+
+	// Check params don't exist before
+	for _, key := range newParamKeys {
+		require.False(t, paramstore.Has(ctx, key))
+	}
+
+	// Run migrations.
+	err := v3swap.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	for _, key := range newParamKeys {
+		require.True(t, paramstore.Has(ctx, key))
+	}
+}
+
+func TestStoreMigrationSetsNewParamOnExistingKeyTable(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	swapKey := sdk.NewKVStoreKey(types.ModuleName)
+	tSwapKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(swapKey, tSwapKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, swapKey, tSwapKey, types.ModuleName)
+	paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// expect it to have key table
+	require.True(t, paramstore.HasKeyTable())
+	// expect it to not have new params
+	for _, key := range newParamKeys {
+		require.False(t, paramstore.Has(ctx, key))
+	}
+
+	// Run migrations.
+	err := v3swap.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set to their defaults.
+	var params types.Params
+	paramstore.GetParamSetIfExists(ctx, &params)
+	require.Equal(t, types.DefaultProtocolFee, params.ProtocolFee)
+	require.Equal(t, types.DefaultPermissionlessPoolCreation, params.PermissionlessPoolCreation)
+	require.True(t, types.DefaultPoolCreationFee.IsEqual(params.PoolCreationFee))
+	require.Equal(t, types.DefaultMinInitialShares, params.MinInitialShares)
+}
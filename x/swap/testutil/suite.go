@@ -15,7 +15,6 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	BankKeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -60,10 +59,7 @@ func (suite *Suite) AddCoinsToModule(amount sdk.Coins) {
 
 // RemoveCoinsFromModule removes coins to the swap module account
 func (suite *Suite) RemoveCoinsFromModule(amount sdk.Coins) {
-	// Swap module does not have BurnCoins permission so we need to transfer to gov first to burn
-	err := suite.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleAccountName, govtypes.ModuleName, amount)
-	suite.Require().NoError(err)
-	err = suite.BankKeeper.BurnCoins(suite.Ctx, govtypes.ModuleName, amount)
+	err := suite.BankKeeper.BurnCoins(suite.Ctx, types.ModuleAccountName, amount)
 	suite.Require().NoError(err)
 }
 
@@ -177,7 +173,7 @@ func (suite *Suite) PoolShareValueEqual(depositor authtypes.AccountI, pool types
 	shares, ok := suite.Keeper.GetDepositorShares(suite.Ctx, depositor.GetAddress(), poolRecord.PoolID)
 	suite.Require().True(ok, fmt.Sprintf("expected shares to exist for depositor %s", depositor.GetAddress()))
 
-	storedPool, err := types.NewDenominatedPoolWithExistingShares(sdk.NewCoins(poolRecord.ReservesA, poolRecord.ReservesB), poolRecord.TotalShares)
+	storedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	suite.Nil(err)
 	value := storedPool.ShareValue(shares.SharesOwned)
 	suite.Equal(coins, value, fmt.Sprintf("expected shares to equal %s, but got %s", coins, value))
@@ -317,6 +317,30 @@ func (p *BasePool) calculateInputForExactOutput(out, outReserves, inReserves sdk
 	return in, feeValue
 }
 
+// WithdrawFees removes fees previously paid to the pool from the reserves.  Since fees are not
+// included in the invariant of a swap, withdrawing up to the fees paid by a swap never reduces
+// the invariant below its value before the swap.  Panics if the reserves become non-positive.
+func (p *BasePool) WithdrawFees(feeA, feeB sdkmath.Int) {
+	if feeA.IsNegative() || feeB.IsNegative() {
+		panic("fees must not be negative")
+	}
+
+	p.reservesA = p.reservesA.Sub(feeA)
+	p.reservesB = p.reservesB.Sub(feeB)
+
+	p.assertReservesArePositive()
+}
+
+// SpotPriceA returns the spot price of A in units of B
+func (p *BasePool) SpotPriceA() sdk.Dec {
+	return sdk.NewDecFromInt(p.reservesB).Quo(sdk.NewDecFromInt(p.reservesA))
+}
+
+// SpotPriceB returns the spot price of B in units of A
+func (p *BasePool) SpotPriceB() sdk.Dec {
+	return sdk.NewDecFromInt(p.reservesA).Quo(sdk.NewDecFromInt(p.reservesB))
+}
+
 // ShareValue returns the value of the provided shares and panics
 // if the shares are greater than the total shares of the pool or
 // if the shares are not positive.
@@ -589,3 +589,26 @@ func TestBasePool_Panics_Swap_ExactOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestBasePool_SpotPrice(t *testing.T) {
+	pool, err := types.NewBasePool(i(1e6), i(4e6))
+	require.NoError(t, err)
+
+	assert.Equal(t, d("4"), pool.SpotPriceA())
+	assert.Equal(t, d("0.25"), pool.SpotPriceB())
+}
+
+func TestBasePool_WithdrawFees(t *testing.T) {
+	pool, err := types.NewBasePool(i(1e6), i(1e6))
+	require.NoError(t, err)
+
+	_, feeA := pool.SwapExactAForB(i(1e4), d("0.003"))
+	assert.Equal(t, i(30), feeA)
+
+	pool.WithdrawFees(i(10), i(0))
+	assert.Equal(t, i(1e6+1e4-10), pool.ReservesA())
+	assert.Equal(t, i(1e6).String(), pool.TotalShares().String(), "expected total shares to not change")
+
+	assert.Panics(t, func() { pool.WithdrawFees(i(-1), i(0)) }, "expected negative fees to panic")
+	assert.Panics(t, func() { pool.WithdrawFees(i(0), pool.ReservesB()) }, "expected depleting reserves to panic")
+}
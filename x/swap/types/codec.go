@@ -16,6 +16,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdraw{}, "swap/MsgWithdraw", nil)
 	cdc.RegisterConcrete(&MsgSwapExactForTokens{}, "swap/MsgSwapExactForTokens", nil)
 	cdc.RegisterConcrete(&MsgSwapForExactTokens{}, "swap/MsgSwapForExactTokens", nil)
+	cdc.RegisterConcrete(&MsgSwapExactForTokensMultiHop{}, "swap/MsgSwapExactForTokensMultiHop", nil)
+	cdc.RegisterConcrete(&MsgSwapForExactTokensMultiHop{}, "swap/MsgSwapForExactTokensMultiHop", nil)
+	cdc.RegisterConcrete(&MsgZapDeposit{}, "swap/MsgZapDeposit", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "swap/MsgPlaceLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "swap/MsgCancelLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgSyncShares{}, "swap/MsgSyncShares", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -26,6 +32,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdraw{},
 		&MsgSwapExactForTokens{},
 		&MsgSwapForExactTokens{},
+		&MsgSwapExactForTokensMultiHop{},
+		&MsgSwapForExactTokensMultiHop{},
+		&MsgZapDeposit{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgSyncShares{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
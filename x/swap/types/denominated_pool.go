@@ -8,13 +8,44 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// DenominatedPool implements a denominated constant-product liquidity pool
+// unitlessPool is implemented by the unitless liquidity pools that back a denominated pool
+type unitlessPool interface {
+	ReservesA() sdkmath.Int
+	ReservesB() sdkmath.Int
+	TotalShares() sdkmath.Int
+	IsEmpty() bool
+	AddLiquidity(desiredA sdkmath.Int, desiredB sdkmath.Int) (sdkmath.Int, sdkmath.Int, sdkmath.Int)
+	RemoveLiquidity(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int)
+	ShareValue(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int)
+	SpotPriceA() sdk.Dec
+	SpotPriceB() sdk.Dec
+	SwapExactAForB(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapExactBForA(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapAForExactB(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapBForExactA(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	WithdrawFees(feeA, feeB sdkmath.Int)
+}
+
+var (
+	_ unitlessPool = (*BasePool)(nil)
+	_ unitlessPool = (*StableSwapPool)(nil)
+	_ unitlessPool = (*WeightedPool)(nil)
+)
+
+// DenominatedPool implements a denominated liquidity pool
 type DenominatedPool struct {
-	// all pool operations are implemented in a unitless base pool
-	pool *BasePool
+	// all pool operations are implemented in a unitless pool chosen by pool type
+	pool unitlessPool
 	// track units of the reserveA and reserveB in base pool
 	denomA string
 	denomB string
+	// track the pool type and its parameters so they can be stored in the pool record
+	poolType      PoolType
+	amplification uint64
+	weightA       uint64
+	weightB       uint64
+	// track the fee tier of the pool, where zero uses the global swap fee
+	swapFee sdk.Dec
 }
 
 // NewDenominatedPool creates a new denominated pool from reserve coins
@@ -34,9 +65,10 @@ func NewDenominatedPool(reserves sdk.Coins) (*DenominatedPool, error) {
 	}
 
 	return &DenominatedPool{
-		pool:   pool,
-		denomA: reservesA.Denom,
-		denomB: reservesB.Denom,
+		pool:    pool,
+		denomA:  reservesA.Denom,
+		denomB:  reservesB.Denom,
+		swapFee: sdk.ZeroDec(),
 	}, nil
 }
 
@@ -57,12 +89,118 @@ func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmat
 	}
 
 	return &DenominatedPool{
-		pool:   pool,
-		denomA: reservesA.Denom,
-		denomB: reservesB.Denom,
+		pool:    pool,
+		denomA:  reservesA.Denom,
+		denomB:  reservesB.Denom,
+		swapFee: sdk.ZeroDec(),
+	}, nil
+}
+
+// NewDenominatedPoolFromAllowedPool creates a new denominated pool from reserve coins,
+// using the pool type and parameters of the allowed pool
+func NewDenominatedPoolFromAllowedPool(allowedPool AllowedPool, reserves sdk.Coins) (*DenominatedPool, error) {
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	var (
+		pool unitlessPool
+		err  error
+	)
+	switch allowedPool.PoolType {
+	case POOL_TYPE_CONSTANT_PRODUCT:
+		pool, err = NewBasePool(reserves[0].Amount, reserves[1].Amount)
+	case POOL_TYPE_STABLESWAP:
+		pool, err = NewStableSwapPool(reserves[0].Amount, reserves[1].Amount, allowedPool.Amplification)
+	case POOL_TYPE_WEIGHTED:
+		pool, err = NewWeightedPool(reserves[0].Amount, reserves[1].Amount, allowedPool.WeightA, allowedPool.WeightB)
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "invalid pool type %s", allowedPool.PoolType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:          pool,
+		denomA:        reserves[0].Denom,
+		denomB:        reserves[1].Denom,
+		poolType:      allowedPool.PoolType,
+		amplification: allowedPool.Amplification,
+		weightA:       allowedPool.WeightA,
+		weightB:       allowedPool.WeightB,
+		swapFee:       nonNilDec(allowedPool.SwapFee),
+	}, nil
+}
+
+// NewDenominatedPoolFromRecord creates a denominated pool from a stored pool record,
+// using the pool type and parameters of the record
+func NewDenominatedPoolFromRecord(record PoolRecord) (*DenominatedPool, error) {
+	reserves := record.Reserves()
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	var (
+		pool unitlessPool
+		err  error
+	)
+	switch record.PoolType {
+	case POOL_TYPE_CONSTANT_PRODUCT:
+		pool, err = NewBasePoolWithExistingShares(reserves[0].Amount, reserves[1].Amount, record.TotalShares)
+	case POOL_TYPE_STABLESWAP:
+		pool, err = NewStableSwapPoolWithExistingShares(reserves[0].Amount, reserves[1].Amount, record.TotalShares, record.Amplification)
+	case POOL_TYPE_WEIGHTED:
+		pool, err = NewWeightedPoolWithExistingShares(reserves[0].Amount, reserves[1].Amount, record.TotalShares, record.WeightA, record.WeightB)
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "invalid pool type %s", record.PoolType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:          pool,
+		denomA:        reserves[0].Denom,
+		denomB:        reserves[1].Denom,
+		poolType:      record.PoolType,
+		amplification: record.Amplification,
+		weightA:       record.WeightA,
+		weightB:       record.WeightB,
+		swapFee:       nonNilDec(record.SwapFee),
 	}, nil
 }
 
+// PoolType returns the type of the pool
+func (p *DenominatedPool) PoolType() PoolType {
+	return p.poolType
+}
+
+// Amplification returns the amplification coefficient of a stableswap pool, or zero for other pool types
+func (p *DenominatedPool) Amplification() uint64 {
+	return p.amplification
+}
+
+// WeightA returns the percentage weight of token a in a weighted pool, or zero for other pool types
+func (p *DenominatedPool) WeightA() uint64 {
+	return p.weightA
+}
+
+// WeightB returns the percentage weight of token b in a weighted pool, or zero for other pool types
+func (p *DenominatedPool) WeightB() uint64 {
+	return p.weightB
+}
+
+// SwapFee returns the fee tier of the pool, or zero if the pool uses the global swap fee
+func (p *DenominatedPool) SwapFee() sdk.Dec {
+	return p.swapFee
+}
+
+// SetSwapFee updates the fee tier of the pool, where zero uses the global swap fee
+func (p *DenominatedPool) SetSwapFee(swapFee sdk.Dec) {
+	p.swapFee = nonNilDec(swapFee)
+}
+
 // Reserves returns the reserves held in the pool
 func (p *DenominatedPool) Reserves() sdk.Coins {
 	return p.coins(p.pool.ReservesA(), p.pool.ReservesB())
@@ -102,6 +240,36 @@ func (p *DenominatedPool) ShareValue(shares sdkmath.Int) sdk.Coins {
 	return p.coins(valueA, valueB)
 }
 
+// SpotPrice returns the spot price of the base denom in units of the other pool denom.
+// It panics if the base denom does not match the pool reserves.
+func (p *DenominatedPool) SpotPrice(baseDenom string) sdk.Dec {
+	switch baseDenom {
+	case p.denomA:
+		return p.pool.SpotPriceA()
+	case p.denomB:
+		return p.pool.SpotPriceB()
+	default:
+		panic(fmt.Sprintf("invalid denomination: denom '%s' does not match pool reserves", baseDenom))
+	}
+}
+
+// SpotValue returns the value of coins of the pool denominations in units of the quote denom at the spot
+// price of the pool.  It panics if the quote denom does not match the pool reserves.
+func (p *DenominatedPool) SpotValue(coins sdk.Coins, quoteDenom string) sdk.Coin {
+	var baseDenom string
+	switch quoteDenom {
+	case p.denomA:
+		baseDenom = p.denomB
+	case p.denomB:
+		baseDenom = p.denomA
+	default:
+		panic(fmt.Sprintf("invalid denomination: denom '%s' does not match pool reserves", quoteDenom))
+	}
+
+	baseValue := sdk.NewDecFromInt(coins.AmountOf(baseDenom)).Mul(p.SpotPrice(baseDenom)).TruncateInt()
+	return sdk.NewCoin(quoteDenom, coins.AmountOf(quoteDenom).Add(baseValue))
+}
+
 // SwapWithExactInput trades an exact input coin for the other.  Returns the positive other coin amount
 // that is removed from the pool and the portion of the input coin that is used for the fee.
 // It panics if the input denom does not match the pool reserves.
@@ -144,6 +312,19 @@ func (p *DenominatedPool) SwapWithExactOutput(swapOutput sdk.Coin, fee sdk.Dec)
 	}
 }
 
+// WithdrawFee removes a portion of the fees paid to the pool from the reserves.
+// Panics if the fee denom does not match the pool reserves.
+func (p *DenominatedPool) WithdrawFee(fee sdk.Coin) {
+	switch fee.Denom {
+	case p.denomA:
+		p.pool.WithdrawFees(fee.Amount, sdk.ZeroInt())
+	case p.denomB:
+		p.pool.WithdrawFees(sdk.ZeroInt(), fee.Amount)
+	default:
+		panic(fmt.Sprintf("invalid denomination: denom '%s' does not match pool reserves", fee.Denom))
+	}
+}
+
 // coins returns a new coins slice with correct reserve denoms from ordered sdk.Ints
 func (p *DenominatedPool) coins(amountA, amountB sdkmath.Int) sdk.Coins {
 	return sdk.NewCoins(p.coinA(amountA), p.coinB(amountB))
@@ -158,3 +339,11 @@ func (p *DenominatedPool) coinA(amount sdkmath.Int) sdk.Coin {
 func (p *DenominatedPool) coinB(amount sdkmath.Int) sdk.Coin {
 	return sdk.NewCoin(p.denomB, amount)
 }
+
+// nonNilDec returns zero for a nil decimal, such as a fee tier that was not set
+func nonNilDec(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
+}
@@ -181,3 +181,97 @@ func TestDenominatedPool_SwapWithExactOuput(t *testing.T) {
 
 	assert.Panics(t, func() { pool.SwapWithExactOutput(hard(1e6), d("0.003")) }, "SwapWithExactOutput did not panic on invalid denomination")
 }
+
+func TestDenominatedPool_FromRecord(t *testing.T) {
+	record := types.NewPoolRecord(sdk.NewCoins(ukava(1000e6), usdx(1000e6)), i(1000e6))
+
+	pool, err := types.NewDenominatedPoolFromRecord(record)
+	require.NoError(t, err)
+	assert.Equal(t, types.POOL_TYPE_CONSTANT_PRODUCT, pool.PoolType())
+	output, _ := pool.SwapWithExactInput(ukava(100e6), d("0"))
+	assert.Equal(t, usdx(90909090), output)
+
+	record.PoolType = types.POOL_TYPE_STABLESWAP
+	record.Amplification = 100
+	pool, err = types.NewDenominatedPoolFromRecord(record)
+	require.NoError(t, err)
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, pool.PoolType())
+	assert.Equal(t, uint64(100), pool.Amplification())
+	output, _ = pool.SwapWithExactInput(ukava(100e6), d("0"))
+	assert.Equal(t, usdx(99949776), output)
+	assert.Equal(t, record.PoolType, types.NewPoolRecordFromPool(pool).PoolType)
+	assert.Equal(t, record.Amplification, types.NewPoolRecordFromPool(pool).Amplification)
+
+	record.Amplification = 0
+	_, err = types.NewDenominatedPoolFromRecord(record)
+	assert.EqualError(t, err, "amplification 0 must be between 1 and 1000000: invalid pool")
+
+	record.PoolType = types.POOL_TYPE_WEIGHTED
+	record.WeightA = 80
+	record.WeightB = 20
+	pool, err = types.NewDenominatedPoolFromRecord(record)
+	require.NoError(t, err)
+	assert.Equal(t, types.POOL_TYPE_WEIGHTED, pool.PoolType())
+	assert.Equal(t, uint64(80), pool.WeightA())
+	assert.Equal(t, uint64(20), pool.WeightB())
+	assert.Equal(t, record.WeightA, types.NewPoolRecordFromPool(pool).WeightA)
+	assert.Equal(t, record.WeightB, types.NewPoolRecordFromPool(pool).WeightB)
+
+	record.WeightA = 0
+	_, err = types.NewDenominatedPoolFromRecord(record)
+	assert.EqualError(t, err, "weights 0 and 20 must be at least 2: invalid pool")
+
+	record.PoolType = types.PoolType(5)
+	_, err = types.NewDenominatedPoolFromRecord(record)
+	assert.EqualError(t, err, "invalid pool type 5: invalid pool")
+}
+
+func TestDenominatedPool_FromAllowedPool(t *testing.T) {
+	reserves := sdk.NewCoins(ukava(10e6), usdx(50e6))
+
+	pool, err := types.NewDenominatedPoolFromAllowedPool(types.NewAllowedPool("ukava", "usdx"), reserves)
+	require.NoError(t, err)
+	assert.Equal(t, types.POOL_TYPE_CONSTANT_PRODUCT, pool.PoolType())
+	assert.Equal(t, reserves, pool.Reserves())
+	assert.Equal(t, i(22360679), pool.TotalShares())
+
+	pool, err = types.NewDenominatedPoolFromAllowedPool(types.NewStableSwapAllowedPool("ukava", "usdx", 50), reserves)
+	require.NoError(t, err)
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, pool.PoolType())
+	assert.Equal(t, uint64(50), pool.Amplification())
+	assert.Equal(t, reserves, pool.Reserves())
+	assert.Equal(t, i(22360679), pool.TotalShares())
+
+	pool, err = types.NewDenominatedPoolFromAllowedPool(types.NewWeightedAllowedPool("ukava", "usdx", 20, 80), reserves)
+	require.NoError(t, err)
+	assert.Equal(t, types.POOL_TYPE_WEIGHTED, pool.PoolType())
+	assert.Equal(t, uint64(20), pool.WeightA())
+	assert.Equal(t, uint64(80), pool.WeightB())
+	assert.Equal(t, reserves, pool.Reserves())
+	assert.Equal(t, i(36238983), pool.TotalShares())
+
+	_, err = types.NewDenominatedPoolFromAllowedPool(types.NewStableSwapAllowedPool("ukava", "usdx", 50), sdk.NewCoins(ukava(10e6)))
+	assert.EqualError(t, err, "reserves must have two denominations: invalid pool")
+}
+
+func TestDenominatedPool_SpotPrice(t *testing.T) {
+	pool, err := types.NewDenominatedPool(sdk.NewCoins(ukava(1e6), usdx(5e6)))
+	require.NoError(t, err)
+
+	assert.Equal(t, sdk.NewDec(5), pool.SpotPrice("ukava"))
+	assert.Equal(t, sdk.MustNewDecFromStr("0.2"), pool.SpotPrice("usdx"))
+
+	assert.Panics(t, func() { pool.SpotPrice("hard") }, "expected panic for invalid denom")
+}
+
+func TestDenominatedPool_SpotValue(t *testing.T) {
+	pool, err := types.NewDenominatedPool(sdk.NewCoins(ukava(1e6), usdx(5e6)))
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(ukava(2e6), usdx(3e6))
+	assert.Equal(t, usdx(13e6), pool.SpotValue(coins, "usdx"))
+	assert.Equal(t, ukava(2600000), pool.SpotValue(coins, "ukava"))
+	assert.Equal(t, usdx(3e6), pool.SpotValue(sdk.NewCoins(usdx(3e6)), "usdx"))
+
+	assert.Panics(t, func() { pool.SpotValue(coins, "hard") }, "expected panic for invalid denom")
+}
@@ -15,4 +15,9 @@ var (
 	ErrDepositNotFound       = errorsmod.Register(ModuleName, 10, "deposit not found")
 	ErrInvalidCoin           = errorsmod.Register(ModuleName, 11, "invalid coin")
 	ErrNotImplemented        = errorsmod.Register(ModuleName, 12, "not implemented")
+	ErrInvalidRoute          = errorsmod.Register(ModuleName, 13, "invalid route")
+	ErrInvalidWindow         = errorsmod.Register(ModuleName, 14, "invalid window")
+	ErrPriceHistoryNotFound  = errorsmod.Register(ModuleName, 15, "price history not found")
+	ErrInvalidLimitOrder     = errorsmod.Register(ModuleName, 16, "invalid limit order")
+	ErrLimitOrderNotFound    = errorsmod.Register(ModuleName, 17, "limit order not found")
 )
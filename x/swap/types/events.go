@@ -2,17 +2,31 @@ package types
 
 // Event types for swap module
 const (
-	AttributeValueCategory     = ModuleName
-	EventTypeSwapDeposit       = "swap_deposit"
-	EventTypeSwapWithdraw      = "swap_withdraw"
-	EventTypeSwapTrade         = "swap_trade"
-	AttributeKeyPoolID         = "pool_id"
-	AttributeKeyDepositor      = "depositor"
-	AttributeKeyShares         = "shares"
-	AttributeKeyOwner          = "owner"
-	AttributeKeyRequester      = "requester"
-	AttributeKeySwapInput      = "input"
-	AttributeKeySwapOutput     = "output"
-	AttributeKeyFeePaid        = "fee"
-	AttributeKeyExactDirection = "exact"
+	AttributeValueCategory          = ModuleName
+	EventTypeSwapDeposit            = "swap_deposit"
+	EventTypeSwapWithdraw           = "swap_withdraw"
+	EventTypeSwapTrade              = "swap_trade"
+	EventTypeSwapLimitOrderPlace    = "swap_limit_order_place"
+	EventTypeSwapLimitOrderCancel   = "swap_limit_order_cancel"
+	EventTypeSwapLimitOrderFill     = "swap_limit_order_fill"
+	EventTypeSwapLimitOrderExpire   = "swap_limit_order_expire"
+	EventTypeSwapLimitOrderRefund   = "swap_limit_order_refund"
+	EventTypeSwapSyncShares         = "swap_sync_shares"
+	EventTypeSwapCreatePool         = "swap_create_pool"
+	AttributeKeyPoolID              = "pool_id"
+	AttributeKeyDepositor           = "depositor"
+	AttributeKeyShares              = "shares"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyRequester           = "requester"
+	AttributeKeySwapInput           = "input"
+	AttributeKeySwapOutput          = "output"
+	AttributeKeyFeePaid             = "fee"
+	AttributeKeyProtocolFee         = "protocol_fee"
+	AttributeKeyExactDirection      = "exact"
+	AttributeKeyLimitOrderID        = "order_id"
+	AttributeKeyLimitOrderPrice     = "price"
+	AttributeKeyLimitOrderBuyDenom  = "buy_denom"
+	AttributeKeyLimitOrderRemaining = "remaining"
+	AttributeKeyCreator             = "creator"
+	AttributeKeyCurated             = "curated"
 )
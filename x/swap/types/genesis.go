@@ -1,30 +1,41 @@
 package types
 
 import (
+	"errors"
 	"fmt"
-
-	sdkmath "cosmossdk.io/math"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-type poolShares struct {
-	totalShares      sdkmath.Int
-	totalSharesOwned sdkmath.Int
-}
-
 var (
 	// DefaultPoolRecords is used to set default records in default genesis state
 	DefaultPoolRecords = PoolRecords{}
 	// DefaultShareRecords is used to set default records in default genesis state
 	DefaultShareRecords = ShareRecords{}
+	// DefaultPriceObservations is used to set default observations in default genesis state
+	DefaultPriceObservations = PriceObservations{}
+	// DefaultLimitOrders is used to set default orders in default genesis state
+	DefaultLimitOrders = LimitOrders{}
+	// DefaultDepositPositions is used to set default positions in default genesis state
+	DefaultDepositPositions = DepositPositions{}
 )
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, poolRecords PoolRecords, shareRecords ShareRecords) GenesisState {
+func NewGenesisState(
+	params Params,
+	poolRecords PoolRecords,
+	shareRecords ShareRecords,
+	priceObservations PriceObservations,
+	limitOrders LimitOrders,
+	nextLimitOrderID uint64,
+	depositPositions DepositPositions,
+) GenesisState {
 	return GenesisState{
-		Params:       params,
-		PoolRecords:  poolRecords,
-		ShareRecords: shareRecords,
+		Params:            params,
+		PoolRecords:       poolRecords,
+		ShareRecords:      shareRecords,
+		PriceObservations: priceObservations,
+		LimitOrders:       limitOrders,
+		NextLimitOrderID:  nextLimitOrderID,
+		DepositPositions:  depositPositions,
 	}
 }
 
@@ -39,29 +50,41 @@ func (gs GenesisState) Validate() error {
 	if err := gs.ShareRecords.Validate(); err != nil {
 		return err
 	}
+	if err := gs.PriceObservations.Validate(); err != nil {
+		return err
+	}
+	if err := gs.LimitOrders.Validate(); err != nil {
+		return err
+	}
+	if err := gs.DepositPositions.Validate(); err != nil {
+		return err
+	}
+	if gs.NextLimitOrderID == 0 {
+		return errors.New("next limit order id must be set")
+	}
 
-	totalShares := make(map[string]poolShares)
+	pools := make(map[string]bool)
 	for _, pr := range gs.PoolRecords {
-		totalShares[pr.PoolID] = poolShares{
-			totalShares:      pr.TotalShares,
-			totalSharesOwned: sdk.ZeroInt(),
-		}
+		pools[pr.PoolID] = true
 	}
+
+	// share records are the shares of each holder as of its last sync, so they are not required to sum to the
+	// pool total shares -- the share token supply is checked against the pool total shares at init genesis
 	for _, sr := range gs.ShareRecords {
-		if shares, found := totalShares[sr.PoolID]; found {
-			shares.totalSharesOwned = shares.totalSharesOwned.Add(sr.SharesOwned)
-			totalShares[sr.PoolID] = shares
-		} else {
-			totalShares[sr.PoolID] = poolShares{
-				totalShares:      sdk.ZeroInt(),
-				totalSharesOwned: sr.SharesOwned,
-			}
+		if !pools[sr.PoolID] {
+			return fmt.Errorf("share record for pool '%s' does not have a pool record", sr.PoolID)
+		}
+	}
+
+	for _, o := range gs.PriceObservations {
+		if !pools[o.PoolID] {
+			return fmt.Errorf("price observation for pool '%s' does not have a pool record", o.PoolID)
 		}
 	}
 
-	for poolID, ps := range totalShares {
-		if !ps.totalShares.Equal(ps.totalSharesOwned) {
-			return fmt.Errorf("total depositor shares %s not equal to pool '%s' total shares %s", ps.totalSharesOwned.String(), poolID, ps.totalShares.String())
+	for _, o := range gs.LimitOrders {
+		if o.ID >= gs.NextLimitOrderID {
+			return fmt.Errorf("limit order id %d is not less than next limit order id %d", o.ID, gs.NextLimitOrderID)
 		}
 	}
 
@@ -74,5 +97,9 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		DefaultPoolRecords,
 		DefaultShareRecords,
+		DefaultPriceObservations,
+		DefaultLimitOrders,
+		DefaultNextLimitOrderID,
+		DefaultDepositPositions,
 	)
 }
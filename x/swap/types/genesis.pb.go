@@ -31,6 +31,14 @@ type GenesisState struct {
 	PoolRecords PoolRecords `protobuf:"bytes,2,rep,name=pool_records,json=poolRecords,proto3,castrepeated=PoolRecords" json:"pool_records"`
 	// share_records defines the owned shares of each pool
 	ShareRecords ShareRecords `protobuf:"bytes,3,rep,name=share_records,json=shareRecords,proto3,castrepeated=ShareRecords" json:"share_records"`
+	// price_observations defines the price history of each pool
+	PriceObservations PriceObservations `protobuf:"bytes,4,rep,name=price_observations,json=priceObservations,proto3,castrepeated=PriceObservations" json:"price_observations"`
+	// limit_orders defines the open limit orders of each pool
+	LimitOrders LimitOrders `protobuf:"bytes,5,rep,name=limit_orders,json=limitOrders,proto3,castrepeated=LimitOrders" json:"limit_orders"`
+	// next_limit_order_id defines the id of the next limit order placed
+	NextLimitOrderID uint64 `protobuf:"varint,6,opt,name=next_limit_order_id,json=nextLimitOrderId,proto3" json:"next_limit_order_id,omitempty"`
+	// deposit_positions defines the cost basis of the shares held by each depositor
+	DepositPositions DepositPositions `protobuf:"bytes,7,rep,name=deposit_positions,json=depositPositions,proto3,castrepeated=DepositPositions" json:"deposit_positions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +95,34 @@ func (m *GenesisState) GetShareRecords() ShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetPriceObservations() PriceObservations {
+	if m != nil {
+		return m.PriceObservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrders() LimitOrders {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLimitOrderID() uint64 {
+	if m != nil {
+		return m.NextLimitOrderID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDepositPositions() DepositPositions {
+	if m != nil {
+		return m.DepositPositions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.swap.v1beta1.GenesisState")
 }
@@ -94,25 +130,34 @@ func init() {
 func init() { proto.RegisterFile("kava/swap/v1beta1/genesis.proto", fileDescriptor_b1a1a1687f484a21) }
 
 var fileDescriptor_b1a1a1687f484a21 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x32, 0x98, 0x26, 0x81, 0x75, 0x81, 0x65, 0x95, 0x7e, 0x32, 0x72, 0xf1, 0xb8,
-	0x43, 0x0c, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe7, 0x62, 0x2b, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4, 0xc3, 0xb0, 0x48, 0x2f, 0x00, 0xac,
-	0xc0, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x72, 0xa1, 0x50, 0x2e, 0x9e, 0x82, 0xfc,
-	0xfc, 0x9c, 0xf8, 0xa2, 0xd4, 0xe4, 0xfc, 0xa2, 0x94, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e,
-	0x23, 0x59, 0x6c, 0xda, 0xf3, 0xf3, 0x73, 0x82, 0xc0, 0xaa, 0x9c, 0x84, 0x41, 0x46, 0xac, 0xba,
-	0x2f, 0xcf, 0x8d, 0x10, 0x2b, 0x0e, 0xe2, 0x2e, 0x40, 0x70, 0x84, 0x22, 0xb9, 0x78, 0x8b, 0x33,
-	0x12, 0x8b, 0x52, 0xe1, 0xe6, 0x32, 0x83, 0xcd, 0x95, 0xc3, 0x62, 0x6e, 0x30, 0x48, 0x1d, 0xd4,
-	0x60, 0x11, 0xa8, 0xc1, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0xc5, 0x48, 0x3c, 0x27, 0x87, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0xd9, 0xa3, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0x66, 0xe9, 0x57,
-	0x40, 0x82, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x88, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xca, 0xb8, 0xb9, 0x95, 0xae, 0x01, 0x00, 0x00,
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x1a, 0x82, 0xb4, 0x0e, 0x52, 0xb2, 0xcd, 0xc1, 0xad, 0xc0, 0x89, 0x8a, 0x84,
+	0x72, 0xc1, 0x56, 0xcb, 0x81, 0x2b, 0x32, 0x95, 0x10, 0x12, 0xa2, 0xd5, 0x56, 0x1c, 0xe0, 0x62,
+	0xad, 0xe3, 0x95, 0xbb, 0xc2, 0xf6, 0xae, 0x76, 0x96, 0x10, 0xde, 0x82, 0xe7, 0xe0, 0x49, 0x7a,
+	0xec, 0x91, 0x53, 0x41, 0xce, 0x03, 0xf0, 0x0a, 0x68, 0xd7, 0x16, 0x0e, 0x8e, 0xb9, 0xac, 0x76,
+	0xfe, 0xf9, 0xe6, 0x9f, 0xd1, 0x68, 0xd0, 0xfc, 0x13, 0x5d, 0xd3, 0x10, 0xbe, 0x50, 0x19, 0xae,
+	0x4f, 0x13, 0xa6, 0xe9, 0x69, 0x98, 0xb1, 0x92, 0x01, 0x87, 0x40, 0x2a, 0xa1, 0x05, 0x9e, 0x1a,
+	0x20, 0x30, 0x40, 0xd0, 0x00, 0xc7, 0xb3, 0x4c, 0x64, 0xc2, 0x66, 0x43, 0xf3, 0xab, 0xc1, 0xe3,
+	0x47, 0xfb, 0x4e, 0xb6, 0xca, 0x66, 0x4f, 0x7e, 0x0f, 0xd1, 0xf8, 0x75, 0x6d, 0x7c, 0xa5, 0xa9,
+	0x66, 0xf8, 0x05, 0x1a, 0x49, 0xaa, 0x68, 0x01, 0x9e, 0xb3, 0x70, 0x96, 0xee, 0xd9, 0x51, 0xb0,
+	0xd7, 0x28, 0xb8, 0xb4, 0x40, 0x34, 0xbc, 0xb9, 0x9b, 0x0f, 0x48, 0x83, 0xe3, 0xf7, 0x68, 0x2c,
+	0x85, 0xc8, 0x63, 0xc5, 0x56, 0x42, 0xa5, 0xe0, 0xdd, 0x5b, 0x1c, 0x2c, 0xdd, 0xb3, 0xc7, 0x7d,
+	0xe5, 0x42, 0xe4, 0xc4, 0x52, 0xd1, 0xa1, 0xb1, 0xf8, 0xfe, 0x73, 0xee, 0xb6, 0x1a, 0x10, 0x57,
+	0xb6, 0x01, 0xfe, 0x80, 0x1e, 0xc2, 0x35, 0x55, 0xec, 0xaf, 0xef, 0x81, 0xf5, 0xf5, 0x7b, 0x7c,
+	0xaf, 0x0c, 0xd7, 0x18, 0xcf, 0x1a, 0xe3, 0xf1, 0x8e, 0x08, 0x64, 0x0c, 0x3b, 0x11, 0x2e, 0x10,
+	0x96, 0x8a, 0xaf, 0x58, 0x2c, 0x12, 0x60, 0x6a, 0x4d, 0x35, 0x17, 0x25, 0x78, 0x43, 0xeb, 0xff,
+	0xa4, 0x6f, 0x6e, 0x03, 0x5f, 0xb4, 0x6c, 0x74, 0xd4, 0x34, 0x99, 0x76, 0x33, 0x40, 0xa6, 0xb2,
+	0x2b, 0x99, 0x05, 0xe5, 0xbc, 0xe0, 0x3a, 0x16, 0x2a, 0x65, 0x0a, 0xbc, 0xfb, 0xff, 0x5d, 0xd0,
+	0x5b, 0x83, 0x5d, 0x18, 0xaa, 0x5d, 0x50, 0xab, 0x01, 0x71, 0xf3, 0x36, 0xc0, 0xaf, 0xd0, 0x61,
+	0xc9, 0x36, 0x3a, 0xde, 0xf1, 0x8e, 0x79, 0xea, 0x8d, 0x16, 0xce, 0x72, 0x18, 0xcd, 0xaa, 0xbb,
+	0xf9, 0xe4, 0x1d, 0xdb, 0xe8, 0xb6, 0xfc, 0xcd, 0x39, 0x99, 0x94, 0xff, 0x2a, 0x29, 0xe6, 0x68,
+	0x9a, 0x32, 0x29, 0x80, 0xeb, 0xd8, 0xbe, 0x76, 0x13, 0x0f, 0xec, 0x80, 0x27, 0x3d, 0x03, 0x9e,
+	0xd7, 0xec, 0x65, 0x83, 0x46, 0x5e, 0x33, 0xe5, 0xa4, 0x93, 0x00, 0x32, 0x49, 0x3b, 0x4a, 0xf4,
+	0xf2, 0xa6, 0xf2, 0x9d, 0xdb, 0xca, 0x77, 0x7e, 0x55, 0xbe, 0xf3, 0x6d, 0xeb, 0x0f, 0x6e, 0xb7,
+	0xfe, 0xe0, 0xc7, 0xd6, 0x1f, 0x7c, 0x7c, 0x9a, 0x71, 0x7d, 0xfd, 0x39, 0x09, 0x56, 0xa2, 0x08,
+	0x4d, 0xcf, 0x67, 0x39, 0x4d, 0xc0, 0xfe, 0xc2, 0x4d, 0x7d, 0xc0, 0xfa, 0xab, 0x64, 0x90, 0x8c,
+	0xec, 0xe9, 0x3e, 0xff, 0x33, 0x00, 0x79, 0x0a, 0x93, 0xbc, 0x24, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +180,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DepositPositions) > 0 {
+		for iNdEx := len(m.DepositPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextLimitOrderID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLimitOrderID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PriceObservations) > 0 {
+		for iNdEx := len(m.PriceObservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceObservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ShareRecords) > 0 {
 		for iNdEx := len(m.ShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -207,6 +299,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PriceObservations) > 0 {
+		for _, e := range m.PriceObservations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLimitOrderID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLimitOrderID))
+	}
+	if len(m.DepositPositions) > 0 {
+		for _, e := range m.DepositPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -346,6 +459,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceObservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceObservations = append(m.PriceObservations, PriceObservation{})
+			if err := m.PriceObservations[len(m.PriceObservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLimitOrderID", wireType)
+			}
+			m.NextLimitOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLimitOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositPositions = append(m.DepositPositions, DepositPosition{})
+			if err := m.DepositPositions[len(m.DepositPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
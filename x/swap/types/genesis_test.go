@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -50,9 +52,12 @@ func TestGenesis_Validate_SwapFee(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: types.DefaultAllowedPools,
-					SwapFee:      tc.swapFee,
+					AllowedPools:     types.DefaultAllowedPools,
+					SwapFee:          tc.swapFee,
+					ProtocolFee:      sdk.ZeroDec(),
+					MinInitialShares: sdk.ZeroInt(),
 				},
+				NextLimitOrderID: types.DefaultNextLimitOrderID,
 			}
 
 			err := genesisState.Validate()
@@ -94,9 +99,12 @@ func TestGenesis_Validate_AllowedPools(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: tc.pairs,
-					SwapFee:      types.DefaultSwapFee,
+					AllowedPools:     tc.pairs,
+					SwapFee:          types.DefaultSwapFee,
+					ProtocolFee:      sdk.ZeroDec(),
+					MinInitialShares: sdk.ZeroInt(),
 				},
+				NextLimitOrderID: types.DefaultNextLimitOrderID,
 			}
 
 			err := genesisState.Validate()
@@ -163,30 +171,58 @@ func TestGenesis_JSONEncoding(t *testing.T) {
 }
 
 func TestGenesis_YAMLEncoding(t *testing.T) {
-	expected := `params:
+	expected := `deposit_positions: []
+limit_orders: []
+next_limit_order_id: 1
+params:
   allowed_pools:
-  - token_a: ukava
+  - amplification: 0
+    pool_type: POOL_TYPE_CONSTANT_PRODUCT
+    swap_fee: "0.000000000000000000"
+    token_a: ukava
     token_b: usdx
-  - token_a: hard
+    weight_a: 0
+    weight_b: 0
+  - amplification: 0
+    pool_type: POOL_TYPE_CONSTANT_PRODUCT
+    swap_fee: "0.000000000000000000"
+    token_a: hard
     token_b: busd
+    weight_a: 0
+    weight_b: 0
+  min_initial_shares: "0"
+  permissionless_pool_creation: false
+  pool_creation_fee: []
+  protocol_fee: "0.000000000000000000"
   swap_fee: "0.003000000000000000"
 pool_records:
-- pool_id: ukava:usdx
+- amplification: 0
+  pool_id: ukava:usdx
+  pool_type: POOL_TYPE_CONSTANT_PRODUCT
   reserves_a:
     amount: "1000000"
     denom: ukava
   reserves_b:
     amount: "5000000"
     denom: usdx
+  swap_fee: "0.000000000000000000"
   total_shares: "3000000"
-- pool_id: hard:usdx
+  weight_a: 0
+  weight_b: 0
+- amplification: 0
+  pool_id: hard:usdx
+  pool_type: POOL_TYPE_CONSTANT_PRODUCT
   reserves_a:
     amount: "1000000"
     denom: hard
   reserves_b:
     amount: "2000000"
     denom: usdx
+  swap_fee: "0.000000000000000000"
   total_shares: "1500000"
+  weight_a: 0
+  weight_b: 0
+price_observations: []
 share_records:
 - depositor: kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w
   pool_id: ukava:usdx
@@ -217,6 +253,10 @@ share_records:
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), i(1e5)),
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), i(2e5)),
 		},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+		types.DepositPositions{},
 	)
 
 	data, err := yaml.Marshal(state)
@@ -232,6 +272,10 @@ func TestGenesis_ValidatePoolRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{invalidPoolRecord},
 		types.ShareRecords{},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+		types.DepositPositions{},
 	)
 
 	assert.Error(t, state.Validate())
@@ -247,11 +291,101 @@ func TestGenesis_ValidateShareRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{},
 		types.ShareRecords{invalidShareRecord},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+		types.DepositPositions{},
+	)
+
+	assert.Error(t, state.Validate())
+}
+
+func TestGenesis_ValidatePriceObservations(t *testing.T) {
+	depositor, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	timestamp := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	poolRecords := types.PoolRecords{
+		types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6)),
+	}
+	shareRecords := types.ShareRecords{
+		types.NewShareRecord(depositor, types.PoolID("ukava", "usdx"), i(3e6)),
+	}
+
+	state := types.NewGenesisState(
+		types.DefaultParams(),
+		poolRecords,
+		shareRecords,
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("ukava", "usdx"), timestamp, sdk.ZeroDec(), sdk.ZeroDec(), d("5"), d("0.2")),
+		},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+		types.DepositPositions{},
 	)
+	assert.NoError(t, state.Validate())
 
+	state = types.NewGenesisState(
+		types.DefaultParams(),
+		poolRecords,
+		shareRecords,
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("ukava", "usdx"), timestamp, sdk.ZeroDec(), sdk.ZeroDec(), d("-5"), d("0.2")),
+		},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+		types.DepositPositions{},
+	)
 	assert.Error(t, state.Validate())
+
+	state = types.NewGenesisState(
+		types.DefaultParams(),
+		poolRecords,
+		shareRecords,
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), timestamp, sdk.ZeroDec(), sdk.ZeroDec(), d("2"), d("0.5")),
+		},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+		types.DepositPositions{},
+	)
+	assert.EqualError(t, state.Validate(), "price observation for pool 'hard:usdx' does not have a pool record")
+}
+
+func TestGenesis_ValidateDepositPositions(t *testing.T) {
+	depositor, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	timestamp := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	position := types.NewDepositPosition(depositor, types.PoolID("ukava", "usdx"), i(3e6), sdk.NewCoins(ukava(1e6), usdx(5e6)), timestamp)
+
+	state := types.NewGenesisState(
+		types.DefaultParams(),
+		types.PoolRecords{},
+		types.ShareRecords{},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+		types.DepositPositions{position},
+	)
+	assert.NoError(t, state.Validate())
+
+	state.DepositPositions = types.DepositPositions{position, position}
+	assert.EqualError(t, state.Validate(), fmt.Sprintf("duplicate depositor '%s' and poolID 'ukava:usdx'", depositor))
+
+	state.DepositPositions = types.DepositPositions{
+		types.NewDepositPosition(depositor, types.PoolID("ukava", "usdx"), i(0), sdk.NewCoins(), timestamp),
+	}
+	assert.EqualError(t, state.Validate(), fmt.Sprintf("depositor '%s' and pool 'ukava:usdx' has invalid position shares: 0", depositor))
+
+	state.DepositPositions = types.DepositPositions{
+		types.NewDepositPosition(depositor, types.PoolID("ukava", "usdx"), i(3e6), sdk.NewCoins(), time.Time{}),
+	}
+	assert.EqualError(t, state.Validate(), fmt.Sprintf("depositor '%s' and pool 'ukava:usdx' has invalid average deposit time", depositor))
 }
 
+// Share records are the shares of each holder as of its last sync and share tokens are transferable,
+// so share records are only required to reference a pool and may not sum to the pool total shares.
 func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 	depositor_1, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
 	require.NoError(t, err)
@@ -270,7 +404,7 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 				types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6)),
 			},
 			shareRecords: types.ShareRecords{},
-			expectedErr:  "total depositor shares 0 not equal to pool 'ukava:usdx' total shares 3000000",
+			expectedErr:  "",
 		},
 		{
 			name:        "zero pool records, one share record",
@@ -278,7 +412,7 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 			shareRecords: types.ShareRecords{
 				types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), i(5e6)),
 			},
-			expectedErr: "total depositor shares 5000000 not equal to pool 'ukava:usdx' total shares 0",
+			expectedErr: "share record for pool 'ukava:usdx' does not have a pool record",
 		},
 		{
 			name: "one pool record, one share record",
@@ -288,7 +422,7 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 			shareRecords: types.ShareRecords{
 				types.NewShareRecord(depositor_1, "ukava:usdx", i(15e5)),
 			},
-			expectedErr: "total depositor shares 1500000 not equal to pool 'ukava:usdx' total shares 3000000",
+			expectedErr: "",
 		},
 		{
 			name: "more than one pool records, more than one share record",
@@ -300,8 +434,9 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 				types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), i(15e5)),
 				types.NewShareRecord(depositor_2, types.PoolID("ukava", "usdx"), i(15e5)),
 				types.NewShareRecord(depositor_1, types.PoolID("hard", "usdx"), i(1e6)),
+				types.NewShareRecord(depositor_2, types.PoolID("hard", "ukava"), i(1e6)),
 			},
-			expectedErr: "total depositor shares 1000000 not equal to pool 'hard:usdx' total shares 2000000",
+			expectedErr: "share record for pool 'hard:ukava' does not have a pool record",
 		},
 		{
 			name: "valid case with many pool records and share records",
@@ -323,7 +458,7 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords)
+			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords, types.PriceObservations{}, types.LimitOrders{}, types.DefaultNextLimitOrderID, types.DepositPositions{})
 			err := state.Validate()
 
 			if tc.expectedErr == "" {
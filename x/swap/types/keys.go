@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -28,6 +31,12 @@ const (
 var (
 	PoolKeyPrefix             = []byte{0x01}
 	DepositorPoolSharesPrefix = []byte{0x02}
+	PriceObservationPrefix    = []byte{0x03}
+	LimitOrderPrefix          = []byte{0x04}
+	LimitOrderByPricePrefix   = []byte{0x05}
+	LimitOrderByTimePrefix    = []byte{0x06}
+	NextLimitOrderIDKey       = []byte{0x07}
+	DepositPositionPrefix     = []byte{0x08}
 
 	sep = []byte("|")
 )
@@ -42,6 +51,54 @@ func DepositorPoolSharesKey(depositor sdk.AccAddress, poolID string) []byte {
 	return createKey(depositor, sep, []byte(poolID))
 }
 
+// DepositPositionKey returns a key from a depositor and poolID
+func DepositPositionKey(depositor sdk.AccAddress, poolID string) []byte {
+	return createKey(depositor, sep, []byte(poolID))
+}
+
+// PriceObservationsKeyPrefix returns the key prefix of all price observations for a poolID
+func PriceObservationsKeyPrefix(poolID string) []byte {
+	return createKey([]byte(poolID), sep)
+}
+
+// PriceObservationKey returns a key from a poolID and observation time
+func PriceObservationKey(poolID string, timestamp time.Time) []byte {
+	return createKey(PriceObservationsKeyPrefix(poolID), sdk.FormatTimeBytes(timestamp))
+}
+
+// LimitOrderKey returns a key from a limit order id
+func LimitOrderKey(id uint64) []byte {
+	return Uint64ToBytes(id)
+}
+
+// LimitOrdersByPriceKeyPrefix returns the key prefix of all limit orders of a pool selling a denom,
+// which are ordered by ascending price
+func LimitOrdersByPriceKeyPrefix(poolID string, sellDenom string) []byte {
+	return createKey([]byte(poolID), sep, []byte(sellDenom), sep)
+}
+
+// LimitOrderByPriceKey returns a key from a poolID, sell denom, price and limit order id
+func LimitOrderByPriceKey(poolID string, sellDenom string, price sdk.Dec, id uint64) []byte {
+	return createKey(LimitOrdersByPriceKeyPrefix(poolID, sellDenom), sdk.SortableDecBytes(price), Uint64ToBytes(id))
+}
+
+// LimitOrderByTimeKey returns a key from a limit order expiration and id
+func LimitOrderByTimeKey(expiration time.Time, id uint64) []byte {
+	return createKey(sdk.FormatTimeBytes(expiration), Uint64ToBytes(id))
+}
+
+// Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
+func Uint64ToBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// Uint64FromBytes converts some fixed length bytes back into a uint64.
+func Uint64FromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
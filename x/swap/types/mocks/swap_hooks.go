@@ -23,3 +23,8 @@ func (_m *SwapHooks) AfterPoolDepositCreated(ctx types.Context, poolID string, d
 func (_m *SwapHooks) BeforePoolDepositModified(ctx types.Context, poolID string, depositor types.AccAddress, sharedOwned math.Int) {
 	_m.Called(ctx, poolID, depositor, sharedOwned)
 }
+
+// AfterPoolSwap provides a mock function with given fields: ctx, poolID, requester, swapInput, swapOutput
+func (_m *SwapHooks) AfterPoolSwap(ctx types.Context, poolID string, requester types.AccAddress, swapInput types.Coin, swapOutput types.Coin) {
+	_m.Called(ctx, poolID, requester, swapInput, swapOutput)
+}
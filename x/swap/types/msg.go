@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -18,6 +19,21 @@ const (
 	TypeSwapExactForTokens = "swap_exact_for_tokens"
 	// TypeSwapForExactTokens represents the type string for MsgSwapForExactTokens
 	TypeSwapForExactTokens = "swap_for_exact_tokens"
+	// TypeSwapExactForTokensMultiHop represents the type string for MsgSwapExactForTokensMultiHop
+	TypeSwapExactForTokensMultiHop = "swap_exact_for_tokens_multi_hop"
+	// TypeSwapForExactTokensMultiHop represents the type string for MsgSwapForExactTokensMultiHop
+	TypeSwapForExactTokensMultiHop = "swap_for_exact_tokens_multi_hop"
+	// TypeMsgZapDeposit represents the type string for MsgZapDeposit
+	TypeMsgZapDeposit = "swap_zap_deposit"
+	// TypeMsgPlaceLimitOrder represents the type string for MsgPlaceLimitOrder
+	TypeMsgPlaceLimitOrder = "swap_place_limit_order"
+	// TypeMsgCancelLimitOrder represents the type string for MsgCancelLimitOrder
+	TypeMsgCancelLimitOrder = "swap_cancel_limit_order"
+	// TypeMsgSyncShares represents the type string for MsgSyncShares
+	TypeMsgSyncShares = "swap_sync_shares"
+
+	// MaxRouteLength is the maximum number of denoms in a multi-hop swap route
+	MaxRouteLength = 5
 )
 
 var (
@@ -29,6 +45,15 @@ var (
 	_ MsgWithDeadline = &MsgSwapExactForTokens{}
 	_ sdk.Msg         = &MsgSwapForExactTokens{}
 	_ MsgWithDeadline = &MsgSwapForExactTokens{}
+	_ sdk.Msg         = &MsgSwapExactForTokensMultiHop{}
+	_ MsgWithDeadline = &MsgSwapExactForTokensMultiHop{}
+	_ sdk.Msg         = &MsgSwapForExactTokensMultiHop{}
+	_ MsgWithDeadline = &MsgSwapForExactTokensMultiHop{}
+	_ sdk.Msg         = &MsgZapDeposit{}
+	_ MsgWithDeadline = &MsgZapDeposit{}
+	_ sdk.Msg         = &MsgPlaceLimitOrder{}
+	_ sdk.Msg         = &MsgCancelLimitOrder{}
+	_ sdk.Msg         = &MsgSyncShares{}
 )
 
 // MsgWithDeadline allows messages to define a deadline of when they are considered invalid
@@ -340,3 +365,433 @@ func (msg MsgSwapForExactTokens) GetDeadline() time.Time {
 func (msg MsgSwapForExactTokens) DeadlineExceeded(blockTime time.Time) bool {
 	return blockTime.Unix() >= msg.Deadline
 }
+
+// NewMsgSwapExactForTokensMultiHop returns a new MsgSwapExactForTokensMultiHop
+func NewMsgSwapExactForTokensMultiHop(
+	requester string, exactTokenA sdk.Coin, route []string, tokenB sdk.Coin, slippage sdk.Dec, deadline int64,
+) *MsgSwapExactForTokensMultiHop {
+	return &MsgSwapExactForTokensMultiHop{
+		Requester:   requester,
+		ExactTokenA: exactTokenA,
+		SwapRoute:   route,
+		TokenB:      tokenB,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapExactForTokensMultiHop) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapExactForTokensMultiHop) Type() string { return TypeSwapExactForTokensMultiHop }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapExactForTokensMultiHop) ValidateBasic() error {
+	if msg.Requester == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester address: %s", err)
+	}
+
+	if !msg.ExactTokenA.IsValid() || msg.ExactTokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "exact token a deposit amount %s", msg.ExactTokenA)
+	}
+
+	if !msg.TokenB.IsValid() || msg.TokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token b deposit amount %s", msg.TokenB)
+	}
+
+	if err := ValidateRoute(msg.SwapRoute, msg.ExactTokenA.Denom, msg.TokenB.Denom); err != nil {
+		return err
+	}
+
+	if msg.Slippage.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapExactForTokensMultiHop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapExactForTokensMultiHop) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapExactForTokensMultiHop) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapExactForTokensMultiHop) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgSwapForExactTokensMultiHop returns a new MsgSwapForExactTokensMultiHop
+func NewMsgSwapForExactTokensMultiHop(
+	requester string, tokenA sdk.Coin, route []string, exactTokenB sdk.Coin, slippage sdk.Dec, deadline int64,
+) *MsgSwapForExactTokensMultiHop {
+	return &MsgSwapForExactTokensMultiHop{
+		Requester:   requester,
+		TokenA:      tokenA,
+		SwapRoute:   route,
+		ExactTokenB: exactTokenB,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapForExactTokensMultiHop) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapForExactTokensMultiHop) Type() string { return TypeSwapForExactTokensMultiHop }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapForExactTokensMultiHop) ValidateBasic() error {
+	if msg.Requester == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester address: %s", err)
+	}
+
+	if !msg.TokenA.IsValid() || msg.TokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token a deposit amount %s", msg.TokenA)
+	}
+
+	if !msg.ExactTokenB.IsValid() || msg.ExactTokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "exact token b deposit amount %s", msg.ExactTokenB)
+	}
+
+	if err := ValidateRoute(msg.SwapRoute, msg.TokenA.Denom, msg.ExactTokenB.Denom); err != nil {
+		return err
+	}
+
+	if msg.Slippage.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapForExactTokensMultiHop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapForExactTokensMultiHop) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapForExactTokensMultiHop) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapForExactTokensMultiHop) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgZapDeposit returns a new MsgZapDeposit
+func NewMsgZapDeposit(depositor string, tokenA sdk.Coin, tokenBDenom string, slippage sdk.Dec, deadline int64) *MsgZapDeposit {
+	return &MsgZapDeposit{
+		Depositor:   depositor,
+		TokenA:      tokenA,
+		TokenBDenom: tokenBDenom,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgZapDeposit) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgZapDeposit) Type() string { return TypeMsgZapDeposit }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgZapDeposit) ValidateBasic() error {
+	if msg.Depositor == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "depositor address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %s", err)
+	}
+
+	if !msg.TokenA.IsValid() || msg.TokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token a deposit amount %s", msg.TokenA)
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenBDenom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if msg.TokenA.Denom == msg.TokenBDenom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	if msg.Slippage.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgZapDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgZapDeposit) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgZapDeposit) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgZapDeposit) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgPlaceLimitOrder returns a new MsgPlaceLimitOrder
+func NewMsgPlaceLimitOrder(owner string, sell sdk.Coin, buyDenom string, price sdk.Dec, expiration int64) *MsgPlaceLimitOrder {
+	return &MsgPlaceLimitOrder{
+		Owner:      owner,
+		Sell:       sell,
+		BuyDenom:   buyDenom,
+		Price:      price,
+		Expiration: expiration,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceLimitOrder) Type() string { return TypeMsgPlaceLimitOrder }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	if msg.Owner == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if !msg.Sell.IsValid() || msg.Sell.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "sell amount %s", msg.Sell)
+	}
+
+	if msg.Sell.Amount.LT(MinLimitOrderSellAmount) {
+		return errorsmod.Wrapf(ErrInvalidLimitOrder, "sell amount %s is less than minimum %s", msg.Sell.Amount, MinLimitOrderSellAmount)
+	}
+
+	if err := sdk.ValidateDenom(msg.BuyDenom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if msg.Sell.Denom == msg.BuyDenom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	if msg.Price.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidLimitOrder, "price must be set")
+	}
+
+	if !msg.Price.IsPositive() || !sdk.ValidSortableDec(msg.Price) {
+		return errorsmod.Wrapf(ErrInvalidLimitOrder, "invalid price %s", msg.Price)
+	}
+
+	if msg.Expiration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidLimitOrder, "invalid expiration %d", msg.Expiration)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// GetExpiration returns the time at which the unfilled amount of the order is returned
+func (msg MsgPlaceLimitOrder) GetExpiration() time.Time {
+	return time.Unix(msg.Expiration, 0)
+}
+
+// NewMsgCancelLimitOrder returns a new MsgCancelLimitOrder
+func NewMsgCancelLimitOrder(owner string, id uint64) *MsgCancelLimitOrder {
+	return &MsgCancelLimitOrder{
+		Owner: owner,
+		ID:    id,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCancelLimitOrder) Type() string { return TypeMsgCancelLimitOrder }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	if msg.Owner == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if msg.ID == 0 {
+		return errorsmod.Wrap(ErrInvalidLimitOrder, "order id must be set")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgSyncShares returns a new MsgSyncShares
+func NewMsgSyncShares(owner string, poolID string) *MsgSyncShares {
+	return &MsgSyncShares{
+		Owner:  owner,
+		PoolID: poolID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSyncShares) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSyncShares) Type() string { return TypeMsgSyncShares }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSyncShares) ValidateBasic() error {
+	if msg.Owner == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	tokens := strings.Split(msg.PoolID, PoolIDSep)
+	if len(tokens) != 2 || tokens[1] < tokens[0] || tokens[0] == tokens[1] ||
+		sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
+		return errorsmod.Wrapf(ErrInvalidPool, "poolID '%s' is invalid", msg.PoolID)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSyncShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSyncShares) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateRoute validates a multi-hop swap route starts with the input denom, ends with the output denom,
+// and passes through each denom at most once
+func ValidateRoute(route []string, denomIn, denomOut string) error {
+	if len(route) < 2 {
+		return errorsmod.Wrapf(ErrInvalidRoute, "route must contain at least 2 denoms, got %d", len(route))
+	}
+
+	if len(route) > MaxRouteLength {
+		return errorsmod.Wrapf(ErrInvalidRoute, "route must contain at most %d denoms, got %d", MaxRouteLength, len(route))
+	}
+
+	if route[0] != denomIn {
+		return errorsmod.Wrapf(ErrInvalidRoute, "route must start with %s, got %s", denomIn, route[0])
+	}
+
+	if route[len(route)-1] != denomOut {
+		return errorsmod.Wrapf(ErrInvalidRoute, "route must end with %s, got %s", denomOut, route[len(route)-1])
+	}
+
+	seen := make(map[string]bool, len(route))
+	for _, denom := range route {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(ErrInvalidRoute, err.Error())
+		}
+		if seen[denom] {
+			return errorsmod.Wrapf(ErrInvalidRoute, "route contains duplicate denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
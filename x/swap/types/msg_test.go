@@ -764,3 +764,698 @@ func TestMsgSwapForExactTokens_Deadline(t *testing.T) {
 		assert.Equal(t, time.Unix(tc.deadline, 0), msg.GetDeadline())
 	}
 }
+
+func TestMsgSwapExactForTokensMultiHop_Attributes(t *testing.T) {
+	msg := types.MsgSwapExactForTokensMultiHop{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_exact_for_tokens_multi_hop", msg.Type())
+}
+
+func TestMsgSwapExactForTokensMultiHop_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgSwapExactForTokensMultiHop","value":{"deadline":"1623606299","exact_token_a":{"amount":"1000000","denom":"ukava"},"requester":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","route":["ukava","usdx","hard"],"slippage":"0.010000000000000000","token_b":{"amount":"5000000","denom":"hard"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgSwapExactForTokensMultiHop(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), []string{"ukava", "usdx", "hard"}, sdk.NewCoin("hard", sdkmath.NewInt(5e6)), sdk.MustNewDecFromStr("0.01"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgSwapExactForTokensMultiHop_Validation(t *testing.T) {
+	validMsg := types.NewMsgSwapExactForTokensMultiHop(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		[]string{"ukava", "usdx", "hard"},
+		sdk.NewCoin("hard", sdkmath.NewInt(5e6)),
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		requester   string
+		exactTokenA sdk.Coin
+		route       []string
+		tokenB      sdk.Coin
+		slippage    sdk.Dec
+		deadline    int64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			requester:   sdk.AccAddress("").String(),
+			exactTokenA: validMsg.ExactTokenA,
+			route:       validMsg.SwapRoute,
+			tokenB:      validMsg.TokenB,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "requester address cannot be empty: invalid address",
+		},
+		{
+			name:        "zero token a",
+			requester:   validMsg.Requester,
+			exactTokenA: sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(0)},
+			route:       validMsg.SwapRoute,
+			tokenB:      validMsg.TokenB,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "exact token a deposit amount 0ukava: invalid coins",
+		},
+		{
+			name:        "zero token b",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			route:       validMsg.SwapRoute,
+			tokenB:      sdk.Coin{Denom: "hard", Amount: sdkmath.NewInt(0)},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "token b deposit amount 0hard: invalid coins",
+		},
+		{
+			name:        "route too short",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			route:       []string{"ukava"},
+			tokenB:      validMsg.TokenB,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "route must contain at least 2 denoms, got 1: invalid route",
+		},
+		{
+			name:        "route too long",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			route:       []string{"ukava", "a", "b", "c", "d", "hard"},
+			tokenB:      validMsg.TokenB,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "route must contain at most 5 denoms, got 6: invalid route",
+		},
+		{
+			name:        "route does not start with token a",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			route:       []string{"usdx", "hard"},
+			tokenB:      validMsg.TokenB,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "route must start with ukava, got usdx: invalid route",
+		},
+		{
+			name:        "route does not end with token b",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			route:       []string{"ukava", "usdx"},
+			tokenB:      validMsg.TokenB,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "route must end with hard, got usdx: invalid route",
+		},
+		{
+			name:        "route with duplicate denom",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			route:       []string{"ukava", "usdx", "ukava", "hard"},
+			tokenB:      validMsg.TokenB,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "route contains duplicate denom ukava: invalid route",
+		},
+		{
+			name:        "same input and output denom",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			route:       []string{"ukava", "usdx", "ukava"},
+			tokenB:      sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "route contains duplicate denom ukava: invalid route",
+		},
+		{
+			name:        "negative slippage",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			route:       validMsg.SwapRoute,
+			tokenB:      validMsg.TokenB,
+			slippage:    sdk.MustNewDecFromStr("-0.01"),
+			deadline:    validMsg.Deadline,
+			expectedErr: "slippage can not be negative: invalid slippage",
+		},
+		{
+			name:        "zero deadline",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			route:       validMsg.SwapRoute,
+			tokenB:      validMsg.TokenB,
+			slippage:    validMsg.Slippage,
+			deadline:    0,
+			expectedErr: "deadline 0: invalid deadline",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapExactForTokensMultiHop(tc.requester, tc.exactTokenA, tc.route, tc.tokenB, tc.slippage, tc.deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgSwapForExactTokensMultiHop_Attributes(t *testing.T) {
+	msg := types.MsgSwapForExactTokensMultiHop{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_for_exact_tokens_multi_hop", msg.Type())
+}
+
+func TestMsgSwapForExactTokensMultiHop_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgSwapForExactTokensMultiHop","value":{"deadline":"1623606299","exact_token_b":{"amount":"5000000","denom":"hard"},"requester":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","route":["ukava","usdx","hard"],"slippage":"0.010000000000000000","token_a":{"amount":"1000000","denom":"ukava"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgSwapForExactTokensMultiHop(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), []string{"ukava", "usdx", "hard"}, sdk.NewCoin("hard", sdkmath.NewInt(5e6)), sdk.MustNewDecFromStr("0.01"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgSwapForExactTokensMultiHop_Validation(t *testing.T) {
+	validMsg := types.NewMsgSwapForExactTokensMultiHop(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		[]string{"ukava", "usdx", "hard"},
+		sdk.NewCoin("hard", sdkmath.NewInt(5e6)),
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		tokenA      sdk.Coin
+		route       []string
+		exactTokenB sdk.Coin
+		expectedErr string
+	}{
+		{
+			name:        "zero token a",
+			tokenA:      sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(0)},
+			route:       validMsg.SwapRoute,
+			exactTokenB: validMsg.ExactTokenB,
+			expectedErr: "token a deposit amount 0ukava: invalid coins",
+		},
+		{
+			name:        "zero token b",
+			tokenA:      validMsg.TokenA,
+			route:       validMsg.SwapRoute,
+			exactTokenB: sdk.Coin{Denom: "hard", Amount: sdkmath.NewInt(0)},
+			expectedErr: "exact token b deposit amount 0hard: invalid coins",
+		},
+		{
+			name:        "route does not end with token b",
+			tokenA:      validMsg.TokenA,
+			route:       []string{"ukava", "usdx"},
+			exactTokenB: validMsg.ExactTokenB,
+			expectedErr: "route must end with hard, got usdx: invalid route",
+		},
+		{
+			name:        "route with invalid denom",
+			tokenA:      validMsg.TokenA,
+			route:       []string{"ukava", "!", "hard"},
+			exactTokenB: validMsg.ExactTokenB,
+			expectedErr: "invalid denom: !: invalid route",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapForExactTokensMultiHop(validMsg.Requester, tc.tokenA, tc.route, tc.exactTokenB, validMsg.Slippage, validMsg.Deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgZapDeposit_Attributes(t *testing.T) {
+	msg := types.MsgZapDeposit{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_zap_deposit", msg.Type())
+}
+
+func TestMsgZapDeposit_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgZapDeposit","value":{"deadline":"1623606299","depositor":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","slippage":"0.010000000000000000","token_a":{"amount":"1000000","denom":"ukava"},"token_b_denom":"usdx"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgZapDeposit(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("0.01"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgZapDeposit_Validation(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	validMsg := types.NewMsgZapDeposit(
+		addr.String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		"usdx",
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		depositor   string
+		tokenA      sdk.Coin
+		tokenBDenom string
+		slippage    sdk.Dec
+		deadline    int64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			depositor:   "",
+			tokenA:      validMsg.TokenA,
+			tokenBDenom: validMsg.TokenBDenom,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "depositor address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			depositor:   "kava1abcde",
+			tokenA:      validMsg.TokenA,
+			tokenBDenom: validMsg.TokenBDenom,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "invalid depositor address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "negative token a",
+			depositor:   validMsg.Depositor,
+			tokenA:      sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(-1)},
+			tokenBDenom: validMsg.TokenBDenom,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "token a deposit amount -1ukava: invalid coins",
+		},
+		{
+			name:        "zero token a",
+			depositor:   validMsg.Depositor,
+			tokenA:      sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(0)},
+			tokenBDenom: validMsg.TokenBDenom,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "token a deposit amount 0ukava: invalid coins",
+		},
+		{
+			name:        "invalid token b denom",
+			depositor:   validMsg.Depositor,
+			tokenA:      validMsg.TokenA,
+			tokenBDenom: "",
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "invalid denom: : invalid coins",
+		},
+		{
+			name:        "denoms can not be the same",
+			depositor:   validMsg.Depositor,
+			tokenA:      validMsg.TokenA,
+			tokenBDenom: "ukava",
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "denominations can not be equal: invalid coins",
+		},
+		{
+			name:        "zero deadline",
+			depositor:   validMsg.Depositor,
+			tokenA:      validMsg.TokenA,
+			tokenBDenom: validMsg.TokenBDenom,
+			slippage:    validMsg.Slippage,
+			deadline:    0,
+			expectedErr: "deadline 0: invalid deadline",
+		},
+		{
+			name:        "negative slippage",
+			depositor:   validMsg.Depositor,
+			tokenA:      validMsg.TokenA,
+			tokenBDenom: validMsg.TokenBDenom,
+			slippage:    sdk.MustNewDecFromStr("-0.01"),
+			deadline:    validMsg.Deadline,
+			expectedErr: "slippage can not be negative: invalid slippage",
+		},
+		{
+			name:        "nil slippage",
+			depositor:   validMsg.Depositor,
+			tokenA:      validMsg.TokenA,
+			tokenBDenom: validMsg.TokenBDenom,
+			slippage:    sdk.Dec{},
+			deadline:    validMsg.Deadline,
+			expectedErr: "slippage must be set: invalid slippage",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgZapDeposit(tc.depositor, tc.tokenA, tc.tokenBDenom, tc.slippage, tc.deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgZapDeposit_Deadline(t *testing.T) {
+	blockTime := time.Now()
+
+	testCases := []struct {
+		name       string
+		deadline   int64
+		isExceeded bool
+	}{
+		{
+			name:       "deadline in future",
+			deadline:   blockTime.Add(1 * time.Second).Unix(),
+			isExceeded: false,
+		},
+		{
+			name:       "deadline in past",
+			deadline:   blockTime.Add(-1 * time.Second).Unix(),
+			isExceeded: true,
+		},
+		{
+			name:       "deadline is equal",
+			deadline:   blockTime.Unix(),
+			isExceeded: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgZapDeposit(
+			sdk.AccAddress("test1").String(),
+			sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			"usdx",
+			sdk.MustNewDecFromStr("0.01"),
+			tc.deadline,
+		)
+		require.NoError(t, msg.ValidateBasic())
+		assert.Equal(t, tc.isExceeded, msg.DeadlineExceeded(blockTime))
+		assert.Equal(t, time.Unix(tc.deadline, 0), msg.GetDeadline())
+	}
+}
+
+func TestMsgPlaceLimitOrder_Attributes(t *testing.T) {
+	msg := types.MsgPlaceLimitOrder{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_place_limit_order", msg.Type())
+}
+
+func TestMsgPlaceLimitOrder_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgPlaceLimitOrder","value":{"buy_denom":"usdx","expiration":"1623606299","owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","price":"5.500000000000000000","sell":{"amount":"1000000","denom":"ukava"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgPlaceLimitOrder(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.5"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+	assert.Equal(t, time.Unix(1623606299, 0), msg.GetExpiration())
+}
+
+func TestMsgPlaceLimitOrder_Validation(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	validMsg := types.NewMsgPlaceLimitOrder(
+		addr.String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		"usdx",
+		sdk.MustNewDecFromStr("5.5"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		owner       string
+		sell        sdk.Coin
+		buyDenom    string
+		price       sdk.Dec
+		expiration  int64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			owner:       "",
+			sell:        validMsg.Sell,
+			buyDenom:    validMsg.BuyDenom,
+			price:       validMsg.Price,
+			expiration:  validMsg.Expiration,
+			expectedErr: "owner address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			owner:       "kava1abcde",
+			sell:        validMsg.Sell,
+			buyDenom:    validMsg.BuyDenom,
+			price:       validMsg.Price,
+			expiration:  validMsg.Expiration,
+			expectedErr: "invalid owner address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "negative sell",
+			owner:       validMsg.Owner,
+			sell:        sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(-1)},
+			buyDenom:    validMsg.BuyDenom,
+			price:       validMsg.Price,
+			expiration:  validMsg.Expiration,
+			expectedErr: "sell amount -1ukava: invalid coins",
+		},
+		{
+			name:        "zero sell",
+			owner:       validMsg.Owner,
+			sell:        sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(0)},
+			buyDenom:    validMsg.BuyDenom,
+			price:       validMsg.Price,
+			expiration:  validMsg.Expiration,
+			expectedErr: "sell amount 0ukava: invalid coins",
+		},
+		{
+			name:        "sell less than minimum",
+			owner:       validMsg.Owner,
+			sell:        sdk.NewCoin("ukava", sdkmath.NewInt(999)),
+			buyDenom:    validMsg.BuyDenom,
+			price:       validMsg.Price,
+			expiration:  validMsg.Expiration,
+			expectedErr: "sell amount 999 is less than minimum 1000: invalid limit order",
+		},
+		{
+			name:        "invalid buy denom",
+			owner:       validMsg.Owner,
+			sell:        validMsg.Sell,
+			buyDenom:    "",
+			price:       validMsg.Price,
+			expiration:  validMsg.Expiration,
+			expectedErr: "invalid denom: : invalid coins",
+		},
+		{
+			name:        "denoms can not be the same",
+			owner:       validMsg.Owner,
+			sell:        validMsg.Sell,
+			buyDenom:    "ukava",
+			price:       validMsg.Price,
+			expiration:  validMsg.Expiration,
+			expectedErr: "denominations can not be equal: invalid coins",
+		},
+		{
+			name:        "nil price",
+			owner:       validMsg.Owner,
+			sell:        validMsg.Sell,
+			buyDenom:    validMsg.BuyDenom,
+			price:       sdk.Dec{},
+			expiration:  validMsg.Expiration,
+			expectedErr: "price must be set: invalid limit order",
+		},
+		{
+			name:        "zero price",
+			owner:       validMsg.Owner,
+			sell:        validMsg.Sell,
+			buyDenom:    validMsg.BuyDenom,
+			price:       sdk.ZeroDec(),
+			expiration:  validMsg.Expiration,
+			expectedErr: "invalid price 0.000000000000000000: invalid limit order",
+		},
+		{
+			name:        "negative price",
+			owner:       validMsg.Owner,
+			sell:        validMsg.Sell,
+			buyDenom:    validMsg.BuyDenom,
+			price:       sdk.MustNewDecFromStr("-1"),
+			expiration:  validMsg.Expiration,
+			expectedErr: "invalid price -1.000000000000000000: invalid limit order",
+		},
+		{
+			name:        "zero expiration",
+			owner:       validMsg.Owner,
+			sell:        validMsg.Sell,
+			buyDenom:    validMsg.BuyDenom,
+			price:       validMsg.Price,
+			expiration:  0,
+			expectedErr: "invalid expiration 0: invalid limit order",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgPlaceLimitOrder(tc.owner, tc.sell, tc.buyDenom, tc.price, tc.expiration)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgCancelLimitOrder_Attributes(t *testing.T) {
+	msg := types.MsgCancelLimitOrder{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_cancel_limit_order", msg.Type())
+}
+
+func TestMsgCancelLimitOrder_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgCancelLimitOrder","value":{"id":"1","owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgCancelLimitOrder(addr.String(), 1)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgCancelLimitOrder_Validation(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	validMsg := types.NewMsgCancelLimitOrder(addr.String(), 1)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		owner       string
+		id          uint64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			owner:       "",
+			id:          validMsg.ID,
+			expectedErr: "owner address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			owner:       "kava1abcde",
+			id:          validMsg.ID,
+			expectedErr: "invalid owner address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "zero id",
+			owner:       validMsg.Owner,
+			id:          0,
+			expectedErr: "order id must be set: invalid limit order",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCancelLimitOrder(tc.owner, tc.id)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgSyncShares_Attributes(t *testing.T) {
+	msg := types.MsgSyncShares{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_sync_shares", msg.Type())
+}
+
+func TestMsgSyncShares_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgSyncShares","value":{"owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","pool_id":"ukava:usdx"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgSyncShares(addr.String(), "ukava:usdx")
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgSyncShares_Validation(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	validMsg := types.NewMsgSyncShares(addr.String(), "ukava:usdx")
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		owner       string
+		poolID      string
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			owner:       "",
+			poolID:      validMsg.PoolID,
+			expectedErr: "owner address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			owner:       "kava1abcde",
+			poolID:      validMsg.PoolID,
+			expectedErr: "invalid owner address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "empty pool id",
+			owner:       validMsg.Owner,
+			poolID:      "",
+			expectedErr: "poolID '' is invalid: invalid pool",
+		},
+		{
+			name:        "unsorted pool id",
+			owner:       validMsg.Owner,
+			poolID:      "usdx:ukava",
+			expectedErr: "poolID 'usdx:ukava' is invalid: invalid pool",
+		},
+		{
+			name:        "duplicate denom pool id",
+			owner:       validMsg.Owner,
+			poolID:      "ukava:ukava",
+			expectedErr: "poolID 'ukava:ukava' is invalid: invalid pool",
+		},
+		{
+			name:        "invalid denom pool id",
+			owner:       validMsg.Owner,
+			poolID:      "ukava:x",
+			expectedErr: "poolID 'ukava:x' is invalid: invalid pool",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSyncShares(tc.owner, tc.poolID)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -4,24 +4,38 @@ import (
 	"fmt"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter keys and default values
 var (
-	KeyAllowedPools     = []byte("AllowedPools")
-	KeySwapFee          = []byte("SwapFee")
-	DefaultAllowedPools = AllowedPools{}
-	DefaultSwapFee      = sdk.ZeroDec()
-	MaxSwapFee          = sdk.OneDec()
+	KeyAllowedPools                   = []byte("AllowedPools")
+	KeySwapFee                        = []byte("SwapFee")
+	KeyProtocolFee                    = []byte("ProtocolFee")
+	KeyPermissionlessPoolCreation     = []byte("PermissionlessPoolCreation")
+	KeyPoolCreationFee                = []byte("PoolCreationFee")
+	KeyMinInitialShares               = []byte("MinInitialShares")
+	DefaultAllowedPools               = AllowedPools{}
+	DefaultSwapFee                    = sdk.ZeroDec()
+	DefaultProtocolFee                = sdk.ZeroDec()
+	DefaultPermissionlessPoolCreation = false
+	DefaultPoolCreationFee            = sdk.Coins{}
+	DefaultMinInitialShares           = sdk.ZeroInt()
+	MaxSwapFee                        = sdk.OneDec()
+	MaxProtocolFee                    = sdk.OneDec()
 )
 
-// NewParams returns a new params object
+// NewParams returns a new params object without a protocol fee or permissionless pool creation
 func NewParams(pairs AllowedPools, swapFee sdk.Dec) Params {
 	return Params{
-		AllowedPools: pairs,
-		SwapFee:      swapFee,
+		AllowedPools:               pairs,
+		SwapFee:                    swapFee,
+		ProtocolFee:                DefaultProtocolFee,
+		PermissionlessPoolCreation: DefaultPermissionlessPoolCreation,
+		PoolCreationFee:            DefaultPoolCreationFee,
+		MinInitialShares:           DefaultMinInitialShares,
 	}
 }
 
@@ -37,8 +51,12 @@ func DefaultParams() Params {
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
 	AllowedPools: %s
-	SwapFee: %s`,
-		p.AllowedPools, p.SwapFee)
+	SwapFee: %s
+	ProtocolFee: %s
+	PermissionlessPoolCreation: %t
+	PoolCreationFee: %s
+	MinInitialShares: %s`,
+		p.AllowedPools, p.SwapFee, p.ProtocolFee, p.PermissionlessPoolCreation, p.PoolCreationFee, p.MinInitialShares)
 }
 
 // ParamKeyTable for swap module.
@@ -51,6 +69,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedPools, &p.AllowedPools, validateAllowedPoolsParams),
 		paramtypes.NewParamSetPair(KeySwapFee, &p.SwapFee, validateSwapFee),
+		paramtypes.NewParamSetPair(KeyProtocolFee, &p.ProtocolFee, validateProtocolFee),
+		paramtypes.NewParamSetPair(KeyPermissionlessPoolCreation, &p.PermissionlessPoolCreation, validatePermissionlessPoolCreation),
+		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyMinInitialShares, &p.MinInitialShares, validateMinInitialShares),
 	}
 }
 
@@ -60,7 +82,23 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateSwapFee(p.SwapFee)
+	if err := validateSwapFee(p.SwapFee); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFee(p.ProtocolFee); err != nil {
+		return err
+	}
+
+	if err := validatePermissionlessPoolCreation(p.PermissionlessPoolCreation); err != nil {
+		return err
+	}
+
+	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
+		return err
+	}
+
+	return validateMinInitialShares(p.MinInitialShares)
 }
 
 func validateAllowedPoolsParams(i interface{}) error {
@@ -85,11 +123,104 @@ func validateSwapFee(i interface{}) error {
 	return nil
 }
 
+func validateProtocolFee(i interface{}) error {
+	protocolFee, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if protocolFee.IsNil() || protocolFee.IsNegative() || protocolFee.GT(MaxProtocolFee) {
+		return fmt.Errorf("invalid protocol fee: %s", protocolFee)
+	}
+
+	return nil
+}
+
+func validatePermissionlessPoolCreation(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePoolCreationFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid pool creation fee: %s", err)
+	}
+
+	return nil
+}
+
+func validateMinInitialShares(i interface{}) error {
+	minShares, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if minShares.IsNil() || minShares.IsNegative() {
+		return fmt.Errorf("invalid min initial shares: %s", minShares)
+	}
+
+	return nil
+}
+
+// validatePoolSwapFee validates the fee tier of a pool, where zero uses the global swap fee
+func validatePoolSwapFee(swapFee sdk.Dec) error {
+	if swapFee.IsNil() {
+		return nil
+	}
+
+	if swapFee.IsNegative() || swapFee.GTE(MaxSwapFee) {
+		return fmt.Errorf("invalid swap fee: %s", swapFee)
+	}
+
+	return nil
+}
+
 // NewAllowedPool returns a new AllowedPool object
 func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 	return AllowedPool{
-		TokenA: tokenA,
-		TokenB: tokenB,
+		TokenA:  tokenA,
+		TokenB:  tokenB,
+		SwapFee: sdk.ZeroDec(),
+	}
+}
+
+// WithSwapFee returns a copy of the allowed pool with the provided fee tier.
+// A zero fee tier uses the global swap fee.
+func (p AllowedPool) WithSwapFee(swapFee sdk.Dec) AllowedPool {
+	p.SwapFee = swapFee
+	return p
+}
+
+// NewStableSwapAllowedPool returns a new AllowedPool object for a stableswap pool
+// with the provided amplification coefficient
+func NewStableSwapAllowedPool(tokenA, tokenB string, amplification uint64) AllowedPool {
+	return AllowedPool{
+		TokenA:        tokenA,
+		TokenB:        tokenB,
+		PoolType:      POOL_TYPE_STABLESWAP,
+		Amplification: amplification,
+		SwapFee:       sdk.ZeroDec(),
+	}
+}
+
+// NewWeightedAllowedPool returns a new AllowedPool object for a weighted pool
+// with the provided percentage weights of token a and token b
+func NewWeightedAllowedPool(tokenA, tokenB string, weightA, weightB uint64) AllowedPool {
+	return AllowedPool{
+		TokenA:   tokenA,
+		TokenB:   tokenB,
+		PoolType: POOL_TYPE_WEIGHTED,
+		WeightA:  weightA,
+		WeightB:  weightB,
+		SwapFee:  sdk.ZeroDec(),
 	}
 }
 
@@ -129,7 +260,11 @@ func (p AllowedPool) Validate() error {
 		)
 	}
 
-	return nil
+	if err := validatePoolType(p.PoolType, p.Amplification, p.WeightA, p.WeightB); err != nil {
+		return err
+	}
+
+	return validatePoolSwapFee(p.SwapFee)
 }
 
 // Name returns the name for the allowed pool
@@ -143,7 +278,12 @@ func (p AllowedPool) String() string {
   Name: %s
 	Token A: %s
 	Token B: %s
-`, p.Name(), p.TokenA, p.TokenB)
+	Pool Type: %s
+	Amplification: %d
+	Weight A: %d
+	Weight B: %d
+	Swap Fee: %s
+`, p.Name(), p.TokenA, p.TokenB, p.PoolType, p.Amplification, p.WeightA, p.WeightB, p.SwapFee)
 }
 
 // AllowedPools is a slice of AllowedPool
@@ -171,3 +311,25 @@ func (p AllowedPools) Validate() error {
 
 	return nil
 }
+
+// validatePoolType validates a pool type and the parameters required by it
+func validatePoolType(poolType PoolType, amplification, weightA, weightB uint64) error {
+	if poolType != POOL_TYPE_STABLESWAP && amplification != 0 {
+		return fmt.Errorf("amplification must be zero for pool type %s", poolType)
+	}
+
+	if poolType != POOL_TYPE_WEIGHTED && (weightA != 0 || weightB != 0) {
+		return fmt.Errorf("weights must be zero for pool type %s", poolType)
+	}
+
+	switch poolType {
+	case POOL_TYPE_CONSTANT_PRODUCT:
+		return nil
+	case POOL_TYPE_STABLESWAP:
+		return validateAmplification(amplification)
+	case POOL_TYPE_WEIGHTED:
+		return validateWeights(weightA, weightB)
+	default:
+		return fmt.Errorf("invalid pool type %s", poolType)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/kava-labs/kava/x/swap/types"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
@@ -52,8 +53,10 @@ func TestParams_MarshalYAML(t *testing.T) {
 	require.NoError(t, err)
 
 	p := types.Params{
-		AllowedPools: pools,
-		SwapFee:      fee,
+		AllowedPools:     pools,
+		SwapFee:          fee,
+		ProtocolFee:      sdk.ZeroDec(),
+		MinInitialShares: sdk.ZeroInt(),
 	}
 
 	data, err := yaml.Marshal(p)
@@ -79,6 +82,9 @@ func TestParams_Default(t *testing.T) {
 
 	assert.Equal(t, 0, len(defaultParams.AllowedPools))
 	assert.Equal(t, sdk.ZeroDec(), defaultParams.SwapFee)
+	assert.False(t, defaultParams.PermissionlessPoolCreation)
+	assert.True(t, defaultParams.PoolCreationFee.IsZero())
+	assert.Equal(t, sdk.ZeroInt(), defaultParams.MinInitialShares)
 }
 
 func TestParams_ParamSetPairs_AllowedPools(t *testing.T) {
@@ -178,6 +184,94 @@ func TestParams_Validation(t *testing.T) {
 			},
 			expectedErr: "invalid swap fee: 1.000000000000000000",
 		},
+		{
+			name: "nil protocol fee",
+			key:  types.KeyProtocolFee,
+			testFn: func(params *types.Params) {
+				params.ProtocolFee = sdk.Dec{}
+			},
+			expectedErr: "invalid protocol fee: <nil>",
+		},
+		{
+			name: "negative protocol fee",
+			key:  types.KeyProtocolFee,
+			testFn: func(params *types.Params) {
+				params.ProtocolFee = sdk.NewDec(-1)
+			},
+			expectedErr: "invalid protocol fee: -1.000000000000000000",
+		},
+		{
+			name: "protocol fee greater than 1",
+			key:  types.KeyProtocolFee,
+			testFn: func(params *types.Params) {
+				params.ProtocolFee = sdk.MustNewDecFromStr("1.000000000000000001")
+			},
+			expectedErr: "invalid protocol fee: 1.000000000000000001",
+		},
+		{
+			name: "1 protocol fee",
+			key:  types.KeyProtocolFee,
+			testFn: func(params *types.Params) {
+				params.ProtocolFee = sdk.OneDec()
+			},
+			expectedErr: "",
+		},
+		{
+			name: "permissionless pool creation enabled",
+			key:  types.KeyPermissionlessPoolCreation,
+			testFn: func(params *types.Params) {
+				params.PermissionlessPoolCreation = true
+			},
+			expectedErr: "",
+		},
+		{
+			name: "valid pool creation fee",
+			key:  types.KeyPoolCreationFee,
+			testFn: func(params *types.Params) {
+				params.PoolCreationFee = sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)))
+			},
+			expectedErr: "",
+		},
+		{
+			name: "zero pool creation fee coin",
+			key:  types.KeyPoolCreationFee,
+			testFn: func(params *types.Params) {
+				params.PoolCreationFee = sdk.Coins{sdk.Coin{Denom: "ukava", Amount: sdk.ZeroInt()}}
+			},
+			expectedErr: "invalid pool creation fee: coin 0ukava amount is not positive",
+		},
+		{
+			name: "unsorted pool creation fee",
+			key:  types.KeyPoolCreationFee,
+			testFn: func(params *types.Params) {
+				params.PoolCreationFee = sdk.Coins{sdk.NewCoin("usdx", sdkmath.NewInt(1)), sdk.NewCoin("ukava", sdkmath.NewInt(1))}
+			},
+			expectedErr: "invalid pool creation fee: denomination ukava is not sorted",
+		},
+		{
+			name: "nil min initial shares",
+			key:  types.KeyMinInitialShares,
+			testFn: func(params *types.Params) {
+				params.MinInitialShares = sdkmath.Int{}
+			},
+			expectedErr: "invalid min initial shares: <nil>",
+		},
+		{
+			name: "negative min initial shares",
+			key:  types.KeyMinInitialShares,
+			testFn: func(params *types.Params) {
+				params.MinInitialShares = sdkmath.NewInt(-1)
+			},
+			expectedErr: "invalid min initial shares: -1",
+		},
+		{
+			name: "positive min initial shares",
+			key:  types.KeyMinInitialShares,
+			testFn: func(params *types.Params) {
+				params.MinInitialShares = sdkmath.NewInt(1e6)
+			},
+			expectedErr: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -277,6 +371,66 @@ func TestAllowedPool_Validation(t *testing.T) {
 			allowedPool: types.NewAllowedPool("ukava", "u:kava"),
 			expectedErr: "tokenB cannot have colons in the denom: u:kava",
 		},
+		{
+			name:        "constant product with amplification",
+			allowedPool: types.AllowedPool{TokenA: "ukava", TokenB: "usdx", Amplification: 100},
+			expectedErr: "amplification must be zero for pool type POOL_TYPE_CONSTANT_PRODUCT",
+		},
+		{
+			name:        "stableswap with zero amplification",
+			allowedPool: types.NewStableSwapAllowedPool("usdc", "usdx", 0),
+			expectedErr: "amplification 0 must be between 1 and 1000000: invalid pool",
+		},
+		{
+			name:        "stableswap with amplification above max",
+			allowedPool: types.NewStableSwapAllowedPool("usdc", "usdx", 1_000_001),
+			expectedErr: "amplification 1000001 must be between 1 and 1000000: invalid pool",
+		},
+		{
+			name:        "constant product with weights",
+			allowedPool: types.AllowedPool{TokenA: "ukava", TokenB: "usdx", WeightA: 50, WeightB: 50},
+			expectedErr: "weights must be zero for pool type POOL_TYPE_CONSTANT_PRODUCT",
+		},
+		{
+			name:        "stableswap with weights",
+			allowedPool: types.AllowedPool{TokenA: "usdc", TokenB: "usdx", PoolType: types.POOL_TYPE_STABLESWAP, Amplification: 100, WeightA: 50, WeightB: 50},
+			expectedErr: "weights must be zero for pool type POOL_TYPE_STABLESWAP",
+		},
+		{
+			name:        "weighted with amplification",
+			allowedPool: types.AllowedPool{TokenA: "ukava", TokenB: "usdx", PoolType: types.POOL_TYPE_WEIGHTED, Amplification: 100, WeightA: 80, WeightB: 20},
+			expectedErr: "amplification must be zero for pool type POOL_TYPE_WEIGHTED",
+		},
+		{
+			name:        "weighted with zero weights",
+			allowedPool: types.NewWeightedAllowedPool("ukava", "usdx", 0, 0),
+			expectedErr: "weights 0 and 0 must be at least 2: invalid pool",
+		},
+		{
+			name:        "weighted with weight below min",
+			allowedPool: types.NewWeightedAllowedPool("ukava", "usdx", 99, 1),
+			expectedErr: "weights 99 and 1 must be at least 2: invalid pool",
+		},
+		{
+			name:        "weighted with weights not summing to total",
+			allowedPool: types.NewWeightedAllowedPool("ukava", "usdx", 80, 30),
+			expectedErr: "weights 80 and 30 must sum to 100: invalid pool",
+		},
+		{
+			name:        "unknown pool type",
+			allowedPool: types.AllowedPool{TokenA: "ukava", TokenB: "usdx", PoolType: 99},
+			expectedErr: "invalid pool type 99",
+		},
+		{
+			name:        "negative swap fee",
+			allowedPool: types.NewAllowedPool("ukava", "usdx").WithSwapFee(sdk.NewDec(-1)),
+			expectedErr: "invalid swap fee: -1.000000000000000000",
+		},
+		{
+			name:        "swap fee of 1",
+			allowedPool: types.NewAllowedPool("ukava", "usdx").WithSwapFee(sdk.OneDec()),
+			expectedErr: "invalid swap fee: 1.000000000000000000",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -287,6 +441,36 @@ func TestAllowedPool_Validation(t *testing.T) {
 	}
 }
 
+func TestAllowedPool_SwapFee(t *testing.T) {
+	pool := types.NewAllowedPool("ukava", "usdx")
+	assert.True(t, pool.SwapFee.IsZero())
+
+	pool = pool.WithSwapFee(sdk.MustNewDecFromStr("0.01"))
+	require.NoError(t, pool.Validate())
+	assert.Equal(t, sdk.MustNewDecFromStr("0.01"), pool.SwapFee)
+
+	// a pool without a fee tier is valid and uses the global swap fee
+	require.NoError(t, types.AllowedPool{TokenA: "ukava", TokenB: "usdx"}.Validate())
+}
+
+func TestAllowedPool_StableSwap(t *testing.T) {
+	allowedPool := types.NewStableSwapAllowedPool("usdc", "usdx", 100)
+	require.NoError(t, allowedPool.Validate())
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, allowedPool.PoolType)
+	assert.Equal(t, uint64(100), allowedPool.Amplification)
+	assert.Equal(t, "usdc:usdx", allowedPool.Name())
+}
+
+func TestAllowedPool_Weighted(t *testing.T) {
+	allowedPool := types.NewWeightedAllowedPool("ukava", "usdx", 80, 20)
+	require.NoError(t, allowedPool.Validate())
+	assert.Equal(t, types.POOL_TYPE_WEIGHTED, allowedPool.PoolType)
+	assert.Equal(t, uint64(80), allowedPool.WeightA)
+	assert.Equal(t, uint64(20), allowedPool.WeightB)
+	assert.Equal(t, uint64(0), allowedPool.Amplification)
+	assert.Equal(t, "ukava:usdx", allowedPool.Name())
+}
+
 func TestAllowedPool_TokenMatch_CaseSensitive(t *testing.T) {
 	allowedPool := types.NewAllowedPool("UKAVA", "ukava")
 	err := allowedPool.Validate()
@@ -309,6 +493,11 @@ func TestAllowedPool_String(t *testing.T) {
   Name: hard:ukava
 	Token A: hard
 	Token B: ukava
+	Pool Type: POOL_TYPE_CONSTANT_PRODUCT
+	Amplification: 0
+	Weight A: 0
+	Weight B: 0
+	Swap Fee: 0.000000000000000000
 `
 	assert.Equal(t, output, allowedPool.String())
 }
@@ -0,0 +1,331 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinAmplification is the minimum amplification coefficient of a stableswap pool
+	MinAmplification uint64 = 1
+	// MaxAmplification is the maximum amplification coefficient of a stableswap pool
+	MaxAmplification uint64 = 1_000_000
+
+	// stableSwapMaxIterations bounds the newton iterations used to solve the stableswap invariant
+	stableSwapMaxIterations = 255
+)
+
+// StableSwapPool implements a unitless two asset stableswap liquidity pool.
+//
+// Swaps are priced with the amplified stableswap invariant
+//
+//	A*n^n*(x+y) + D = A*D*n^n + D^(n+1)/(n^n*x*y)
+//
+// for n = 2, which behaves like a constant-sum pool when reserves are balanced
+// and like a constant-product pool as reserves become imbalanced. A higher
+// amplification coefficient A results in lower price impact around the peg.
+//
+// Liquidity is added and removed in the ratio of the pool reserves, so deposits,
+// withdrawals, and share values are shared with the constant-product BasePool.
+type StableSwapPool struct {
+	*BasePool
+	amplification uint64
+}
+
+// NewStableSwapPool returns a pointer to a stableswap pool with reserves and total shares initialized
+func NewStableSwapPool(reservesA, reservesB sdkmath.Int, amplification uint64) (*StableSwapPool, error) {
+	if err := validateAmplification(amplification); err != nil {
+		return nil, err
+	}
+
+	pool, err := NewBasePool(reservesA, reservesB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StableSwapPool{
+		BasePool:      pool,
+		amplification: amplification,
+	}, nil
+}
+
+// NewStableSwapPoolWithExistingShares returns a pointer to a stableswap pool with existing shares
+func NewStableSwapPoolWithExistingShares(reservesA, reservesB, totalShares sdkmath.Int, amplification uint64) (*StableSwapPool, error) {
+	if err := validateAmplification(amplification); err != nil {
+		return nil, err
+	}
+
+	pool, err := NewBasePoolWithExistingShares(reservesA, reservesB, totalShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StableSwapPool{
+		BasePool:      pool,
+		amplification: amplification,
+	}, nil
+}
+
+// Amplification returns the amplification coefficient of the pool
+func (p *StableSwapPool) Amplification() uint64 {
+	return p.amplification
+}
+
+// Invariant returns the stableswap invariant D of the current reserves
+func (p *StableSwapPool) Invariant() sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(p.computeD(p.reservesA.BigInt(), p.reservesB.BigInt()))
+}
+
+// SpotPriceA returns the spot price of A in units of B, the ratio of the partial derivatives
+// of the invariant with respect to A and B
+func (p *StableSwapPool) SpotPriceA() sdk.Dec {
+	return p.spotPrice(p.reservesA.BigInt(), p.reservesB.BigInt())
+}
+
+// SpotPriceB returns the spot price of B in units of A, the ratio of the partial derivatives
+// of the invariant with respect to B and A
+func (p *StableSwapPool) SpotPriceB() sdk.Dec {
+	return p.spotPrice(p.reservesB.BigInt(), p.reservesA.BigInt())
+}
+
+// SwapExactAForB trades an exact value of a for b.  Returns the positive amount b
+// that is removed from the pool and the portion of a that is used for paying the fee.
+func (p *StableSwapPool) SwapExactAForB(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	b, feeValue := p.calculateOutputForExactInput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return b, feeValue
+}
+
+// SwapExactBForA trades an exact value of b for a.  Returns the positive amount a
+// that is removed from the pool and the portion of b that is used for paying the fee.
+func (p *StableSwapPool) SwapExactBForA(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	a, feeValue := p.calculateOutputForExactInput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return a, feeValue
+}
+
+// SwapAForExactB trades a for an exact b.  Returns the positive amount a
+// that is added to the pool, and the portion of a that is used to pay the fee.
+func (p *StableSwapPool) SwapAForExactB(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	a, feeValue := p.calculateInputForExactOutput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return a, feeValue
+}
+
+// SwapBForExactA trades b for an exact a.  Returns the positive amount b
+// that is added to the pool, and the portion of b that is used to pay the fee.
+func (p *StableSwapPool) SwapBForExactA(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	b, feeValue := p.calculateInputForExactOutput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return b, feeValue
+}
+
+// calculateOutputForExactInput calculates the output amount of a swap using a fixed input, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The fee is ceiled in the same way as the BasePool.  The new output reserves are rounded up until the invariant
+// of the new reserves is greater than or equal to the previous invariant, ensuring the output is always truncated.
+func (p *StableSwapPool) calculateOutputForExactInput(in, inReserves, outReserves sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapInputIsValid(in)
+	p.assertFeeIsValid(fee)
+
+	inAfterFee := sdk.NewDecFromInt(in).Mul(sdk.OneDec().Sub(fee)).TruncateInt()
+	feeValue := in.Sub(inAfterFee)
+
+	d := p.computeD(inReserves.BigInt(), outReserves.BigInt())
+	newInReserves := inReserves.Add(inAfterFee).BigInt()
+	newOutReserves := p.roundUpToInvariant(newInReserves, p.computeY(newInReserves, d), d)
+
+	// an input too small to move the invariant results in no output
+	if newOutReserves.Cmp(outReserves.BigInt()) >= 0 {
+		return sdk.ZeroInt(), feeValue
+	}
+
+	out := outReserves.Sub(sdkmath.NewIntFromBigInt(newOutReserves))
+
+	return out, feeValue
+}
+
+// calculateInputForExactOutput calculates the input amount of a swap using a fixed output, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The fee is ceiled in the same way as the BasePool.  The new input reserves are rounded up until the invariant
+// of the new reserves is greater than or equal to the previous invariant, ensuring the input is always ceiled.
+func (p *StableSwapPool) calculateInputForExactOutput(out, outReserves, inReserves sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapOutputIsValid(out, outReserves)
+	p.assertFeeIsValid(fee)
+
+	d := p.computeD(inReserves.BigInt(), outReserves.BigInt())
+	newOutReserves := outReserves.Sub(out).BigInt()
+	newInReserves := p.roundUpToInvariant(newOutReserves, p.computeY(newOutReserves, d), d)
+
+	inWithoutFee := sdkmath.NewIntFromBigInt(newInReserves).Sub(inReserves)
+	if !inWithoutFee.IsPositive() {
+		inWithoutFee = sdk.OneInt()
+	}
+
+	in := sdk.NewDecFromInt(inWithoutFee).Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt()
+	feeValue := in.Sub(inWithoutFee)
+
+	return in, feeValue
+}
+
+// assertInvariantAndUpdateReserves asserts the stableswap invariant is not violated, subtracting
+// any fees first, then updates the pool reserves.  Panics if invariant is violated.
+func (p *StableSwapPool) assertInvariantAndUpdateReserves(newReservesA, feeA, newReservesB, feeB sdkmath.Int) {
+	invariant := p.computeD(p.reservesA.BigInt(), p.reservesB.BigInt())
+	newInvariant := p.computeD(newReservesA.Sub(feeA).BigInt(), newReservesB.Sub(feeB).BigInt())
+
+	p.assertInvariant(invariant, newInvariant)
+
+	p.reservesA = newReservesA
+	p.reservesB = newReservesB
+}
+
+// spotPrice returns the spot price of x in units of y
+//
+//	price = (Ann*4*x^2*y + D^3) * y / ((Ann*4*x*y^2 + D^3) * x)
+func (p *StableSwapPool) spotPrice(x, y *big.Int) sdk.Dec {
+	d := p.computeD(x, y)
+	dCubed := new(big.Int).Exp(d, big.NewInt(3), nil)
+	annFour := new(big.Int).Mul(p.ann(), big.NewInt(4))
+
+	numerator := new(big.Int).Mul(annFour, x)
+	numerator.Mul(numerator, x).Mul(numerator, y).Add(numerator, dCubed).Mul(numerator, y)
+
+	denominator := new(big.Int).Mul(annFour, x)
+	denominator.Mul(denominator, y).Mul(denominator, y).Add(denominator, dCubed).Mul(denominator, x)
+
+	// scale the numerator by the decimal precision before dividing to retain the fractional part
+	numerator.Mul(numerator, new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil))
+	return sdk.NewDecFromBigIntWithPrec(numerator.Quo(numerator, denominator), sdk.Precision)
+}
+
+// ann returns the amplification coefficient multiplied by n^n
+func (p *StableSwapPool) ann() *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(p.amplification), big.NewInt(4))
+}
+
+// computeD solves the stableswap invariant D for reserves x and y using newton's method
+//
+//	D' = (Ann*S + 2*D_P) * D / ((Ann - 1) * D + 3*D_P), where D_P = D^3 / (4*x*y)
+func (p *StableSwapPool) computeD(x, y *big.Int) *big.Int {
+	sum := new(big.Int).Add(x, y)
+	if sum.Sign() == 0 {
+		return sum
+	}
+
+	ann := p.ann()
+	annSum := new(big.Int).Mul(ann, sum)
+	annMinusOne := new(big.Int).Sub(ann, big.NewInt(1))
+	twoX := new(big.Int).Mul(x, big.NewInt(2))
+	twoY := new(big.Int).Mul(y, big.NewInt(2))
+
+	d := new(big.Int).Set(sum)
+	for i := 0; i < stableSwapMaxIterations; i++ {
+		dP := new(big.Int).Set(d)
+		dP.Mul(dP, d).Quo(dP, twoX)
+		dP.Mul(dP, d).Quo(dP, twoY)
+
+		numerator := new(big.Int).Mul(dP, big.NewInt(2))
+		numerator.Add(numerator, annSum).Mul(numerator, d)
+
+		denominator := new(big.Int).Mul(annMinusOne, d)
+		denominator.Add(denominator, new(big.Int).Mul(dP, big.NewInt(3)))
+
+		prevD := d
+		d = numerator.Quo(numerator, denominator)
+
+		if withinOne(d, prevD) {
+			return d
+		}
+	}
+
+	panic(fmt.Sprintf("invalid state: stableswap invariant did not converge for reserves %s, %s", x, y))
+}
+
+// computeY solves the stableswap invariant for the reserves y paired with reserves x at invariant d
+// using newton's method
+//
+//	y' = (y^2 + c) / (2*y + b - D), where c = D^3 / (4*x*Ann) and b = x + D/Ann
+func (p *StableSwapPool) computeY(x, d *big.Int) *big.Int {
+	ann := p.ann()
+
+	c := new(big.Int).Set(d)
+	c.Mul(c, d).Quo(c, new(big.Int).Mul(x, big.NewInt(2)))
+	c.Mul(c, d).Quo(c, new(big.Int).Mul(ann, big.NewInt(2)))
+
+	b := new(big.Int).Quo(d, ann)
+	b.Add(b, x)
+
+	y := new(big.Int).Set(d)
+	for i := 0; i < stableSwapMaxIterations; i++ {
+		numerator := new(big.Int).Mul(y, y)
+		numerator.Add(numerator, c)
+
+		denominator := new(big.Int).Mul(y, big.NewInt(2))
+		denominator.Add(denominator, b).Sub(denominator, d)
+
+		prevY := y
+		y = numerator.Quo(numerator, denominator)
+
+		if withinOne(y, prevY) {
+			return y
+		}
+	}
+
+	panic(fmt.Sprintf("invalid state: stableswap reserves did not converge for reserves %s and invariant %s", x, d))
+}
+
+// roundUpToInvariant increments the reserves y paired with reserves x until the invariant of
+// the reserves is greater than or equal to d.  This corrects for rounding in computeY, which
+// is accurate to within one unit.
+func (p *StableSwapPool) roundUpToInvariant(x, y, d *big.Int) *big.Int {
+	y = new(big.Int).Set(y)
+	for i := 0; i < stableSwapMaxIterations; i++ {
+		if p.computeD(x, y).Cmp(d) >= 0 {
+			return y
+		}
+		y.Add(y, big.NewInt(1))
+	}
+
+	panic(fmt.Sprintf("invalid state: stableswap reserves %s can not reach invariant %s", y, d))
+}
+
+// withinOne returns true if a and b differ by at most one
+func withinOne(a, b *big.Int) bool {
+	diff := new(big.Int).Sub(a, b)
+	return diff.CmpAbs(big.NewInt(1)) <= 0
+}
+
+// validateAmplification returns an error if the amplification coefficient is out of bounds
+func validateAmplification(amplification uint64) error {
+	if amplification < MinAmplification || amplification > MaxAmplification {
+		return errorsmod.Wrapf(
+			ErrInvalidPool,
+			"amplification %d must be between %d and %d", amplification, MinAmplification, MaxAmplification,
+		)
+	}
+
+	return nil
+}
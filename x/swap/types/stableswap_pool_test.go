@@ -0,0 +1,207 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	types "github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStableSwapPool_NewPool_Validation(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		expectedErr   string
+	}{
+		{i(1e6), i(1e6), 0, "amplification 0 must be between 1 and 1000000: invalid pool"},
+		{i(1e6), i(1e6), 1_000_001, "amplification 1000001 must be between 1 and 1000000: invalid pool"},
+		{i(0), i(1e6), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(-1), 100, "reserves must be greater than zero: invalid pool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d", tc.reservesA, tc.reservesB, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+
+			pool, err = types.NewStableSwapPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+		})
+	}
+}
+
+func TestStableSwapPool_InitialState(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(4e6), 100)
+	require.NoError(t, err)
+
+	// liquidity math is shared with the base pool
+	assert.Equal(t, i(1e6), pool.ReservesA())
+	assert.Equal(t, i(4e6), pool.ReservesB())
+	assert.Equal(t, i(2e6), pool.TotalShares())
+	assert.Equal(t, uint64(100), pool.Amplification())
+
+	// balanced reserves have an invariant equal to the sum of reserves
+	balancedPool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+	assert.Equal(t, i(2e6), balancedPool.Invariant())
+}
+
+func TestStableSwapPool_Swap_LowerPriceImpactThanConstantProduct(t *testing.T) {
+	basePool, err := types.NewBasePool(i(1000e6), i(1000e6))
+	require.NoError(t, err)
+	stablePool, err := types.NewStableSwapPool(i(1000e6), i(1000e6), 100)
+	require.NoError(t, err)
+
+	baseOutput, _ := basePool.SwapExactAForB(i(100e6), d("0"))
+	stableOutput, _ := stablePool.SwapExactAForB(i(100e6), d("0"))
+
+	assert.Equal(t, i(90909090), baseOutput)
+	assert.Equal(t, i(99949776), stableOutput)
+	assert.True(t, stableOutput.GT(baseOutput), "expected stableswap output to be greater than constant product output")
+	assert.True(t, stableOutput.LT(i(100e6)), "expected stableswap output to be less than input")
+}
+
+func TestStableSwapPool_Swap_ExactInput(t *testing.T) {
+	testCases := []struct {
+		reservesA      sdkmath.Int
+		reservesB      sdkmath.Int
+		amplification  uint64
+		exactInput     sdkmath.Int
+		fee            sdk.Dec
+		expectedOutput sdkmath.Int
+		expectedFee    sdkmath.Int
+	}{
+		{i(1000e6), i(1000e6), 100, i(1e6), d("0.003"), i(996995), i(3000)},
+		{i(1000e6), i(1000e6), 1, i(1e6), d("0.003"), i(996668), i(3000)},
+		{i(1000e6), i(500e6), 100, i(1e6), d("0.003"), i(992821), i(3000)},
+		{i(1000e6), i(1000e6), 100, i(1), d("0.003"), i(0), i(1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d exactInput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactInput, tc.fee), func(t *testing.T) {
+			pool, err := types.NewStableSwapPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.amplification)
+			require.NoError(t, err)
+
+			output, feePaid := pool.SwapExactAForB(tc.exactInput, tc.fee)
+			assert.Equal(t, tc.expectedOutput, output)
+			assert.Equal(t, tc.expectedFee, feePaid)
+			assert.Equal(t, tc.reservesA.Add(tc.exactInput), pool.ReservesA())
+			assert.Equal(t, tc.reservesB.Sub(output), pool.ReservesB())
+
+			// the pool is symmetric
+			pool, err = types.NewStableSwapPoolWithExistingShares(tc.reservesB, tc.reservesA, i(1e6), tc.amplification)
+			require.NoError(t, err)
+
+			output, feePaid = pool.SwapExactBForA(tc.exactInput, tc.fee)
+			assert.Equal(t, tc.expectedOutput, output)
+			assert.Equal(t, tc.expectedFee, feePaid)
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_ExactOutput(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		exactOutput   sdkmath.Int
+		fee           sdk.Dec
+		expectedInput sdkmath.Int
+		expectedFee   sdkmath.Int
+	}{
+		{i(1000e6), i(1000e6), 100, i(1e6), d("0.003"), i(1003015), i(3010)},
+		{i(1000e6), i(1000e6), 1, i(1e6), d("0.003"), i(1003345), i(3011)},
+		{i(1000e6), i(500e6), 100, i(1e6), d("0.003"), i(1007232), i(3022)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d exactOutput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactOutput, tc.fee), func(t *testing.T) {
+			pool, err := types.NewStableSwapPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.amplification)
+			require.NoError(t, err)
+
+			input, feePaid := pool.SwapAForExactB(tc.exactOutput, tc.fee)
+			assert.Equal(t, tc.expectedInput, input)
+			assert.Equal(t, tc.expectedFee, feePaid)
+			assert.Equal(t, tc.reservesA.Add(input), pool.ReservesA())
+			assert.Equal(t, tc.reservesB.Sub(tc.exactOutput), pool.ReservesB())
+
+			// the calculated input must return at least the exact output when swapped as an exact input
+			pool, err = types.NewStableSwapPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.amplification)
+			require.NoError(t, err)
+
+			output, _ := pool.SwapExactAForB(input, tc.fee)
+			assert.True(t, output.GTE(tc.exactOutput), "expected output %s >= %s", output, tc.exactOutput)
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_InvariantNeverDecreases(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1000e6), i(1000e6), 200)
+	require.NoError(t, err)
+
+	inputs := []sdkmath.Int{i(1), i(7), i(1234), i(1e6), i(3e8), i(999e6), i(5e9)}
+	for n, input := range inputs {
+		for _, fee := range []sdk.Dec{d("0"), d("0.003")} {
+			invariant := pool.Invariant()
+			if n%2 == 0 {
+				pool.SwapExactAForB(input, fee)
+			} else {
+				pool.SwapExactBForA(input, fee)
+			}
+			assert.True(t, pool.Invariant().GTE(invariant), "expected invariant %s >= %s", pool.Invariant(), invariant)
+			assert.True(t, pool.ReservesA().IsPositive())
+			assert.True(t, pool.ReservesB().IsPositive())
+
+			invariant = pool.Invariant()
+			output := pool.ReservesB().QuoRaw(3)
+			if n%2 == 0 {
+				pool.SwapBForExactA(pool.ReservesA().QuoRaw(3), fee)
+			} else {
+				pool.SwapAForExactB(output, fee)
+			}
+			assert.True(t, pool.Invariant().GTE(invariant), "expected invariant %s >= %s", pool.Invariant(), invariant)
+		}
+	}
+}
+
+func TestStableSwapPool_Panics_Swap(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+
+	assert.PanicsWithValue(t, "invalid value: swap input must be positive", func() {
+		pool.SwapExactAForB(i(0), d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid value: swap output must be less than reserves", func() {
+		pool.SwapAForExactB(i(1e6), d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid value: fee must be between 0 and 1", func() {
+		pool.SwapExactBForA(i(1e3), d("1"))
+	})
+}
+
+func TestStableSwapPool_SpotPrice(t *testing.T) {
+	balancedPool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.OneDec(), balancedPool.SpotPriceA())
+	assert.Equal(t, sdk.OneDec(), balancedPool.SpotPriceB())
+
+	// prices stay closer to one than a constant product pool with the same reserves
+	pool, err := types.NewStableSwapPool(i(1e6), i(4e6), 100)
+	require.NoError(t, err)
+	basePool, err := types.NewBasePool(i(1e6), i(4e6))
+	require.NoError(t, err)
+
+	assert.True(t, pool.SpotPriceA().GT(sdk.OneDec()))
+	assert.True(t, pool.SpotPriceA().LT(basePool.SpotPriceA()))
+	assert.True(t, pool.SpotPriceB().LT(sdk.OneDec()))
+	assert.True(t, pool.SpotPriceB().GT(basePool.SpotPriceB()))
+	assert.InDelta(t, 1, pool.SpotPriceA().Mul(pool.SpotPriceB()).MustFloat64(), 1e-12)
+}
@@ -1,17 +1,45 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MarshalJSON marshals the pool type as its name, matching the proto json encoding
+// so legacy amino json and proto json are equal.
+func (t PoolType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON unmarshals a pool type from its name
+func (t *PoolType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	value, found := PoolType_value[name]
+	if !found {
+		return fmt.Errorf("invalid pool type %s", name)
+	}
+
+	*t = PoolType(value)
+	return nil
+}
+
 // PoolIDSep represents the separator used in pool ids to separate two denominations
 const PoolIDSep = ":"
 
+// ShareDenomPrefix is the prefix of the bank denom minted for the shares of a pool
+const ShareDenomPrefix = "swp-lp"
+
 // PoolIDFromCoins returns a poolID from a coins object
 func PoolIDFromCoins(coins sdk.Coins) string {
 	return PoolID(coins[0].Denom, coins[1].Denom)
@@ -27,6 +55,13 @@ func PoolID(denomA string, denomB string) string {
 	return fmt.Sprintf("%s%s%s", denomA, PoolIDSep, denomB)
 }
 
+// ShareDenom returns the bank denom of the share token for a pool. The denom holds the hex encoded sha256 hash
+// of the pool id instead of the id itself, so it stays within the max denom length for pools of long denoms
+// such as ibc denoms.
+func ShareDenom(poolID string) string {
+	return fmt.Sprintf("%s/%X", ShareDenomPrefix, sha256.Sum256([]byte(poolID)))
+}
+
 // NewPoolRecord takes reserve coins and total shares, returning
 // a new pool record with a id
 func NewPoolRecord(reserves sdk.Coins, totalShares sdkmath.Int) PoolRecord {
@@ -41,6 +76,7 @@ func NewPoolRecord(reserves sdk.Coins, totalShares sdkmath.Int) PoolRecord {
 		ReservesA:   reserves[0],
 		ReservesB:   reserves[1],
 		TotalShares: totalShares,
+		SwapFee:     sdk.ZeroDec(),
 	}
 }
 
@@ -51,10 +87,15 @@ func NewPoolRecordFromPool(pool *DenominatedPool) PoolRecord {
 	poolID := PoolIDFromCoins(reserves)
 
 	return PoolRecord{
-		PoolID:      poolID,
-		ReservesA:   reserves[0],
-		ReservesB:   reserves[1],
-		TotalShares: pool.TotalShares(),
+		PoolID:        poolID,
+		ReservesA:     reserves[0],
+		ReservesB:     reserves[1],
+		TotalShares:   pool.TotalShares(),
+		PoolType:      pool.PoolType(),
+		Amplification: pool.Amplification(),
+		WeightA:       pool.WeightA(),
+		WeightB:       pool.WeightB(),
+		SwapFee:       pool.SwapFee(),
 	}
 }
 
@@ -87,6 +128,14 @@ func (p PoolRecord) Validate() error {
 		return fmt.Errorf("pool '%s' has invalid total shares: %s", p.PoolID, p.TotalShares)
 	}
 
+	if err := validatePoolType(p.PoolType, p.Amplification, p.WeightA, p.WeightB); err != nil {
+		return fmt.Errorf("pool '%s' has invalid pool type: %s", p.PoolID, err)
+	}
+
+	if err := validatePoolSwapFee(p.SwapFee); err != nil {
+		return fmt.Errorf("pool '%s' has invalid swap fee: %s", p.PoolID, err)
+	}
+
 	return nil
 }
 
@@ -178,3 +227,266 @@ func (srs ShareRecords) Validate() error {
 
 	return nil
 }
+
+// NewDepositPosition returns a new deposit position for a depositor of a pool
+func NewDepositPosition(depositor sdk.AccAddress, poolID string, shares sdkmath.Int, costBasis sdk.Coins, averageDepositTime time.Time) DepositPosition {
+	return DepositPosition{
+		Depositor:          depositor,
+		PoolID:             poolID,
+		Shares:             shares,
+		CostBasis:          costBasis,
+		AverageDepositTime: averageDepositTime,
+	}
+}
+
+// AddShares returns the position with shares acquired at a time for a cost, moving the average deposit
+// time toward the acquisition time in proportion to the shares added
+func (p DepositPosition) AddShares(shares sdkmath.Int, cost sdk.Coins, t time.Time) DepositPosition {
+	total := p.Shares.Add(shares)
+	if total.IsZero() {
+		return p
+	}
+
+	averageDepositTime := t
+	if p.Shares.IsPositive() {
+		elapsed := sdkmath.NewInt(int64(t.Sub(p.AverageDepositTime)))
+		averageDepositTime = p.AverageDepositTime.Add(time.Duration(elapsed.Mul(shares).Quo(total).Int64()))
+	}
+
+	return NewDepositPosition(p.Depositor, p.PoolID, total, p.CostBasis.Add(cost...), averageDepositTime)
+}
+
+// RemoveShares returns the position with shares removed, reducing the cost basis in proportion to the shares
+// removed.  It panics if more shares are removed than the position holds.
+func (p DepositPosition) RemoveShares(shares sdkmath.Int) DepositPosition {
+	if shares.GT(p.Shares) {
+		panic(fmt.Sprintf("can not remove %s shares from position with %s shares", shares, p.Shares))
+	}
+
+	remaining := p.Shares.Sub(shares)
+	costBasis := make([]sdk.Coin, 0, len(p.CostBasis))
+	for _, c := range p.CostBasis {
+		costBasis = append(costBasis, sdk.NewCoin(c.Denom, c.Amount.Mul(remaining).Quo(p.Shares)))
+	}
+
+	return NewDepositPosition(p.Depositor, p.PoolID, remaining, sdk.NewCoins(costBasis...), p.AverageDepositTime)
+}
+
+// Validate performs basic validation checks of the position data
+func (p DepositPosition) Validate() error {
+	tokens := strings.Split(p.PoolID, PoolIDSep)
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" || tokens[1] < tokens[0] || tokens[0] == tokens[1] {
+		return fmt.Errorf("poolID '%s' is invalid", p.PoolID)
+	}
+	if sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
+		return fmt.Errorf("poolID '%s' is invalid", p.PoolID)
+	}
+
+	if p.Depositor.Empty() {
+		return fmt.Errorf("deposit position cannot have empty depositor address")
+	}
+
+	if p.Shares.IsNil() || !p.Shares.IsPositive() {
+		return fmt.Errorf("depositor '%s' and pool '%s' has invalid position shares: %s", p.Depositor, p.PoolID, p.Shares)
+	}
+
+	if !p.CostBasis.IsValid() && !p.CostBasis.Empty() {
+		return fmt.Errorf("depositor '%s' and pool '%s' has invalid cost basis: %s", p.Depositor, p.PoolID, p.CostBasis)
+	}
+
+	if p.AverageDepositTime.IsZero() {
+		return fmt.Errorf("depositor '%s' and pool '%s' has invalid average deposit time", p.Depositor, p.PoolID)
+	}
+
+	return nil
+}
+
+// DepositPositions is a slice of DepositPosition
+type DepositPositions []DepositPosition
+
+// Validate performs basic validation checks on all positions in the slice
+func (dps DepositPositions) Validate() error {
+	seenPositions := make(map[string]bool)
+
+	for _, p := range dps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s", p.Depositor, p.PoolID)
+		if seenPositions[key] {
+			return fmt.Errorf("duplicate depositor '%s' and poolID '%s'", p.Depositor, p.PoolID)
+		}
+		seenPositions[key] = true
+	}
+
+	return nil
+}
+
+// PriceObservationRetention is the duration that price observations are stored for.  The most recent
+// observation before the retention period is also kept, so any window within the period can be priced.
+const PriceObservationRetention = 7 * 24 * time.Hour
+
+// NewPriceObservation returns a new price observation for a pool
+func NewPriceObservation(poolID string, timestamp time.Time, cumulativePriceA, cumulativePriceB, priceA, priceB sdk.Dec) PriceObservation {
+	return PriceObservation{
+		PoolID:           poolID,
+		Timestamp:        timestamp,
+		CumulativePriceA: cumulativePriceA,
+		CumulativePriceB: cumulativePriceB,
+		PriceA:           priceA,
+		PriceB:           priceB,
+	}
+}
+
+// CumulativePricesAt returns the cumulative prices of the observation extended to a time at or after the
+// observation, using the spot prices held since the observation
+func (o PriceObservation) CumulativePricesAt(t time.Time) (sdk.Dec, sdk.Dec) {
+	elapsed := sdk.NewDecWithPrec(t.Sub(o.Timestamp).Nanoseconds(), 9)
+
+	return o.CumulativePriceA.Add(o.PriceA.Mul(elapsed)), o.CumulativePriceB.Add(o.PriceB.Mul(elapsed))
+}
+
+// Validate performs basic validation checks of the observation data
+func (o PriceObservation) Validate() error {
+	tokens := strings.Split(o.PoolID, PoolIDSep)
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" || tokens[1] < tokens[0] || tokens[0] == tokens[1] {
+		return fmt.Errorf("poolID '%s' is invalid", o.PoolID)
+	}
+	if sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
+		return fmt.Errorf("poolID '%s' is invalid", o.PoolID)
+	}
+
+	if o.Timestamp.IsZero() {
+		return fmt.Errorf("pool '%s' has invalid observation time", o.PoolID)
+	}
+
+	for _, price := range []sdk.Dec{o.CumulativePriceA, o.CumulativePriceB, o.PriceA, o.PriceB} {
+		if price.IsNil() || price.IsNegative() {
+			return fmt.Errorf("pool '%s' has invalid observation price: %s", o.PoolID, price)
+		}
+	}
+
+	return nil
+}
+
+// PriceObservations is a slice of PriceObservation
+type PriceObservations []PriceObservation
+
+// Validate performs basic validation checks on all observations in the slice
+func (pos PriceObservations) Validate() error {
+	seenObservations := make(map[string]bool)
+
+	for _, o := range pos {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s", o.PoolID, o.Timestamp.UTC())
+		if seenObservations[key] {
+			return fmt.Errorf("duplicate observation for pool '%s' at %s", o.PoolID, o.Timestamp.UTC())
+		}
+		seenObservations[key] = true
+	}
+
+	return nil
+}
+
+// MaxLimitOrdersPerBlock is the maximum number of limit orders expired or examined for filling each block,
+// bounding the work done refunding expired orders and matching orders against pools
+const MaxLimitOrdersPerBlock = 100
+
+// MaxLimitOrderFillIterations is the maximum number of simulated swaps used to search for the fill amount of a
+// limit order against a pool without a closed-form fill amount
+const MaxLimitOrderFillIterations = 32
+
+// MaxLimitOrderDuration is the maximum time from placement to expiration of a limit order, bounding the time
+// orders that are never filled occupy the order book
+const MaxLimitOrderDuration = 30 * 24 * time.Hour
+
+// MinLimitOrderSellAmount is the minimum sell amount of a placed limit order, so orders are not too small to be
+// filled at their limit price
+var MinLimitOrderSellAmount = sdkmath.NewInt(1000)
+
+// DefaultNextLimitOrderID is the id of the first limit order placed
+const DefaultNextLimitOrderID = uint64(1)
+
+// NewLimitOrder returns a new limit order
+func NewLimitOrder(id uint64, owner sdk.AccAddress, sell sdk.Coin, buyDenom string, price sdk.Dec, expiration time.Time) LimitOrder {
+	return LimitOrder{
+		ID:         id,
+		Owner:      owner.String(),
+		PoolID:     PoolID(sell.Denom, buyDenom),
+		Sell:       sell,
+		BuyDenom:   buyDenom,
+		Price:      price,
+		Expiration: expiration,
+	}
+}
+
+// Validate performs basic validation checks of the order data
+func (o LimitOrder) Validate() error {
+	if o.ID == 0 {
+		return errors.New("limit order id must be set")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(o.Owner); err != nil {
+		return fmt.Errorf("limit order %d has invalid owner: %s", o.ID, err)
+	}
+
+	if !o.Sell.IsValid() || !o.Sell.IsPositive() {
+		return fmt.Errorf("limit order %d has invalid sell amount: %s", o.ID, o.Sell)
+	}
+
+	if err := sdk.ValidateDenom(o.BuyDenom); err != nil {
+		return fmt.Errorf("limit order %d has invalid buy denom: %s", o.ID, err)
+	}
+
+	if o.Sell.Denom == o.BuyDenom {
+		return fmt.Errorf("limit order %d can not buy and sell the same denom", o.ID)
+	}
+
+	if o.PoolID != PoolID(o.Sell.Denom, o.BuyDenom) {
+		return fmt.Errorf("limit order %d has invalid poolID '%s'", o.ID, o.PoolID)
+	}
+
+	if o.Price.IsNil() || !o.Price.IsPositive() || !sdk.ValidSortableDec(o.Price) {
+		return fmt.Errorf("limit order %d has invalid price: %s", o.ID, o.Price)
+	}
+
+	if o.Expiration.IsZero() {
+		return fmt.Errorf("limit order %d has invalid expiration", o.ID)
+	}
+
+	return nil
+}
+
+// LimitOrders is a slice of LimitOrder
+type LimitOrders []LimitOrder
+
+// Validate performs basic validation checks on all orders in the slice
+func (los LimitOrders) Validate() error {
+	seenOrders := make(map[uint64]bool)
+
+	for _, o := range los {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		if seenOrders[o.ID] {
+			return fmt.Errorf("duplicate limit order id %d", o.ID)
+		}
+		seenOrders[o.ID] = true
+	}
+
+	return nil
+}
+
+// Escrow returns the total unfilled amount held in escrow by the orders
+func (los LimitOrders) Escrow() sdk.Coins {
+	escrow := sdk.NewCoins()
+	for _, o := range los {
+		escrow = escrow.Add(o.Sell)
+	}
+	return escrow
+}
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
 	types "github.com/kava-labs/kava/x/swap/types"
 
@@ -40,6 +42,19 @@ func TestState_PoolID(t *testing.T) {
 	}
 }
 
+func TestState_ShareDenom(t *testing.T) {
+	ibcDenomA := "ibc/" + strings.Repeat("A", 64)
+	ibcDenomB := "ibc/" + strings.Repeat("B", 64)
+
+	denom := types.ShareDenom(types.PoolID(ibcDenomA, ibcDenomB))
+	require.NoError(t, sdk.ValidateDenom(denom))
+	assert.Len(t, denom, len(types.ShareDenom(types.PoolID("ukava", "usdx"))))
+	assert.True(t, strings.HasPrefix(denom, types.ShareDenomPrefix+"/"))
+
+	assert.NotEqual(t, denom, types.ShareDenom(types.PoolID(ibcDenomA, "ukava")))
+	assert.Equal(t, types.ShareDenom("ukava:usdx"), types.ShareDenom(types.PoolID("usdx", "ukava")))
+}
+
 func TestState_NewPoolRecord(t *testing.T) {
 	reserves := sdk.NewCoins(usdx(50e6), ukava(10e6))
 	totalShares := sdkmath.NewInt(30e6)
@@ -78,6 +93,20 @@ func TestState_NewPoolRecordFromPool(t *testing.T) {
 	assert.Nil(t, record.Validate())
 }
 
+func TestState_NewPoolRecordFromPool_StableSwap(t *testing.T) {
+	reserves := sdk.NewCoins(usdx(50e6), sdk.NewCoin("usdc", i(50e6)))
+
+	pool, err := types.NewDenominatedPoolFromAllowedPool(types.NewStableSwapAllowedPool("usdc", "usdx", 100), reserves)
+	require.NoError(t, err)
+
+	record := types.NewPoolRecordFromPool(pool)
+
+	assert.Equal(t, types.PoolID("usdc", "usdx"), record.PoolID)
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, record.PoolType)
+	assert.Equal(t, uint64(100), record.Amplification)
+	assert.Nil(t, record.Validate())
+}
+
 func TestState_PoolRecord_JSONEncoding(t *testing.T) {
 	raw := `{
 		"pool_id": "ukava:usdx",
@@ -96,15 +125,41 @@ func TestState_PoolRecord_JSONEncoding(t *testing.T) {
 	assert.Equal(t, i(3e6), record.TotalShares)
 }
 
+func TestState_PoolRecord_JSONEncoding_PoolType(t *testing.T) {
+	raw := `{
+		"pool_id": "usdc:usdx",
+		"reserves_a": { "denom": "usdc", "amount": "1000000" },
+		"reserves_b": { "denom": "usdx", "amount": "1000000" },
+		"total_shares": "1000000",
+		"pool_type": "POOL_TYPE_STABLESWAP",
+		"amplification": "100"
+	}`
+
+	var record types.PoolRecord
+	err := types.ModuleCdc.UnmarshalJSON([]byte(raw), &record)
+	require.NoError(t, err)
+
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, record.PoolType)
+	assert.Equal(t, uint64(100), record.Amplification)
+
+	var invalid types.PoolType
+	assert.EqualError(t, json.Unmarshal([]byte(`"POOL_TYPE_UNKNOWN"`), &invalid), "invalid pool type POOL_TYPE_UNKNOWN")
+}
+
 func TestState_PoolRecord_YamlEncoding(t *testing.T) {
-	expected := `pool_id: ukava:usdx
+	expected := `amplification: 0
+pool_id: ukava:usdx
+pool_type: POOL_TYPE_CONSTANT_PRODUCT
 reserves_a:
   amount: "1000000"
   denom: ukava
 reserves_b:
   amount: "5000000"
   denom: usdx
+swap_fee: "0.000000000000000000"
 total_shares: "3000000"
+weight_a: 0
+weight_b: 0
 `
 	record := types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6))
 	data, err := yaml.Marshal(record)
@@ -262,6 +317,90 @@ func TestState_PoolRecord_Validations(t *testing.T) {
 	}
 }
 
+func TestState_PoolRecord_Validations_PoolType(t *testing.T) {
+	testCases := []struct {
+		name          string
+		poolType      types.PoolType
+		amplification uint64
+		weightA       uint64
+		weightB       uint64
+		expectedErr   string
+	}{
+		{
+			name:          "constant product with amplification",
+			poolType:      types.POOL_TYPE_CONSTANT_PRODUCT,
+			amplification: 1,
+			expectedErr:   "pool 'ukava:usdx' has invalid pool type: amplification must be zero for pool type POOL_TYPE_CONSTANT_PRODUCT",
+		},
+		{
+			name:          "stableswap without amplification",
+			poolType:      types.POOL_TYPE_STABLESWAP,
+			amplification: 0,
+			expectedErr:   "pool 'ukava:usdx' has invalid pool type: amplification 0 must be between 1 and 1000000: invalid pool",
+		},
+		{
+			name:        "constant product with weights",
+			poolType:    types.POOL_TYPE_CONSTANT_PRODUCT,
+			weightA:     80,
+			weightB:     20,
+			expectedErr: "pool 'ukava:usdx' has invalid pool type: weights must be zero for pool type POOL_TYPE_CONSTANT_PRODUCT",
+		},
+		{
+			name:        "weighted without weights",
+			poolType:    types.POOL_TYPE_WEIGHTED,
+			expectedErr: "pool 'ukava:usdx' has invalid pool type: weights 0 and 0 must be at least 2: invalid pool",
+		},
+		{
+			name:        "weighted with invalid weights",
+			poolType:    types.POOL_TYPE_WEIGHTED,
+			weightA:     60,
+			weightB:     60,
+			expectedErr: "pool 'ukava:usdx' has invalid pool type: weights 60 and 60 must sum to 100: invalid pool",
+		},
+		{
+			name:          "unknown pool type",
+			poolType:      types.PoolType(5),
+			amplification: 0,
+			expectedErr:   "pool 'ukava:usdx' has invalid pool type: invalid pool type 5",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			record := types.NewPoolRecord(sdk.NewCoins(usdx(500e6), ukava(100e6)), i(300e6))
+			record.PoolType = tc.poolType
+			record.Amplification = tc.amplification
+			record.WeightA = tc.weightA
+			record.WeightB = tc.weightB
+			err := record.Validate()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+
+	record := types.NewPoolRecord(sdk.NewCoins(usdx(500e6), ukava(100e6)), i(300e6))
+	record.PoolType = types.POOL_TYPE_STABLESWAP
+	record.Amplification = 100
+	assert.NoError(t, record.Validate())
+
+	record = types.NewPoolRecord(sdk.NewCoins(usdx(500e6), ukava(100e6)), i(300e6))
+	record.PoolType = types.POOL_TYPE_WEIGHTED
+	record.WeightA = 80
+	record.WeightB = 20
+	assert.NoError(t, record.Validate())
+}
+
+func TestState_PoolRecord_Validations_SwapFee(t *testing.T) {
+	record := types.NewPoolRecord(sdk.NewCoins(usdx(500e6), ukava(100e6)), i(300e6))
+	record.SwapFee = sdk.MustNewDecFromStr("0.01")
+	assert.NoError(t, record.Validate())
+
+	record.SwapFee = sdk.NewDec(-1)
+	assert.EqualError(t, record.Validate(), "pool 'ukava:usdx' has invalid swap fee: invalid swap fee: -1.000000000000000000")
+
+	record.SwapFee = sdk.OneDec()
+	assert.EqualError(t, record.Validate(), "pool 'ukava:usdx' has invalid swap fee: invalid swap fee: 1.000000000000000000")
+}
+
 func TestState_PoolRecord_OrderedReserves(t *testing.T) {
 	invalidOrder := types.NewPoolRecord(
 		// force order to not be sorted
@@ -529,3 +668,274 @@ func TestState_ShareRecords_ValidateUniqueShareRecords(t *testing.T) {
 	invalidRecords := types.ShareRecords{record_1, record_3, record_2, record_4}
 	assert.EqualError(t, invalidRecords.Validate(), "duplicate depositor 'kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w' and poolID 'ukava:usdx'")
 }
+
+func TestState_PriceObservation_CumulativePricesAt(t *testing.T) {
+	timestamp := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	observation := types.NewPriceObservation("ukava:usdx", timestamp, d("100"), d("4"), d("5"), d("0.2"))
+
+	cumulativeA, cumulativeB := observation.CumulativePricesAt(timestamp)
+	assert.Equal(t, d("100"), cumulativeA)
+	assert.Equal(t, d("4"), cumulativeB)
+
+	cumulativeA, cumulativeB = observation.CumulativePricesAt(timestamp.Add(90 * time.Second))
+	assert.Equal(t, d("550"), cumulativeA)
+	assert.Equal(t, d("22"), cumulativeB)
+
+	cumulativeA, cumulativeB = observation.CumulativePricesAt(timestamp.Add(500 * time.Millisecond))
+	assert.Equal(t, d("102.5"), cumulativeA)
+	assert.Equal(t, d("4.1"), cumulativeB)
+}
+
+func TestState_PriceObservation_Validations(t *testing.T) {
+	timestamp := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		observation types.PriceObservation
+		expectedErr string
+	}{
+		{
+			name:        "valid observation",
+			observation: types.NewPriceObservation("ukava:usdx", timestamp, d("100"), d("4"), d("5"), d("0.2")),
+			expectedErr: "",
+		},
+		{
+			name:        "zero cumulative prices",
+			observation: types.NewPriceObservation("ukava:usdx", timestamp, sdk.ZeroDec(), sdk.ZeroDec(), d("5"), d("0.2")),
+			expectedErr: "",
+		},
+		{
+			name:        "invalid pool id",
+			observation: types.NewPriceObservation("usdx:ukava", timestamp, d("100"), d("4"), d("5"), d("0.2")),
+			expectedErr: "poolID 'usdx:ukava' is invalid",
+		},
+		{
+			name:        "invalid denom in pool id",
+			observation: types.NewPriceObservation("ukava:0usdx", timestamp, d("100"), d("4"), d("5"), d("0.2")),
+			expectedErr: "poolID 'ukava:0usdx' is invalid",
+		},
+		{
+			name:        "zero timestamp",
+			observation: types.NewPriceObservation("ukava:usdx", time.Time{}, d("100"), d("4"), d("5"), d("0.2")),
+			expectedErr: "pool 'ukava:usdx' has invalid observation time",
+		},
+		{
+			name:        "negative cumulative price",
+			observation: types.NewPriceObservation("ukava:usdx", timestamp, d("-100"), d("4"), d("5"), d("0.2")),
+			expectedErr: "pool 'ukava:usdx' has invalid observation price: -100.000000000000000000",
+		},
+		{
+			name:        "negative price",
+			observation: types.NewPriceObservation("ukava:usdx", timestamp, d("100"), d("4"), d("5"), d("-0.2")),
+			expectedErr: "pool 'ukava:usdx' has invalid observation price: -0.200000000000000000",
+		},
+		{
+			name:        "nil price",
+			observation: types.NewPriceObservation("ukava:usdx", timestamp, d("100"), d("4"), sdk.Dec{}, d("0.2")),
+			expectedErr: "pool 'ukava:usdx' has invalid observation price: <nil>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.observation.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestState_PriceObservations_ValidateUniqueObservations(t *testing.T) {
+	timestamp := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	observation_1 := types.NewPriceObservation("ukava:usdx", timestamp, d("100"), d("4"), d("5"), d("0.2"))
+	observation_2 := types.NewPriceObservation("ukava:usdx", timestamp.Add(time.Minute), d("400"), d("16"), d("5"), d("0.2"))
+	observation_3 := types.NewPriceObservation("hard:usdx", timestamp, d("100"), d("4"), d("5"), d("0.2"))
+	observation_4 := types.NewPriceObservation("ukava:usdx", timestamp, d("200"), d("8"), d("5"), d("0.2"))
+
+	validObservations := types.PriceObservations{observation_1, observation_2, observation_3}
+	assert.NoError(t, validObservations.Validate())
+
+	invalidObservations := types.PriceObservations{observation_1, observation_2, observation_3, observation_4}
+	assert.EqualError(t, invalidObservations.Validate(), "duplicate observation for pool 'ukava:usdx' at 2022-01-01 00:00:00 +0000 UTC")
+}
+
+func TestState_LimitOrder_Validations(t *testing.T) {
+	owner := sdk.AccAddress("test1")
+	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	validOrder := types.NewLimitOrder(1, owner, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", d("5.5"), expiration)
+
+	testCases := []struct {
+		name        string
+		modify      func(o *types.LimitOrder)
+		expectedErr string
+	}{
+		{
+			name:        "valid order",
+			modify:      func(o *types.LimitOrder) {},
+			expectedErr: "",
+		},
+		{
+			name:        "zero id",
+			modify:      func(o *types.LimitOrder) { o.ID = 0 },
+			expectedErr: "limit order id must be set",
+		},
+		{
+			name:        "invalid owner",
+			modify:      func(o *types.LimitOrder) { o.Owner = "" },
+			expectedErr: "limit order 1 has invalid owner: empty address string is not allowed",
+		},
+		{
+			name:        "zero sell amount",
+			modify:      func(o *types.LimitOrder) { o.Sell = sdk.NewCoin("ukava", sdk.ZeroInt()) },
+			expectedErr: "limit order 1 has invalid sell amount: 0ukava",
+		},
+		{
+			name:        "invalid buy denom",
+			modify:      func(o *types.LimitOrder) { o.BuyDenom = "" },
+			expectedErr: "limit order 1 has invalid buy denom: invalid denom: ",
+		},
+		{
+			name: "same sell and buy denom",
+			modify: func(o *types.LimitOrder) {
+				o.BuyDenom = "ukava"
+				o.PoolID = "ukava:ukava"
+			},
+			expectedErr: "limit order 1 can not buy and sell the same denom",
+		},
+		{
+			name:        "pool id does not match denoms",
+			modify:      func(o *types.LimitOrder) { o.PoolID = "hard:usdx" },
+			expectedErr: "limit order 1 has invalid poolID 'hard:usdx'",
+		},
+		{
+			name:        "nil price",
+			modify:      func(o *types.LimitOrder) { o.Price = sdk.Dec{} },
+			expectedErr: "limit order 1 has invalid price: <nil>",
+		},
+		{
+			name:        "zero price",
+			modify:      func(o *types.LimitOrder) { o.Price = sdk.ZeroDec() },
+			expectedErr: "limit order 1 has invalid price: 0.000000000000000000",
+		},
+		{
+			name:        "zero expiration",
+			modify:      func(o *types.LimitOrder) { o.Expiration = time.Time{} },
+			expectedErr: "limit order 1 has invalid expiration",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := validOrder
+			tc.modify(&order)
+
+			err := order.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestState_LimitOrders_ValidateUniqueOrders(t *testing.T) {
+	owner := sdk.AccAddress("test1")
+	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	order_1 := types.NewLimitOrder(1, owner, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", d("5.5"), expiration)
+	order_2 := types.NewLimitOrder(2, owner, sdk.NewCoin("usdx", sdkmath.NewInt(2e6)), "ukava", d("0.2"), expiration)
+	order_3 := types.NewLimitOrder(1, owner, sdk.NewCoin("hard", sdkmath.NewInt(1e6)), "usdx", d("1.5"), expiration)
+
+	validOrders := types.LimitOrders{order_1, order_2}
+	assert.NoError(t, validOrders.Validate())
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), validOrders.Escrow())
+
+	invalidOrders := types.LimitOrders{order_1, order_2, order_3}
+	assert.EqualError(t, invalidOrders.Validate(), "duplicate limit order id 1")
+}
+
+func TestState_DepositPosition_AddShares(t *testing.T) {
+	depositor := sdk.AccAddress("depositor")
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	position := types.NewDepositPosition(depositor, "ukava:usdx", sdkmath.ZeroInt(), sdk.NewCoins(), start)
+	position = position.AddShares(i(3e6), sdk.NewCoins(ukava(1e6), usdx(5e6)), start.Add(time.Hour))
+	assert.Equal(t, types.NewDepositPosition(depositor, "ukava:usdx", i(3e6), sdk.NewCoins(ukava(1e6), usdx(5e6)), start.Add(time.Hour)), position)
+
+	// the average deposit time moves toward the new deposit in proportion to the shares added
+	position = position.AddShares(i(1e6), sdk.NewCoins(ukava(5e5), usdx(1e6)), start.Add(5*time.Hour))
+	assert.Equal(t, types.NewDepositPosition(depositor, "ukava:usdx", i(4e6), sdk.NewCoins(ukava(15e5), usdx(6e6)), start.Add(2*time.Hour)), position)
+}
+
+func TestState_DepositPosition_RemoveShares(t *testing.T) {
+	depositor := sdk.AccAddress("depositor")
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	position := types.NewDepositPosition(depositor, "ukava:usdx", i(3e6), sdk.NewCoins(ukava(1e6), usdx(5e6)), start)
+
+	// the cost basis is reduced in proportion to the shares removed, rounding down
+	removed := position.RemoveShares(i(1e6))
+	assert.Equal(t, types.NewDepositPosition(depositor, "ukava:usdx", i(2e6), sdk.NewCoins(ukava(666666), usdx(3333333)), start), removed)
+
+	removed = position.RemoveShares(i(3e6))
+	assert.True(t, removed.Shares.IsZero())
+	assert.True(t, removed.CostBasis.IsZero())
+
+	assert.Panics(t, func() { position.RemoveShares(i(4e6)) }, "expected panic when removing more shares than the position holds")
+}
+
+func TestState_DepositPosition_Validations(t *testing.T) {
+	depositor := sdk.AccAddress("depositor")
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		position    types.DepositPosition
+		expectedErr string
+	}{
+		{
+			name:     "valid",
+			position: types.NewDepositPosition(depositor, "ukava:usdx", i(3e6), sdk.NewCoins(ukava(1e6), usdx(5e6)), start),
+		},
+		{
+			name:     "valid without cost basis",
+			position: types.NewDepositPosition(depositor, "ukava:usdx", i(3e6), sdk.NewCoins(), start),
+		},
+		{
+			name:        "invalid pool id",
+			position:    types.NewDepositPosition(depositor, "usdx:ukava", i(3e6), sdk.NewCoins(), start),
+			expectedErr: "poolID 'usdx:ukava' is invalid",
+		},
+		{
+			name:        "empty depositor",
+			position:    types.NewDepositPosition(sdk.AccAddress{}, "ukava:usdx", i(3e6), sdk.NewCoins(), start),
+			expectedErr: "deposit position cannot have empty depositor address",
+		},
+		{
+			name:        "negative shares",
+			position:    types.NewDepositPosition(depositor, "ukava:usdx", i(-1), sdk.NewCoins(), start),
+			expectedErr: "depositor 'kava1v3jhqmmnd96x7usfffpln' and pool 'ukava:usdx' has invalid position shares: -1",
+		},
+		{
+			name:        "invalid cost basis",
+			position:    types.NewDepositPosition(depositor, "ukava:usdx", i(3e6), sdk.Coins{usdx(5e6), ukava(1e6)}, start),
+			expectedErr: "depositor 'kava1v3jhqmmnd96x7usfffpln' and pool 'ukava:usdx' has invalid cost basis: 5000000usdx,1000000ukava",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.position.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
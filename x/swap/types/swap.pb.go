@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,12 +30,53 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PoolType defines the invariant used to price swaps within a pool
+type PoolType int32
+
+const (
+	// POOL_TYPE_CONSTANT_PRODUCT represents a constant-product (x * y = k) pool. This is the
+	// default pool type and is used by all pools created before pool types were introduced.
+	POOL_TYPE_CONSTANT_PRODUCT PoolType = 0
+	// POOL_TYPE_STABLESWAP represents a stableswap pool using an amplified invariant for pegged pairs
+	POOL_TYPE_STABLESWAP PoolType = 1
+	// POOL_TYPE_WEIGHTED represents a weighted constant-product pool with custom token weights
+	POOL_TYPE_WEIGHTED PoolType = 2
+)
+
+var PoolType_name = map[int32]string{
+	0: "POOL_TYPE_CONSTANT_PRODUCT",
+	1: "POOL_TYPE_STABLESWAP",
+	2: "POOL_TYPE_WEIGHTED",
+}
+
+var PoolType_value = map[string]int32{
+	"POOL_TYPE_CONSTANT_PRODUCT": 0,
+	"POOL_TYPE_STABLESWAP":       1,
+	"POOL_TYPE_WEIGHTED":         2,
+}
+
+func (x PoolType) String() string {
+	return proto.EnumName(PoolType_name, int32(x))
+}
+
+func (PoolType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{0}
+}
+
 // Params defines the parameters for the swap module.
 type Params struct {
-	// allowed_pools defines that pools that are allowed to be created
+	// allowed_pools defines the curated pools that are allowed to be created without a pool creation fee
 	AllowedPools AllowedPools `protobuf:"bytes,1,rep,name=allowed_pools,json=allowedPools,proto3,castrepeated=AllowedPools" json:"allowed_pools"`
-	// swap_fee defines the swap fee for all pools
+	// swap_fee defines the swap fee for all pools without a fee tier
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
+	// protocol_fee defines the percentage of swap fees sent to the community pool instead of liquidity providers
+	ProtocolFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=protocol_fee,json=protocolFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee"`
+	// permissionless_pool_creation defines if pools that are not in the allowed pools may be created by any depositor
+	PermissionlessPoolCreation bool `protobuf:"varint,4,opt,name=permissionless_pool_creation,json=permissionlessPoolCreation,proto3" json:"permissionless_pool_creation"`
+	// pool_creation_fee defines the fee sent to the community pool to create a pool that is not in the allowed pools
+	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee"`
+	// min_initial_shares defines the minimum shares a pool that is not in the allowed pools must be created with
+	MinInitialShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_initial_shares,json=minInitialShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_initial_shares"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,12 +118,36 @@ func (m *Params) GetAllowedPools() AllowedPools {
 	return nil
 }
 
+func (m *Params) GetPermissionlessPoolCreation() bool {
+	if m != nil {
+		return m.PermissionlessPoolCreation
+	}
+	return false
+}
+
+func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PoolCreationFee
+	}
+	return nil
+}
+
 // AllowedPool defines a pool that is allowed to be created
 type AllowedPool struct {
 	// token_a represents the a token allowed
 	TokenA string `protobuf:"bytes,1,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
 	// token_b represents the b token allowed
 	TokenB string `protobuf:"bytes,2,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	// pool_type represents the type of pool created for the token pair
+	PoolType PoolType `protobuf:"varint,3,opt,name=pool_type,json=poolType,proto3,enum=kava.swap.v1beta1.PoolType" json:"pool_type"`
+	// amplification represents the amplification coefficient of a stableswap pool, and must be zero for other pool types
+	Amplification uint64 `protobuf:"varint,4,opt,name=amplification,proto3" json:"amplification"`
+	// weight_a represents the percentage weight of token a in a weighted pool, and must be zero for other pool types
+	WeightA uint64 `protobuf:"varint,5,opt,name=weight_a,json=weightA,proto3" json:"weight_a"`
+	// weight_b represents the percentage weight of token b in a weighted pool, and must be zero for other pool types
+	WeightB uint64 `protobuf:"varint,6,opt,name=weight_b,json=weightB,proto3" json:"weight_b"`
+	// swap_fee represents the fee tier of the pool, and uses the global swap fee when zero
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
 }
 
 func (m *AllowedPool) Reset()      { *m = AllowedPool{} }
@@ -127,6 +196,34 @@ func (m *AllowedPool) GetTokenB() string {
 	return ""
 }
 
+func (m *AllowedPool) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return POOL_TYPE_CONSTANT_PRODUCT
+}
+
+func (m *AllowedPool) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
+func (m *AllowedPool) GetWeightA() uint64 {
+	if m != nil {
+		return m.WeightA
+	}
+	return 0
+}
+
+func (m *AllowedPool) GetWeightB() uint64 {
+	if m != nil {
+		return m.WeightB
+	}
+	return 0
+}
+
 // PoolRecord represents the state of a liquidity pool
 // and is used to store the state of a denominated pool
 type PoolRecord struct {
@@ -138,6 +235,16 @@ type PoolRecord struct {
 	ReservesB types.Coin `protobuf:"bytes,3,opt,name=reserves_b,json=reservesB,proto3" json:"reserves_b"`
 	// total_shares is the total distrubuted shares of the pool
 	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shares"`
+	// pool_type represents the type of the pool
+	PoolType PoolType `protobuf:"varint,5,opt,name=pool_type,json=poolType,proto3,enum=kava.swap.v1beta1.PoolType" json:"pool_type"`
+	// amplification represents the amplification coefficient of a stableswap pool
+	Amplification uint64 `protobuf:"varint,6,opt,name=amplification,proto3" json:"amplification"`
+	// weight_a represents the percentage weight of token a in a weighted pool
+	WeightA uint64 `protobuf:"varint,7,opt,name=weight_a,json=weightA,proto3" json:"weight_a"`
+	// weight_b represents the percentage weight of token b in a weighted pool
+	WeightB uint64 `protobuf:"varint,8,opt,name=weight_b,json=weightB,proto3" json:"weight_b"`
+	// swap_fee represents the fee tier of the pool, and uses the global swap fee when zero
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -194,7 +301,36 @@ func (m *PoolRecord) GetReservesB() types.Coin {
 	return types.Coin{}
 }
 
-// ShareRecord stores the shares owned for a depositor and pool
+func (m *PoolRecord) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return POOL_TYPE_CONSTANT_PRODUCT
+}
+
+func (m *PoolRecord) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
+func (m *PoolRecord) GetWeightA() uint64 {
+	if m != nil {
+		return m.WeightA
+	}
+	return 0
+}
+
+func (m *PoolRecord) GetWeightB() uint64 {
+	if m != nil {
+		return m.WeightB
+	}
+	return 0
+}
+
+// ShareRecord stores the shares owned for a depositor and pool, as of the last time the depositor's
+// share token balance was synced
 type ShareRecord struct {
 	// depositor represents the owner of the shares
 	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
@@ -251,50 +387,333 @@ func (m *ShareRecord) GetPoolID() string {
 	return ""
 }
 
+// DepositPosition records the cost basis of the shares held by a depositor of a pool, and is used to compare
+// the value of the shares to the value of holding the deposited tokens
+type DepositPosition struct {
+	// depositor represents the owner of the position
+	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
+	// pool_id represents the pool the position is for
+	PoolID string `protobuf:"bytes,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// shares represents the shares covered by the cost basis as of the last deposit, withdraw, or sync
+	Shares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares"`
+	// cost_basis represents the tokens deposited for the shares, reduced in proportion to the shares withdrawn
+	// or transferred.  Shares received by transfer are added at their value when received.
+	CostBasis github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=cost_basis,json=costBasis,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cost_basis"`
+	// average_deposit_time represents the share-weighted average time the shares were acquired
+	AverageDepositTime time.Time `protobuf:"bytes,5,opt,name=average_deposit_time,json=averageDepositTime,proto3,stdtime" json:"average_deposit_time"`
+}
+
+func (m *DepositPosition) Reset()         { *m = DepositPosition{} }
+func (m *DepositPosition) String() string { return proto.CompactTextString(m) }
+func (*DepositPosition) ProtoMessage()    {}
+func (*DepositPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{4}
+}
+func (m *DepositPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositPosition.Merge(m, src)
+}
+func (m *DepositPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositPosition proto.InternalMessageInfo
+
+func (m *DepositPosition) GetDepositor() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Depositor
+	}
+	return nil
+}
+
+func (m *DepositPosition) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *DepositPosition) GetCostBasis() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CostBasis
+	}
+	return nil
+}
+
+func (m *DepositPosition) GetAverageDepositTime() time.Time {
+	if m != nil {
+		return m.AverageDepositTime
+	}
+	return time.Time{}
+}
+
+// PriceObservation records the cumulative prices of a pool at a point in time, and is used to
+// calculate the time-weighted average price of the pool over any window of observations
+type PriceObservation struct {
+	// pool_id represents the unique id of the pool
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// timestamp is the block time of the observation
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// cumulative_price_a is the sum of the spot price of token a, in units of token b, multiplied by the
+	// seconds each price was held since the first observation
+	CumulativePriceA github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=cumulative_price_a,json=cumulativePriceA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price_a"`
+	// cumulative_price_b is the sum of the spot price of token b, in units of token a, multiplied by the
+	// seconds each price was held since the first observation
+	CumulativePriceB github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_price_b,json=cumulativePriceB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price_b"`
+	// price_a is the spot price of token a, in units of token b, from the time of the observation
+	PriceA github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price_a,json=priceA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_a"`
+	// price_b is the spot price of token b, in units of token a, from the time of the observation
+	PriceB github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price_b,json=priceB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_b"`
+}
+
+func (m *PriceObservation) Reset()         { *m = PriceObservation{} }
+func (m *PriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PriceObservation) ProtoMessage()    {}
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{5}
+}
+func (m *PriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceObservation.Merge(m, src)
+}
+func (m *PriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceObservation proto.InternalMessageInfo
+
+func (m *PriceObservation) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// LimitOrder is an order to sell a token to a pool at or above a limit price. The unfilled amount is held in
+// escrow, and the order is filled against the pool whenever the spot price of the pool crosses the limit price.
+type LimitOrder struct {
+	// id represents the unique id of the order
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner represents the address that placed the order
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pool_id represents the pool the order is filled against
+	PoolID string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// sell represents the unfilled amount of the order held in escrow
+	Sell types.Coin `protobuf:"bytes,4,opt,name=sell,proto3" json:"sell"`
+	// buy_denom represents the denom received when the order is filled
+	BuyDenom string `protobuf:"bytes,5,opt,name=buy_denom,json=buyDenom,proto3" json:"buy_denom,omitempty"`
+	// price represents the minimum price of the sell token, in units of the buy token
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// expiration represents the time the unfilled amount of the order is returned to the owner
+	Expiration time.Time `protobuf:"bytes,7,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{6}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetSell() types.Coin {
+	if m != nil {
+		return m.Sell
+	}
+	return types.Coin{}
+}
+
+func (m *LimitOrder) GetBuyDenom() string {
+	if m != nil {
+		return m.BuyDenom
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("kava.swap.v1beta1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterType((*Params)(nil), "kava.swap.v1beta1.Params")
 	proto.RegisterType((*AllowedPool)(nil), "kava.swap.v1beta1.AllowedPool")
 	proto.RegisterType((*PoolRecord)(nil), "kava.swap.v1beta1.PoolRecord")
 	proto.RegisterType((*ShareRecord)(nil), "kava.swap.v1beta1.ShareRecord")
+	proto.RegisterType((*DepositPosition)(nil), "kava.swap.v1beta1.DepositPosition")
+	proto.RegisterType((*PriceObservation)(nil), "kava.swap.v1beta1.PriceObservation")
+	proto.RegisterType((*LimitOrder)(nil), "kava.swap.v1beta1.LimitOrder")
 }
 
 func init() { proto.RegisterFile("kava/swap/v1beta1/swap.proto", fileDescriptor_9df359be90eb28cb) }
 
 var fileDescriptor_9df359be90eb28cb = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6f, 0xd3, 0x5e,
-	0x14, 0x8d, 0xd3, 0x28, 0xf9, 0xe5, 0x25, 0xbf, 0x01, 0x53, 0x89, 0xb4, 0x42, 0x76, 0x15, 0x24,
-	0xd4, 0x25, 0xb6, 0x5a, 0x36, 0x84, 0x10, 0x31, 0x01, 0x91, 0x89, 0xca, 0x0c, 0x08, 0x96, 0xa7,
-	0x67, 0xfb, 0x36, 0xb5, 0xe2, 0xf8, 0x5a, 0x7e, 0x8f, 0x84, 0x7e, 0x0b, 0xc4, 0xc4, 0xc8, 0xcc,
-	0xdc, 0x4f, 0xc0, 0xd4, 0xb1, 0xea, 0x84, 0x18, 0x02, 0x4a, 0xbe, 0x05, 0x2c, 0xe8, 0xfd, 0xa1,
-	0x35, 0x42, 0x48, 0x54, 0x4c, 0xbe, 0xf7, 0x9e, 0x77, 0xce, 0xbd, 0xf7, 0x58, 0x97, 0xdc, 0x9c,
-	0xb2, 0x39, 0xf3, 0xf9, 0x82, 0x15, 0xfe, 0x7c, 0x2f, 0x02, 0xc1, 0xf6, 0x54, 0xe2, 0x15, 0x25,
-	0x0a, 0xb4, 0xaf, 0x49, 0xd4, 0x53, 0x05, 0x83, 0x6e, 0x3b, 0x31, 0xf2, 0x19, 0x72, 0x3f, 0x62,
-	0x1c, 0x2e, 0x28, 0x31, 0xa6, 0xb9, 0xa6, 0x6c, 0x6f, 0x69, 0x9c, 0xaa, 0xcc, 0xd7, 0x89, 0x81,
-	0x36, 0x27, 0x38, 0x41, 0x5d, 0x97, 0x91, 0xae, 0xf6, 0x3f, 0x5a, 0xa4, 0x79, 0xc0, 0x4a, 0x36,
-	0xe3, 0xf6, 0x0b, 0xf2, 0x3f, 0xcb, 0x32, 0x5c, 0x40, 0x42, 0x0b, 0xc4, 0x8c, 0xf7, 0xac, 0x9d,
-	0x8d, 0xdd, 0xce, 0xbe, 0xe3, 0xfd, 0x36, 0x86, 0x37, 0xd4, 0xef, 0x0e, 0x10, 0xb3, 0x60, 0xf3,
-	0x74, 0xe9, 0xd6, 0x3e, 0x7c, 0x71, 0xbb, 0x95, 0x22, 0x0f, 0xbb, 0xac, 0x92, 0xd9, 0xcf, 0xc9,
-	0x7f, 0x92, 0x4f, 0x0f, 0x01, 0x7a, 0xf5, 0x1d, 0x6b, 0xb7, 0x1d, 0xdc, 0x93, 0xac, 0xcf, 0x4b,
-	0xf7, 0xf6, 0x24, 0x15, 0x47, 0xaf, 0x22, 0x2f, 0xc6, 0x99, 0x19, 0xd7, 0x7c, 0x06, 0x3c, 0x99,
-	0xfa, 0xe2, 0xb8, 0x00, 0xee, 0x8d, 0x20, 0x3e, 0x3f, 0x19, 0x10, 0xb3, 0xcd, 0x08, 0xe2, 0xb0,
-	0x25, 0xd5, 0x1e, 0x03, 0xdc, 0x6d, 0xbc, 0x7b, 0xef, 0xd6, 0xfa, 0x8f, 0x48, 0xa7, 0xd2, 0xdc,
-	0xbe, 0x41, 0x5a, 0x02, 0xa7, 0x90, 0x53, 0xd6, 0xb3, 0x64, 0xb3, 0xb0, 0xa9, 0xd2, 0xe1, 0x25,
-	0x10, 0xe9, 0x29, 0x0c, 0x10, 0x18, 0x99, 0xb7, 0x75, 0x42, 0xa4, 0x40, 0x08, 0x31, 0x96, 0x89,
-	0x7d, 0x8b, 0xb4, 0xa4, 0x0f, 0x34, 0x4d, 0xb4, 0x4c, 0x40, 0x56, 0x4b, 0xb7, 0x29, 0x1f, 0x8c,
-	0x47, 0x61, 0x53, 0x42, 0xe3, 0xc4, 0xbe, 0x4f, 0x48, 0x09, 0x1c, 0xca, 0x39, 0x70, 0xca, 0x94,
-	0x6a, 0x67, 0x7f, 0xcb, 0x33, 0xa3, 0xca, 0xbf, 0x74, 0xe1, 0xd9, 0x43, 0x4c, 0xf3, 0xa0, 0x21,
-	0xd7, 0x0e, 0xdb, 0x3f, 0x29, 0xc3, 0x5f, 0xf8, 0x51, 0x6f, 0xe3, 0x8a, 0xfc, 0xc0, 0xa6, 0xa4,
-	0x2b, 0x50, 0xb0, 0x8c, 0xf2, 0x23, 0x56, 0x02, 0xef, 0x35, 0xae, 0xec, 0xee, 0x38, 0x17, 0x15,
-	0x77, 0xc7, 0xb9, 0x08, 0x3b, 0x4a, 0xf1, 0x99, 0x12, 0xec, 0x7f, 0xb7, 0x48, 0x47, 0x85, 0xc6,
-	0x95, 0x43, 0xd2, 0x4e, 0xa0, 0x40, 0x9e, 0x0a, 0x2c, 0x95, 0x2f, 0xdd, 0xe0, 0xc9, 0xb7, 0xa5,
-	0x3b, 0xf8, 0x8b, 0x4e, 0xc3, 0x38, 0x1e, 0x26, 0x49, 0x09, 0x9c, 0x9f, 0x9f, 0x0c, 0xae, 0x9b,
-	0x86, 0xa6, 0x12, 0x1c, 0x0b, 0xe0, 0xe1, 0xa5, 0x74, 0xd5, 0xfd, 0xfa, 0x1f, 0xdd, 0xa7, 0xa4,
-	0xab, 0xf7, 0xa6, 0xb8, 0xc8, 0x21, 0x51, 0xfe, 0xfd, 0xf3, 0xf6, 0x5a, 0xf1, 0xa9, 0x14, 0x0c,
-	0x1e, 0x9c, 0xae, 0x1c, 0xeb, 0x6c, 0xe5, 0x58, 0x5f, 0x57, 0x8e, 0xf5, 0x66, 0xed, 0xd4, 0xce,
-	0xd6, 0x4e, 0xed, 0xd3, 0xda, 0xa9, 0xbd, 0xac, 0x8a, 0xcb, 0x03, 0x19, 0x64, 0x2c, 0xe2, 0x2a,
-	0xf2, 0x5f, 0xeb, 0x8b, 0x56, 0x0d, 0xa2, 0xa6, 0xba, 0xb3, 0x3b, 0x3f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xee, 0x4a, 0x16, 0x69, 0xeb, 0x03, 0x00, 0x00,
+	// 1190 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xfa, 0xc7, 0xda, 0x1e, 0xbb, 0xdf, 0xba, 0xf3, 0x8d, 0xca, 0xd6, 0xad, 0xbc, 0x91,
+	0x91, 0x20, 0x42, 0x8a, 0x4d, 0xd3, 0x03, 0x12, 0x42, 0x08, 0x6f, 0x1c, 0x1a, 0x4b, 0x51, 0x6d,
+	0x6d, 0x5c, 0xa2, 0x72, 0x59, 0xed, 0x8f, 0x89, 0x33, 0xcd, 0xee, 0xce, 0x6a, 0x67, 0x9d, 0x34,
+	0x37, 0xb8, 0x71, 0xe0, 0xd0, 0x23, 0x47, 0x24, 0x4e, 0xf4, 0xdc, 0x03, 0x7f, 0x42, 0x25, 0x2e,
+	0x55, 0x4f, 0x88, 0xc3, 0x06, 0x25, 0xe2, 0xe2, 0x3f, 0x01, 0x24, 0x84, 0x66, 0x76, 0x6d, 0xaf,
+	0x9b, 0xa4, 0xd8, 0xc8, 0x95, 0xb8, 0x24, 0x3b, 0xf3, 0xde, 0xfb, 0xcc, 0x7b, 0x9f, 0xf7, 0x99,
+	0x1f, 0x06, 0x77, 0x0e, 0xf5, 0x23, 0xbd, 0x49, 0x8f, 0x75, 0xaf, 0x79, 0x74, 0xd7, 0x40, 0x81,
+	0x7e, 0x97, 0x0f, 0x1a, 0x9e, 0x4f, 0x02, 0x02, 0x6f, 0x30, 0x6b, 0x83, 0x4f, 0xc4, 0xd6, 0x6a,
+	0xcd, 0x24, 0xd4, 0x21, 0xb4, 0x69, 0xe8, 0x14, 0x4d, 0x42, 0x4c, 0x82, 0xdd, 0x28, 0xa4, 0x7a,
+	0x2b, 0xb2, 0x6b, 0x7c, 0xd4, 0x8c, 0x06, 0xb1, 0x69, 0x65, 0x40, 0x06, 0x24, 0x9a, 0x67, 0x5f,
+	0xf1, 0xac, 0x3c, 0x20, 0x64, 0x60, 0xa3, 0x26, 0x1f, 0x19, 0xc3, 0xfd, 0x66, 0x80, 0x1d, 0x44,
+	0x03, 0xdd, 0x89, 0x93, 0xa8, 0xff, 0x98, 0x03, 0x62, 0x4f, 0xf7, 0x75, 0x87, 0xc2, 0x47, 0xe0,
+	0x9a, 0x6e, 0xdb, 0xe4, 0x18, 0x59, 0x9a, 0x47, 0x88, 0x4d, 0x25, 0x61, 0x35, 0xb3, 0x56, 0xda,
+	0xa8, 0x35, 0x2e, 0xe4, 0xd9, 0x68, 0x45, 0x7e, 0x3d, 0x42, 0x6c, 0x65, 0xe5, 0x45, 0x28, 0xa7,
+	0x9e, 0x9d, 0xca, 0xe5, 0xc4, 0x24, 0x55, 0xcb, 0x7a, 0x62, 0x04, 0xf7, 0x40, 0x81, 0xc5, 0x6b,
+	0xfb, 0x08, 0x49, 0xe9, 0x55, 0x61, 0xad, 0xa8, 0x7c, 0xc2, 0xa2, 0x7e, 0x0d, 0xe5, 0xf7, 0x06,
+	0x38, 0x38, 0x18, 0x1a, 0x0d, 0x93, 0x38, 0x71, 0x3d, 0xf1, 0xbf, 0x75, 0x6a, 0x1d, 0x36, 0x83,
+	0x13, 0x0f, 0xd1, 0x46, 0x1b, 0x99, 0xaf, 0x9e, 0xaf, 0x83, 0xb8, 0xdc, 0x36, 0x32, 0xd5, 0x3c,
+	0x43, 0xfb, 0x1c, 0x21, 0x48, 0x40, 0x99, 0xd7, 0x61, 0x12, 0x9b, 0x83, 0x67, 0x38, 0xf8, 0xce,
+	0x62, 0xe0, 0xa3, 0x50, 0x9e, 0x41, 0x79, 0x6d, 0xb1, 0xd2, 0xd8, 0xc6, 0x16, 0x34, 0xc0, 0x1d,
+	0x0f, 0xf9, 0x0e, 0xa6, 0x14, 0x13, 0xd7, 0x46, 0x94, 0x72, 0xae, 0x34, 0xd3, 0x47, 0x7a, 0x80,
+	0x89, 0x2b, 0x65, 0x57, 0x85, 0xb5, 0x82, 0xb2, 0x3a, 0x0a, 0xe5, 0x37, 0xfa, 0xa9, 0xd5, 0x59,
+	0x2b, 0xa3, 0x69, 0x33, 0xb6, 0xc1, 0x6f, 0x05, 0x70, 0x63, 0xc6, 0x9b, 0x97, 0x96, 0xe3, 0xdd,
+	0xb8, 0xd5, 0x88, 0x33, 0x63, 0x12, 0x99, 0xf4, 0x63, 0x93, 0x60, 0x57, 0xd9, 0x62, 0x55, 0x8f,
+	0x42, 0xf9, 0x62, 0xec, 0xb3, 0x53, 0x79, 0x6d, 0x0e, 0x2a, 0x18, 0x0a, 0x55, 0xaf, 0x7b, 0x89,
+	0x5c, 0x58, 0xc9, 0x5f, 0x09, 0x00, 0x3a, 0xd8, 0xd5, 0xb0, 0x8b, 0x03, 0xac, 0xdb, 0x1a, 0x3d,
+	0xd0, 0x7d, 0x44, 0x25, 0x91, 0x53, 0xad, 0x2e, 0x40, 0x75, 0xc7, 0x0d, 0x46, 0xa1, 0x7c, 0x09,
+	0x56, 0x82, 0xf0, 0x8e, 0x1b, 0xa8, 0x15, 0x07, 0xbb, 0x9d, 0xc8, 0x61, 0x97, 0xdb, 0x3f, 0xce,
+	0x7e, 0xf7, 0xbd, 0x9c, 0xaa, 0xff, 0x95, 0x06, 0xa5, 0x84, 0xc8, 0xe0, 0x3b, 0x20, 0x1f, 0x90,
+	0x43, 0xe4, 0x6a, 0xba, 0x24, 0xb0, 0x64, 0x54, 0x91, 0x0f, 0x5b, 0x53, 0x83, 0x21, 0xa5, 0x13,
+	0x06, 0x05, 0xde, 0x07, 0x45, 0x4e, 0x0e, 0xcb, 0x87, 0x6b, 0xe5, 0x7f, 0x1b, 0xb7, 0x2f, 0x91,
+	0x37, 0x43, 0xef, 0x9f, 0x78, 0x48, 0xb9, 0x36, 0x0a, 0xe5, 0x69, 0x84, 0x5a, 0xf0, 0x62, 0x03,
+	0xfc, 0x08, 0x5c, 0xd3, 0x1d, 0xcf, 0xc6, 0xfb, 0xd8, 0x9c, 0xf6, 0x3d, 0xab, 0xdc, 0x18, 0x85,
+	0xf2, 0xac, 0x41, 0x9d, 0x1d, 0xc2, 0xf7, 0x41, 0xe1, 0x18, 0xe1, 0xc1, 0x41, 0xa0, 0xe9, 0x52,
+	0x8e, 0xc7, 0x94, 0x47, 0xa1, 0x3c, 0x99, 0x53, 0xf3, 0xd1, 0x57, 0x2b, 0xe1, 0x68, 0x48, 0xe2,
+	0x05, 0x47, 0x63, 0xec, 0xa8, 0x40, 0x33, 0xb1, 0xb7, 0xf2, 0xbc, 0x27, 0xdb, 0x0b, 0xcb, 0x7f,
+	0x82, 0x70, 0xc5, 0x3e, 0x8b, 0x1b, 0xf0, 0x73, 0x16, 0x00, 0xc6, 0x8d, 0x8a, 0x4c, 0xe2, 0x5b,
+	0xf0, 0x5d, 0x90, 0xe7, 0xdc, 0x60, 0x2b, 0xe2, 0x5f, 0x01, 0x67, 0xa1, 0x2c, 0x32, 0x87, 0x4e,
+	0x5b, 0x15, 0x99, 0xa9, 0x63, 0xc1, 0x4f, 0x01, 0xf0, 0x11, 0x45, 0xfe, 0x11, 0xa2, 0x9a, 0xce,
+	0xdb, 0xf1, 0x46, 0x11, 0x67, 0x59, 0xee, 0x6a, 0x71, 0x1c, 0xd2, 0x9a, 0x89, 0x37, 0xa4, 0xcc,
+	0x82, 0xf1, 0x0a, 0xd4, 0x40, 0x39, 0x20, 0xc1, 0x54, 0xb6, 0xd9, 0x85, 0x8f, 0x9f, 0x8e, 0x1b,
+	0xbc, 0x26, 0xd0, 0x12, 0x47, 0x8c, 0xb4, 0x39, 0xab, 0xa9, 0xdc, 0x32, 0x35, 0x25, 0xfe, 0x0b,
+	0x4d, 0xe5, 0xe7, 0xd5, 0x54, 0x61, 0x5e, 0x4d, 0x15, 0xdf, 0x92, 0xa6, 0xea, 0x7f, 0x0a, 0xa0,
+	0xc4, 0x39, 0x8c, 0xe5, 0xb4, 0x0f, 0x8a, 0x16, 0xf2, 0x08, 0xc5, 0x01, 0xf1, 0xb9, 0xa0, 0xca,
+	0xca, 0xf6, 0x1f, 0xa1, 0xbc, 0x3e, 0xc7, 0x8a, 0x2d, 0xd3, 0x6c, 0x59, 0x96, 0x8f, 0x28, 0x3b,
+	0x4a, 0xfe, 0x1f, 0x2f, 0x16, 0xcf, 0x28, 0x27, 0x01, 0xa2, 0xea, 0x14, 0x3a, 0x29, 0xdb, 0xf4,
+	0x95, 0xb2, 0xd5, 0x40, 0x39, 0x12, 0x8c, 0x46, 0x8e, 0x5d, 0x64, 0x49, 0x99, 0x65, 0xc8, 0x26,
+	0x42, 0xec, 0x32, 0xc0, 0xfa, 0x4f, 0x19, 0x70, 0xbd, 0x1d, 0xe5, 0xd4, 0x63, 0x7f, 0x58, 0x23,
+	0xff, 0x53, 0x0c, 0xf4, 0x81, 0x18, 0x6f, 0x99, 0x65, 0xd4, 0x1e, 0x63, 0xc1, 0xc7, 0x80, 0xcd,
+	0x06, 0x9a, 0xa1, 0x53, 0xcc, 0x36, 0xe3, 0x3f, 0xdc, 0x69, 0x1f, 0xc6, 0x8f, 0x8b, 0xf9, 0xaf,
+	0xaf, 0x22, 0x83, 0x57, 0x18, 0x3a, 0xfc, 0x02, 0xac, 0xe8, 0x47, 0xc8, 0xd7, 0x07, 0x48, 0x8b,
+	0x6b, 0xd7, 0xd8, 0xf3, 0x87, 0x6f, 0xd2, 0xd2, 0x46, 0xb5, 0x11, 0xbd, 0x8d, 0x1a, 0xe3, 0xb7,
+	0x51, 0xa3, 0x3f, 0x7e, 0x1b, 0x29, 0x05, 0xb6, 0xec, 0xd3, 0x53, 0x59, 0x50, 0x61, 0x8c, 0x10,
+	0xb7, 0x8a, 0xb9, 0xd4, 0xbf, 0xce, 0x82, 0x4a, 0xcf, 0xc7, 0x26, 0xea, 0x1a, 0xec, 0x94, 0x89,
+	0x36, 0xe1, 0x5c, 0x87, 0xa1, 0x02, 0x8a, 0x93, 0x07, 0x98, 0x94, 0x5e, 0x20, 0x8d, 0x69, 0x18,
+	0x7c, 0x0c, 0xa0, 0x39, 0x74, 0x86, 0xb6, 0x1e, 0xe0, 0x23, 0xa4, 0x79, 0x2c, 0x0f, 0x4d, 0x97,
+	0x32, 0x4b, 0x78, 0x55, 0x55, 0xa6, 0xb8, 0xbc, 0xbc, 0xd6, 0xa5, 0x6b, 0x19, 0x52, 0xf6, 0x2d,
+	0xac, 0xa5, 0xc0, 0x87, 0x20, 0x3f, 0x2e, 0x26, 0xb7, 0x84, 0x05, 0x44, 0x2f, 0x2a, 0x61, 0x02,
+	0x6b, 0x48, 0xe2, 0xd2, 0x60, 0x95, 0xfa, 0xef, 0x69, 0x00, 0x76, 0xb0, 0x83, 0x83, 0xae, 0x6f,
+	0x21, 0x1f, 0xde, 0x04, 0xe9, 0xb8, 0xf1, 0x59, 0x45, 0x3c, 0x0b, 0xe5, 0x74, 0xa7, 0xad, 0xa6,
+	0xb1, 0x05, 0x1b, 0x20, 0xc7, 0xce, 0x0f, 0x3f, 0xde, 0x67, 0xd2, 0xab, 0xe7, 0xeb, 0x2b, 0xb3,
+	0x9b, 0x73, 0x37, 0xf0, 0xb1, 0x3b, 0x50, 0x23, 0xb7, 0xa4, 0x8a, 0x32, 0x57, 0xaa, 0xe8, 0x1e,
+	0xc8, 0x52, 0x64, 0xdb, 0xbc, 0x0f, 0x73, 0x5c, 0x86, 0xdc, 0x19, 0xde, 0x06, 0x45, 0x63, 0x78,
+	0xa2, 0x59, 0xc8, 0x25, 0x4e, 0x44, 0xb0, 0x5a, 0x30, 0x86, 0x27, 0x6d, 0x36, 0x86, 0x2a, 0xc8,
+	0xf1, 0xba, 0x96, 0x42, 0x51, 0x04, 0x05, 0xdb, 0x00, 0xa0, 0x27, 0x1e, 0xf6, 0xa3, 0xbb, 0x2c,
+	0xbf, 0x80, 0xd8, 0x13, 0x71, 0x1f, 0x18, 0xa0, 0x30, 0xbe, 0x39, 0x61, 0x0d, 0x54, 0x7b, 0xdd,
+	0xee, 0x8e, 0xd6, 0x7f, 0xd4, 0xdb, 0xd2, 0x36, 0xbb, 0x0f, 0x76, 0xfb, 0xad, 0x07, 0x7d, 0xad,
+	0xa7, 0x76, 0xdb, 0x0f, 0x37, 0xfb, 0x95, 0x14, 0x94, 0xc0, 0xca, 0xd4, 0xbe, 0xdb, 0x6f, 0x29,
+	0x3b, 0x5b, 0xbb, 0x7b, 0xad, 0x5e, 0x45, 0x80, 0x37, 0x01, 0x9c, 0x5a, 0xf6, 0xb6, 0x3a, 0xf7,
+	0xb7, 0xfb, 0x5b, 0xed, 0x4a, 0xba, 0x9a, 0xfd, 0xe6, 0x87, 0x5a, 0x4a, 0xf9, 0xec, 0xc5, 0x59,
+	0x4d, 0x78, 0x79, 0x56, 0x13, 0x7e, 0x3b, 0xab, 0x09, 0x4f, 0xcf, 0x6b, 0xa9, 0x97, 0xe7, 0xb5,
+	0xd4, 0x2f, 0xe7, 0xb5, 0xd4, 0x97, 0x49, 0x02, 0xd8, 0x95, 0xbe, 0x6e, 0xeb, 0x06, 0xe5, 0x5f,
+	0xcd, 0x27, 0xd1, 0xef, 0x3a, 0x4e, 0x82, 0x21, 0xf2, 0x7a, 0xee, 0xfd, 0x3d, 0x00, 0x12, 0xfd,
+	0x34, 0xda, 0xf1, 0x0d, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -317,6 +736,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinInitialShares.Size()
+		i -= size
+		if _, err := m.MinInitialShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PoolCreationFee) > 0 {
+		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PermissionlessPoolCreation {
+		i--
+		if m.PermissionlessPoolCreation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ProtocolFee.Size()
+		i -= size
+		if _, err := m.ProtocolFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.SwapFee.Size()
 		i -= size
@@ -364,6 +827,36 @@ func (m *AllowedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.WeightB != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.WeightB))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WeightA != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.WeightA))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TokenB) > 0 {
 		i -= len(m.TokenB)
 		copy(dAtA[i:], m.TokenB)
@@ -401,6 +894,36 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.WeightB != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.WeightB))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.WeightA != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.WeightA))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.TotalShares.Size()
 		i -= size
@@ -488,15 +1011,239 @@ func (m *ShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSwap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DepositPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *DepositPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AverageDepositTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AverageDepositTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSwap(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CostBasis) > 0 {
+		for iNdEx := len(m.CostBasis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CostBasis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceB.Size()
+		i -= size
+		if _, err := m.PriceB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PriceA.Size()
+		i -= size
+		if _, err := m.PriceA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CumulativePriceB.Size()
+		i -= size
+		if _, err := m.CumulativePriceB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CumulativePriceA.Size()
+		i -= size
+		if _, err := m.CumulativePriceA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSwap(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSwap(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BuyDenom) > 0 {
+		i -= len(m.BuyDenom)
+		copy(dAtA[i:], m.BuyDenom)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.BuyDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Sell.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *Params) Size() (n int) {
@@ -513,6 +1260,19 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.SwapFee.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	l = m.ProtocolFee.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	if m.PermissionlessPoolCreation {
+		n += 2
+	}
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	l = m.MinInitialShares.Size()
+	n += 1 + l + sovSwap(uint64(l))
 	return n
 }
 
@@ -530,6 +1290,20 @@ func (m *AllowedPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSwap(uint64(l))
 	}
+	if m.PoolType != 0 {
+		n += 1 + sovSwap(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
+	if m.WeightA != 0 {
+		n += 1 + sovSwap(uint64(m.WeightA))
+	}
+	if m.WeightB != 0 {
+		n += 1 + sovSwap(uint64(m.WeightB))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovSwap(uint64(l))
 	return n
 }
 
@@ -549,6 +1323,20 @@ func (m *PoolRecord) Size() (n int) {
 	n += 1 + l + sovSwap(uint64(l))
 	l = m.TotalShares.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	if m.PoolType != 0 {
+		n += 1 + sovSwap(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
+	if m.WeightA != 0 {
+		n += 1 + sovSwap(uint64(m.WeightA))
+	}
+	if m.WeightB != 0 {
+		n += 1 + sovSwap(uint64(m.WeightB))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovSwap(uint64(l))
 	return n
 }
 
@@ -571,6 +1359,86 @@ func (m *ShareRecord) Size() (n int) {
 	return n
 }
 
+func (m *DepositPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	if len(m.CostBasis) > 0 {
+		for _, e := range m.CostBasis {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AverageDepositTime)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func (m *PriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.CumulativePriceA.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.CumulativePriceB.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceA.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceB.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovSwap(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.Sell.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = len(m.BuyDenom)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
 func sovSwap(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -608,7 +1476,1013 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPools = append(m.AllowedPools, AllowedPool{})
+			if err := m.AllowedPools[len(m.AllowedPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessPoolCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionlessPoolCreation = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitialShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightA", wireType)
+			}
+			m.WeightA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightA |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightB", wireType)
+			}
+			m.WeightB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightB |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservesA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservesB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightA", wireType)
+			}
+			m.WeightA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightA |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightB", wireType)
+			}
+			m.WeightB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightB |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesOwned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesOwned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostBasis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -635,16 +2509,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedPools = append(m.AllowedPools, AllowedPool{})
-			if err := m.AllowedPools[len(m.AllowedPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CostBasis = append(m.CostBasis, types.Coin{})
+			if err := m.CostBasis[len(m.CostBasis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDepositTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -654,23 +2528,22 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AverageDepositTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -695,7 +2568,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedPool) Unmarshal(dAtA []byte) error {
+func (m *PriceObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -718,15 +2591,15 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -754,13 +2627,13 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenA = string(dAtA[iNdEx:postIndex])
+			m.PoolID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -770,77 +2643,28 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenB = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwap(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PoolRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSwap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePriceA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -868,13 +2692,15 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolID = string(dAtA[iNdEx:postIndex])
+			if err := m.CumulativePriceA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservesA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePriceB", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -884,30 +2710,31 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservesA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativePriceB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservesB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -917,28 +2744,29 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservesB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceB", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -966,7 +2794,7 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -991,7 +2819,7 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShareRecord) Unmarshal(dAtA []byte) error {
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,17 +2842,36 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShareRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1034,27 +2881,25 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
@@ -1086,9 +2931,42 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.PoolID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesOwned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sell", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1116,7 +2994,72 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesOwned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BuyDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
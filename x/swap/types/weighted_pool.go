@@ -0,0 +1,313 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TotalWeight is the sum of the token weights of a weighted pool
+	TotalWeight uint64 = 100
+	// MinWeight is the minimum weight of a token in a weighted pool
+	MinWeight uint64 = 2
+)
+
+// calculateWeightedInitialShares calculates initial shares as (A^wA * B^wB)^(1/(wA+wB)),
+// the weighted geometric mean of A and B.  For equal weights this is equal to sqrt(A*B).
+func calculateWeightedInitialShares(reservesA, reservesB sdkmath.Int, weightA, weightB uint64) sdkmath.Int {
+	expA, expB := reduceWeights(weightA, weightB)
+
+	product := weightedProduct(reservesA.BigInt(), reservesB.BigInt(), expA, expB)
+	return sdkmath.NewIntFromBigInt(nthRootFloor(product, expA+expB))
+}
+
+// WeightedPool implements a unitless two asset weighted constant-product liquidity pool.
+//
+// Swaps are priced with the weighted constant-product invariant
+//
+//	A^wA * B^wB = k
+//
+// where the weights wA and wB are percentages that sum to 100.  The spot price of A
+// in terms of B is (B/wB) / (A/wA), so a pool holds wA percent of its value in A.
+// The invariant is evaluated with exact integer arithmetic.
+//
+// Liquidity is added and removed in the ratio of the pool reserves, which preserves
+// the weighted value of the pool, so deposits, withdrawals, and share values are shared
+// with the constant-product BasePool.  Initial shares are the weighted geometric mean of
+// the reserves.
+type WeightedPool struct {
+	*BasePool
+	weightA uint64
+	weightB uint64
+}
+
+// NewWeightedPool returns a pointer to a weighted pool with reserves and total shares initialized
+func NewWeightedPool(reservesA, reservesB sdkmath.Int, weightA, weightB uint64) (*WeightedPool, error) {
+	if err := validateWeights(weightA, weightB); err != nil {
+		return nil, err
+	}
+
+	pool, err := NewBasePool(reservesA, reservesB)
+	if err != nil {
+		return nil, err
+	}
+	pool.totalShares = calculateWeightedInitialShares(reservesA, reservesB, weightA, weightB)
+
+	return &WeightedPool{
+		BasePool: pool,
+		weightA:  weightA,
+		weightB:  weightB,
+	}, nil
+}
+
+// NewWeightedPoolWithExistingShares returns a pointer to a weighted pool with existing shares
+func NewWeightedPoolWithExistingShares(reservesA, reservesB, totalShares sdkmath.Int, weightA, weightB uint64) (*WeightedPool, error) {
+	if err := validateWeights(weightA, weightB); err != nil {
+		return nil, err
+	}
+
+	pool, err := NewBasePoolWithExistingShares(reservesA, reservesB, totalShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WeightedPool{
+		BasePool: pool,
+		weightA:  weightA,
+		weightB:  weightB,
+	}, nil
+}
+
+// WeightA returns the percentage weight of the A reserves
+func (p *WeightedPool) WeightA() uint64 {
+	return p.weightA
+}
+
+// WeightB returns the percentage weight of the B reserves
+func (p *WeightedPool) WeightB() uint64 {
+	return p.weightB
+}
+
+// SpotPriceA returns the spot price of A in units of B, (B/wB) / (A/wA)
+func (p *WeightedPool) SpotPriceA() sdk.Dec {
+	return weightedSpotPrice(p.reservesA, p.reservesB, p.weightA, p.weightB)
+}
+
+// SpotPriceB returns the spot price of B in units of A, (A/wA) / (B/wB)
+func (p *WeightedPool) SpotPriceB() sdk.Dec {
+	return weightedSpotPrice(p.reservesB, p.reservesA, p.weightB, p.weightA)
+}
+
+// AddLiquidity adds liquidity to the pool returns the actual reservesA, reservesB deposits in addition
+// to the number of shares created.  Deposits into an existing pool are made in the ratio of the
+// reserves in the same way as the BasePool, while an empty pool is reinitialized with weighted shares.
+func (p *WeightedPool) AddLiquidity(desiredA sdkmath.Int, desiredB sdkmath.Int) (sdkmath.Int, sdkmath.Int, sdkmath.Int) {
+	// Panics if provided values are zero
+	p.assertDepositsArePositive(desiredA, desiredB)
+
+	// Reinitialize the pool if reserves are empty and return the initialized state.
+	if p.IsEmpty() {
+		p.reservesA = desiredA
+		p.reservesB = desiredB
+		p.totalShares = calculateWeightedInitialShares(desiredA, desiredB, p.weightA, p.weightB)
+		return p.ReservesA(), p.ReservesB(), p.TotalShares()
+	}
+
+	return p.BasePool.AddLiquidity(desiredA, desiredB)
+}
+
+// SwapExactAForB trades an exact value of a for b.  Returns the positive amount b
+// that is removed from the pool and the portion of a that is used for paying the fee.
+func (p *WeightedPool) SwapExactAForB(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	expA, expB := reduceWeights(p.weightA, p.weightB)
+	b, feeValue := p.calculateOutputForExactInput(a, p.reservesA, p.reservesB, expA, expB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return b, feeValue
+}
+
+// SwapExactBForA trades an exact value of b for a.  Returns the positive amount a
+// that is removed from the pool and the portion of b that is used for paying the fee.
+func (p *WeightedPool) SwapExactBForA(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	expA, expB := reduceWeights(p.weightA, p.weightB)
+	a, feeValue := p.calculateOutputForExactInput(b, p.reservesB, p.reservesA, expB, expA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return a, feeValue
+}
+
+// SwapAForExactB trades a for an exact b.  Returns the positive amount a
+// that is added to the pool, and the portion of a that is used to pay the fee.
+func (p *WeightedPool) SwapAForExactB(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	expA, expB := reduceWeights(p.weightA, p.weightB)
+	a, feeValue := p.calculateInputForExactOutput(b, p.reservesB, p.reservesA, expB, expA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return a, feeValue
+}
+
+// SwapBForExactA trades b for an exact a.  Returns the positive amount b
+// that is added to the pool, and the portion of b that is used to pay the fee.
+func (p *WeightedPool) SwapBForExactA(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	expA, expB := reduceWeights(p.weightA, p.weightB)
+	b, feeValue := p.calculateInputForExactOutput(a, p.reservesA, p.reservesB, expA, expB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return b, feeValue
+}
+
+// calculateOutputForExactInput calculates the output amount of a swap using a fixed input, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The fee is ceiled in the same way as the BasePool.  The new output reserves are the smallest reserves that keep
+// the invariant greater than or equal to the previous invariant, ensuring the output is always truncated.
+func (p *WeightedPool) calculateOutputForExactInput(in, inReserves, outReserves sdkmath.Int, inExp, outExp uint64, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapInputIsValid(in)
+	p.assertFeeIsValid(fee)
+
+	inAfterFee := sdk.NewDecFromInt(in).Mul(sdk.OneDec().Sub(fee)).TruncateInt()
+	feeValue := in.Sub(inAfterFee)
+
+	invariant := weightedProduct(inReserves.BigInt(), outReserves.BigInt(), inExp, outExp)
+	newInReserves := inReserves.Add(inAfterFee).BigInt()
+	newOutReserves := solveWeightedReserves(invariant, newInReserves, inExp, outExp)
+
+	// an input too small to move the invariant results in no output
+	if newOutReserves.Cmp(outReserves.BigInt()) >= 0 {
+		return sdk.ZeroInt(), feeValue
+	}
+
+	out := outReserves.Sub(sdkmath.NewIntFromBigInt(newOutReserves))
+
+	return out, feeValue
+}
+
+// calculateInputForExactOutput calculates the input amount of a swap using a fixed output, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The fee is ceiled in the same way as the BasePool.  The new input reserves are the smallest reserves that keep
+// the invariant greater than or equal to the previous invariant, ensuring the input is always ceiled.
+func (p *WeightedPool) calculateInputForExactOutput(out, outReserves, inReserves sdkmath.Int, outExp, inExp uint64, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapOutputIsValid(out, outReserves)
+	p.assertFeeIsValid(fee)
+
+	invariant := weightedProduct(inReserves.BigInt(), outReserves.BigInt(), inExp, outExp)
+	newOutReserves := outReserves.Sub(out).BigInt()
+	newInReserves := solveWeightedReserves(invariant, newOutReserves, outExp, inExp)
+
+	inWithoutFee := sdkmath.NewIntFromBigInt(newInReserves).Sub(inReserves)
+	if !inWithoutFee.IsPositive() {
+		inWithoutFee = sdk.OneInt()
+	}
+
+	in := sdk.NewDecFromInt(inWithoutFee).Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt()
+	feeValue := in.Sub(inWithoutFee)
+
+	return in, feeValue
+}
+
+// assertInvariantAndUpdateReserves asserts the weighted invariant is not violated, subtracting
+// any fees first, then updates the pool reserves.  Panics if invariant is violated.
+func (p *WeightedPool) assertInvariantAndUpdateReserves(newReservesA, feeA, newReservesB, feeB sdkmath.Int) {
+	expA, expB := reduceWeights(p.weightA, p.weightB)
+
+	invariant := weightedProduct(p.reservesA.BigInt(), p.reservesB.BigInt(), expA, expB)
+	newInvariant := weightedProduct(newReservesA.Sub(feeA).BigInt(), newReservesB.Sub(feeB).BigInt(), expA, expB)
+
+	p.assertInvariant(invariant, newInvariant)
+
+	p.reservesA = newReservesA
+	p.reservesB = newReservesB
+}
+
+// weightedSpotPrice returns the spot price of x in units of y, (y/wY) / (x/wX)
+func weightedSpotPrice(x, y sdkmath.Int, weightX, weightY uint64) sdk.Dec {
+	numerator := sdk.NewDecFromInt(y.Mul(sdkmath.NewIntFromUint64(weightX)))
+	denominator := sdk.NewDecFromInt(x.Mul(sdkmath.NewIntFromUint64(weightY)))
+	return numerator.Quo(denominator)
+}
+
+// weightedProduct returns x^expX * y^expY
+func weightedProduct(x, y *big.Int, expX, expY uint64) *big.Int {
+	product := new(big.Int).Exp(x, new(big.Int).SetUint64(expX), nil)
+	return product.Mul(product, new(big.Int).Exp(y, new(big.Int).SetUint64(expY), nil))
+}
+
+// solveWeightedReserves returns the smallest reserves y paired with reserves x such that
+// x^expX * y^expY is greater than or equal to the invariant
+func solveWeightedReserves(invariant, x *big.Int, expX, expY uint64) *big.Int {
+	xPow := new(big.Int).Exp(x, new(big.Int).SetUint64(expX), nil)
+
+	// y^expY >= ceil(invariant / x^expX) since y^expY is an integer
+	target, remainder := new(big.Int).QuoRem(invariant, xPow, new(big.Int))
+	if remainder.Sign() > 0 {
+		target.Add(target, big.NewInt(1))
+	}
+
+	y := nthRootFloor(target, expY)
+	if new(big.Int).Exp(y, new(big.Int).SetUint64(expY), nil).Cmp(target) < 0 {
+		y.Add(y, big.NewInt(1))
+	}
+
+	return y
+}
+
+// nthRootFloor returns the largest integer r such that r^n <= x using newton's method
+func nthRootFloor(x *big.Int, n uint64) *big.Int {
+	if x.Sign() == 0 || n == 1 {
+		return new(big.Int).Set(x)
+	}
+
+	bigN := new(big.Int).SetUint64(n)
+	bigNMinusOne := new(big.Int).SetUint64(n - 1)
+
+	// start from a power of two greater than the root, newton's method then decreases
+	// monotonically to the floor of the root
+	r := new(big.Int).Lsh(big.NewInt(1), uint((uint64(x.BitLen())+n-1)/n))
+	for {
+		next := new(big.Int).Exp(r, bigNMinusOne, nil)
+		next.Quo(x, next)
+		next.Add(next, new(big.Int).Mul(bigNMinusOne, r))
+		next.Quo(next, bigN)
+
+		if next.Cmp(r) >= 0 {
+			return r
+		}
+		r = next
+	}
+}
+
+// reduceWeights divides the weights by their greatest common divisor to minimize the
+// exponents used when evaluating the invariant
+func reduceWeights(weightA, weightB uint64) (uint64, uint64) {
+	divisor := new(big.Int).GCD(nil, nil, new(big.Int).SetUint64(weightA), new(big.Int).SetUint64(weightB)).Uint64()
+	return weightA / divisor, weightB / divisor
+}
+
+// validateWeights returns an error if the weights are out of bounds or do not sum to the total weight
+func validateWeights(weightA, weightB uint64) error {
+	if weightA < MinWeight || weightB < MinWeight {
+		return errorsmod.Wrapf(ErrInvalidPool, "weights %d and %d must be at least %d", weightA, weightB, MinWeight)
+	}
+
+	if weightA+weightB != TotalWeight {
+		return errorsmod.Wrapf(ErrInvalidPool, "weights %d and %d must sum to %d", weightA, weightB, TotalWeight)
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	types "github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedPool_NewPool_Validation(t *testing.T) {
+	testCases := []struct {
+		reservesA   sdkmath.Int
+		reservesB   sdkmath.Int
+		weightA     uint64
+		weightB     uint64
+		expectedErr string
+	}{
+		{i(1e6), i(1e6), 0, 100, "weights 0 and 100 must be at least 2: invalid pool"},
+		{i(1e6), i(1e6), 99, 1, "weights 99 and 1 must be at least 2: invalid pool"},
+		{i(1e6), i(1e6), 80, 30, "weights 80 and 30 must sum to 100: invalid pool"},
+		{i(1e6), i(1e6), 40, 40, "weights 40 and 40 must sum to 100: invalid pool"},
+		{i(0), i(1e6), 80, 20, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(-1), 80, 20, "reserves must be greater than zero: invalid pool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s weightA=%d weightB=%d", tc.reservesA, tc.reservesB, tc.weightA, tc.weightB), func(t *testing.T) {
+			pool, err := types.NewWeightedPool(tc.reservesA, tc.reservesB, tc.weightA, tc.weightB)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+
+			pool, err = types.NewWeightedPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.weightA, tc.weightB)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+		})
+	}
+}
+
+func TestWeightedPool_InitialState(t *testing.T) {
+	pool, err := types.NewWeightedPool(i(1000e6), i(250e6), 80, 20)
+	require.NoError(t, err)
+
+	assert.Equal(t, i(1000e6), pool.ReservesA())
+	assert.Equal(t, i(250e6), pool.ReservesB())
+	assert.Equal(t, uint64(80), pool.WeightA())
+	assert.Equal(t, uint64(20), pool.WeightB())
+
+	// initial shares are the weighted geometric mean of the reserves
+	assert.Equal(t, i(757858283), pool.TotalShares())
+
+	// equal weights have the same initial shares as the base pool
+	equalPool, err := types.NewWeightedPool(i(1e6), i(4e6), 50, 50)
+	require.NoError(t, err)
+	assert.Equal(t, i(2e6), equalPool.TotalShares())
+}
+
+func TestWeightedPool_EqualWeights_MatchBasePool(t *testing.T) {
+	inputs := []sdkmath.Int{i(1), i(1234), i(1e6), i(3e8)}
+	for _, input := range inputs {
+		basePool, err := types.NewBasePool(i(1000e6), i(500e6))
+		require.NoError(t, err)
+		weightedPool, err := types.NewWeightedPool(i(1000e6), i(500e6), 50, 50)
+		require.NoError(t, err)
+
+		baseOutput, baseFee := basePool.SwapExactAForB(input, d("0.003"))
+		weightedOutput, weightedFee := weightedPool.SwapExactAForB(input, d("0.003"))
+		assert.Equal(t, baseOutput, weightedOutput)
+		assert.Equal(t, baseFee, weightedFee)
+	}
+}
+
+func TestWeightedPool_Swap_ExactInput(t *testing.T) {
+	testCases := []struct {
+		reservesA      sdkmath.Int
+		reservesB      sdkmath.Int
+		weightA        uint64
+		weightB        uint64
+		exactInput     sdkmath.Int
+		fee            sdk.Dec
+		expectedOutput sdkmath.Int
+		expectedFee    sdkmath.Int
+	}{
+		{i(1000e6), i(250e6), 80, 20, i(1e6), d("0.003"), i(994519), i(3000)},
+		{i(250e6), i(1000e6), 20, 80, i(1e6), d("0.003"), i(994522), i(3000)},
+		{i(1000e6), i(700e6), 30, 70, i(1e6), d("0.003"), i(298887), i(3000)},
+		{i(1000e6), i(1000e6), 50, 50, i(1e6), d("0.003"), i(996006), i(3000)},
+		{i(1000e6), i(250e6), 80, 20, i(1), d("0.003"), i(0), i(1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s weights=%d/%d exactInput=%s fee=%s", tc.reservesA, tc.reservesB, tc.weightA, tc.weightB, tc.exactInput, tc.fee), func(t *testing.T) {
+			pool, err := types.NewWeightedPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.weightA, tc.weightB)
+			require.NoError(t, err)
+
+			output, feePaid := pool.SwapExactAForB(tc.exactInput, tc.fee)
+			assert.Equal(t, tc.expectedOutput, output)
+			assert.Equal(t, tc.expectedFee, feePaid)
+			assert.Equal(t, tc.reservesA.Add(tc.exactInput), pool.ReservesA())
+			assert.Equal(t, tc.reservesB.Sub(output), pool.ReservesB())
+
+			// the pool is symmetric
+			pool, err = types.NewWeightedPoolWithExistingShares(tc.reservesB, tc.reservesA, i(1e6), tc.weightB, tc.weightA)
+			require.NoError(t, err)
+
+			output, feePaid = pool.SwapExactBForA(tc.exactInput, tc.fee)
+			assert.Equal(t, tc.expectedOutput, output)
+			assert.Equal(t, tc.expectedFee, feePaid)
+		})
+	}
+}
+
+func TestWeightedPool_Swap_ExactOutput(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		weightA       uint64
+		weightB       uint64
+		exactOutput   sdkmath.Int
+		fee           sdk.Dec
+		expectedInput sdkmath.Int
+		expectedFee   sdkmath.Int
+	}{
+		{i(1000e6), i(250e6), 80, 20, i(1e6), d("0.003"), i(1005525), i(3017)},
+		{i(250e6), i(1000e6), 20, 80, i(1e6), d("0.003"), i(1005523), i(3017)},
+		{i(1000e6), i(700e6), 30, 70, i(1e6), d("0.003"), i(3351342), i(10055)},
+		{i(1000e6), i(1000e6), 50, 50, i(1e6), d("0.003"), i(1004015), i(3013)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s weights=%d/%d exactOutput=%s fee=%s", tc.reservesA, tc.reservesB, tc.weightA, tc.weightB, tc.exactOutput, tc.fee), func(t *testing.T) {
+			pool, err := types.NewWeightedPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.weightA, tc.weightB)
+			require.NoError(t, err)
+
+			input, feePaid := pool.SwapAForExactB(tc.exactOutput, tc.fee)
+			assert.Equal(t, tc.expectedInput, input)
+			assert.Equal(t, tc.expectedFee, feePaid)
+			assert.Equal(t, tc.reservesA.Add(input), pool.ReservesA())
+			assert.Equal(t, tc.reservesB.Sub(tc.exactOutput), pool.ReservesB())
+
+			// the pool is symmetric
+			pool, err = types.NewWeightedPoolWithExistingShares(tc.reservesB, tc.reservesA, i(1e6), tc.weightB, tc.weightA)
+			require.NoError(t, err)
+
+			input, feePaid = pool.SwapBForExactA(tc.exactOutput, tc.fee)
+			assert.Equal(t, tc.expectedInput, input)
+			assert.Equal(t, tc.expectedFee, feePaid)
+
+			// the calculated input must return at least the exact output when swapped as an exact input
+			pool, err = types.NewWeightedPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.weightA, tc.weightB)
+			require.NoError(t, err)
+
+			output, _ := pool.SwapExactAForB(input, tc.fee)
+			assert.True(t, output.GTE(tc.exactOutput), "expected output %s >= %s", output, tc.exactOutput)
+		})
+	}
+}
+
+func TestWeightedPool_Swap_WeightsAffectPriceImpact(t *testing.T) {
+	basePool, err := types.NewBasePool(i(1000e6), i(250e6))
+	require.NoError(t, err)
+	weightedPool, err := types.NewWeightedPool(i(1000e6), i(250e6), 80, 20)
+	require.NoError(t, err)
+
+	// the 80/20 pool values reserves 1:1 while the constant product pool values them 4:1
+	baseOutput, _ := basePool.SwapExactAForB(i(100e6), d("0"))
+	weightedOutput, _ := weightedPool.SwapExactAForB(i(100e6), d("0"))
+
+	assert.Equal(t, i(22727272), baseOutput)
+	assert.Equal(t, i(79246636), weightedOutput)
+}
+
+func TestWeightedPool_Liquidity(t *testing.T) {
+	pool, err := types.NewWeightedPool(i(1000e6), i(250e6), 80, 20)
+	require.NoError(t, err)
+	totalShares := pool.TotalShares()
+
+	// deposits are made in the ratio of the reserves
+	depositA, depositB, shares := pool.AddLiquidity(i(100e6), i(100e6))
+	assert.Equal(t, i(100e6), depositA)
+	assert.Equal(t, i(25e6), depositB)
+	assert.Equal(t, totalShares.QuoRaw(10), shares)
+
+	// share values are truncated
+	valueA, valueB := pool.ShareValue(shares)
+	assert.Equal(t, i(99999999), valueA)
+	assert.Equal(t, i(24999999), valueB)
+
+	withdrawnA, withdrawnB := pool.RemoveLiquidity(pool.TotalShares())
+	assert.Equal(t, i(1100e6), withdrawnA)
+	assert.Equal(t, i(275e6), withdrawnB)
+	assert.True(t, pool.IsEmpty())
+
+	// an empty pool is reinitialized with weighted shares
+	_, _, shares = pool.AddLiquidity(i(1000e6), i(250e6))
+	assert.Equal(t, totalShares, shares)
+}
+
+func TestWeightedPool_Swap_InvariantNeverDecreases(t *testing.T) {
+	pool, err := types.NewWeightedPool(i(1000e6), i(250e6), 80, 20)
+	require.NoError(t, err)
+
+	inputs := []sdkmath.Int{i(1), i(7), i(1234), i(1e6), i(3e8), i(999e6), i(5e9)}
+	for n, input := range inputs {
+		for _, fee := range []sdk.Dec{d("0"), d("0.003")} {
+			reservesA, reservesB := pool.ReservesA(), pool.ReservesB()
+			if n%2 == 0 {
+				output, _ := pool.SwapExactAForB(input, fee)
+				assert.Equal(t, reservesB.Sub(output), pool.ReservesB())
+			} else {
+				output, _ := pool.SwapExactBForA(input, fee)
+				assert.Equal(t, reservesA.Sub(output), pool.ReservesA())
+			}
+			assert.True(t, pool.ReservesA().IsPositive())
+			assert.True(t, pool.ReservesB().IsPositive())
+
+			if n%2 == 0 {
+				pool.SwapBForExactA(pool.ReservesA().QuoRaw(3), fee)
+			} else {
+				pool.SwapAForExactB(pool.ReservesB().QuoRaw(3), fee)
+			}
+			assert.True(t, pool.ReservesA().IsPositive())
+			assert.True(t, pool.ReservesB().IsPositive())
+		}
+	}
+}
+
+func TestWeightedPool_Panics_Swap(t *testing.T) {
+	pool, err := types.NewWeightedPool(i(1e6), i(1e6), 80, 20)
+	require.NoError(t, err)
+
+	assert.PanicsWithValue(t, "invalid value: swap input must be positive", func() {
+		pool.SwapExactAForB(i(0), d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid value: swap output must be less than reserves", func() {
+		pool.SwapAForExactB(i(1e6), d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid value: fee must be between 0 and 1", func() {
+		pool.SwapExactBForA(i(1e3), d("1"))
+	})
+}
+
+func TestWeightedPool_SpotPrice(t *testing.T) {
+	// an 80/20 pool with reserves in proportion to the weights has equal prices
+	pool, err := types.NewWeightedPool(i(1000e6), i(250e6), 80, 20)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.OneDec(), pool.SpotPriceA())
+	assert.Equal(t, sdk.OneDec(), pool.SpotPriceB())
+
+	pool, err = types.NewWeightedPool(i(1000e6), i(700e6), 30, 70)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.3"), pool.SpotPriceA())
+	assert.Equal(t, sdk.MustNewDecFromStr("3.333333333333333333"), pool.SpotPriceB())
+}